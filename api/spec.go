@@ -0,0 +1,16 @@
+// Package api встраивает спецификации OpenAPI в бинарь, чтобы сервер и проверка контракта
+// работали с теми же файлами, что и документация.
+package api
+
+import "embed"
+
+const (
+	V1 = "openapi.yml"
+	V2 = "openapi.v2.yml" // Ссылается на схемы из openapi.yml
+)
+
+// Specs — спецификации всех версий API в порядке версий.
+var Specs = []string{V1, V2}
+
+//go:embed openapi.yml openapi.v2.yml
+var FS embed.FS
@@ -2,6 +2,8 @@ package main
 
 import (
 	"log"
+	"os"
+	_ "time/tzdata" // База часовых поясов внутри бинарника: в образе alpine её нет
 
 	cfg "github.com/F3dosik/PRS.git/internal/config/server"
 	"github.com/F3dosik/PRS.git/internal/server"
@@ -13,6 +15,12 @@ func main() {
 	if err != nil {
 		log.Fatalf("Configuration loading error: %v", err)
 	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(cfg.DatabaseURL, os.Args[2:]); err != nil {
+			log.Fatalf("Migration error: %v", err)
+		}
+		return
+	}
 	mode := logger.Mode(cfg.LogMode)
 	baseLogger, sugarLogger := logger.NewLogger(mode)
 	defer func() { _ = baseLogger.Sync() }()
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/F3dosik/PRS.git/internal/migrate"
+	"github.com/F3dosik/PRS.git/migrations"
+)
+
+const migrateUsage = "usage: prs migrate up|down|status|goto VERSION|force VERSION"
+
+// runMigrate выполняет prs migrate: up применяет все миграции, down откатывает последнюю,
+// goto переводит схему на указанную версию (0 — откатить все), force только записывает версию
+// и снимает отметку dirty после ручного исправления схемы.
+func runMigrate(databaseURL string, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	migrator, err := migrate.Open(databaseURL, migrations.FS)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = migrator.Close() }()
+
+	ctx := context.Background()
+
+	var steps []migrate.Step
+	switch {
+	case args[0] == "up" && len(args) == 1:
+		steps, err = migrator.Up(ctx)
+	case args[0] == "down" && len(args) == 1:
+		steps, err = migrator.Down(ctx)
+	case args[0] == "goto" && len(args) == 2:
+		version, parseErr := strconv.ParseUint(args[1], 10, 64)
+		if parseErr != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		steps, err = migrator.Goto(ctx, version)
+	case args[0] == "force" && len(args) == 2:
+		version, parseErr := strconv.ParseUint(args[1], 10, 64)
+		if parseErr != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		if err := migrator.Force(ctx, version); err != nil {
+			return err
+		}
+		fmt.Printf("schema version set to %d\n", version)
+		return nil
+	case args[0] == "status" && len(args) == 1:
+		return printMigrationStatus(ctx, migrator)
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	for _, step := range steps {
+		direction := "reverted"
+		if step.Up {
+			direction = "applied"
+		}
+		fmt.Printf("%s %06d_%s\n", direction, step.Version, step.Name)
+	}
+	if err != nil {
+		return err
+	}
+	if len(steps) == 0 {
+		fmt.Println("no change")
+	}
+
+	return nil
+}
+
+func printMigrationStatus(ctx context.Context, migrator *migrate.Migrator) error {
+	status, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrator.Migrations() {
+		mark := "pending"
+		if m.Version <= status.Version {
+			mark = "applied"
+		}
+		fmt.Printf("%-8s %06d_%s\n", mark, m.Version, m.Name)
+	}
+
+	version := fmt.Sprintf("version %d of %d", status.Version, status.Latest)
+	switch {
+	case status.Dirty:
+		version += " (dirty)"
+	case status.Version > status.Latest:
+		version += " (newer than this binary)"
+	}
+	fmt.Println(version)
+
+	return nil
+}
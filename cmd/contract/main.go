@@ -0,0 +1,52 @@
+// Команда contract проверяет запущенный сервис на соответствие спецификациям api/openapi*.yml
+// (см. internal/contract). Сервис лучше запускать с OPENAPI_VALIDATION=test и на отдельной базе —
+// проверка создаёт команду, пользователей и PR.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/contract"
+)
+
+func main() {
+	baseURL := flag.String("base-url", "http://localhost:8080", "адрес проверяемого сервиса")
+	timeout := flag.Duration("timeout", 10*time.Second, "таймаут одного запроса")
+	adminToken := flag.String("admin-token", os.Getenv("ADMIN_TOKEN"), "токен для /admin/* (по умолчанию ADMIN_TOKEN)")
+	flag.Parse()
+
+	docs, err := contract.LoadSpecs()
+	if err != nil {
+		log.Fatalf("Cannot load openapi spec: %v", err)
+	}
+
+	c := contract.New(*baseURL, &http.Client{Timeout: *timeout}, *adminToken)
+	if err := c.Setup(); err != nil {
+		log.Fatalf("Cannot create fixtures: %v", err)
+	}
+
+	failed := 0
+	for _, doc := range docs {
+		for _, r := range c.CheckSpec(doc) {
+			switch {
+			case r.Skipped:
+				fmt.Printf("SKIP %s %s (stream)\n", r.Method, r.Path)
+			case r.Err != nil:
+				failed++
+				fmt.Printf("FAIL %s %s %d: %v\n", r.Method, r.Path, r.Status, r.Err)
+			default:
+				fmt.Printf("ok   %s %s %d\n", r.Method, r.Path, r.Status)
+			}
+		}
+	}
+
+	if failed > 0 {
+		fmt.Printf("%d operation(s) diverge from the spec\n", failed)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,368 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+var errUsage = errors.New("invalid arguments, see prsctl -h")
+
+func teamAdd(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("team add", flag.ContinueOnError)
+	file := fs.String("f", "", "JSON-файл с командой в формате /team/add")
+	parent := fs.String("parent", "", "родительская команда")
+	var members stringList
+	fs.Var(&members, "member", "участник <user_id>:<username>[:seniority[:role]], можно несколько раз")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	var team api.Team
+	switch {
+	case *file != "" && len(positional) == 0 && len(members) == 0:
+		data, err := os.ReadFile(*file)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &team); err != nil {
+			return fmt.Errorf("parse %s: %w", *file, err)
+		}
+	case *file == "" && len(positional) == 1 && len(members) > 0:
+		team.TeamName = positional[0]
+		for _, raw := range members {
+			member, err := parseMember(raw)
+			if err != nil {
+				return err
+			}
+			team.Members = append(team.Members, member)
+		}
+	default:
+		return errUsage
+	}
+	if *parent != "" {
+		team.ParentTeam = parent
+	}
+
+	created, err := a.client.AddTeam(ctx, team)
+	if err != nil {
+		return err
+	}
+	return a.printTeam(created)
+}
+
+// parseMember разбирает <user_id>:<username>[:seniority[:role]]; участник добавляется активным.
+func parseMember(raw string) (api.TeamMember, error) {
+	parts := strings.Split(raw, ":")
+	if len(parts) < 2 || len(parts) > 4 || parts[1] == "" {
+		return api.TeamMember{}, fmt.Errorf("invalid member %q: want <user_id>:<username>[:seniority[:role]]", raw)
+	}
+
+	userID, err := uuid.Parse(parts[0])
+	if err != nil {
+		return api.TeamMember{}, fmt.Errorf("invalid member %q: %w", raw, err)
+	}
+
+	member := api.TeamMember{UserID: userID, Username: parts[1], IsActive: true}
+	if len(parts) > 2 {
+		member.Seniority = api.Seniority(parts[2])
+	}
+	if len(parts) > 3 {
+		member.Role = parts[3]
+	}
+
+	return member, nil
+}
+
+func teamGet(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("team get", flag.ContinueOnError)
+	descendants := fs.Bool("descendants", false, "включить участников подкоманд")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errUsage
+	}
+
+	team, err := a.client.GetTeam(ctx, positional[0], *descendants)
+	if err != nil {
+		return err
+	}
+	return a.printTeam(team)
+}
+
+func teamUpdate(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("team update", flag.ContinueOnError)
+	parent := fs.String("parent", "", "новая родительская команда")
+	root := fs.Bool("root", false, "сделать команду корневой")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 || (*parent == "") == !*root {
+		return errUsage
+	}
+
+	var parentTeam *string
+	if *parent != "" {
+		parentTeam = parent
+	}
+
+	team, err := a.client.SetTeamParent(ctx, positional[0], parentTeam)
+	if err != nil {
+		return err
+	}
+	return a.printTeam(team)
+}
+
+func (a *app) printTeam(team *api.Team) error {
+	return a.printer.print(team, func(w *tabwriter.Writer) {
+		row(w, "TEAM:", team.TeamName)
+		row(w, "PARENT:", valueOr(team.ParentTeam, "-"))
+		row(w)
+		row(w, "USER_ID", "USERNAME", "ACTIVE", "ROLE", "SENIORITY", "TEAM")
+		for _, m := range team.Members {
+			row(w, m.UserID, m.Username, m.IsActive, m.Role, m.Seniority, valueOr(&m.TeamName, team.TeamName))
+		}
+	})
+}
+
+func userActivate(ctx context.Context, a *app, args []string) error {
+	return setIsActive(ctx, a, "user activate", args, true)
+}
+
+func userDeactivate(ctx context.Context, a *app, args []string) error {
+	return setIsActive(ctx, a, "user deactivate", args, false)
+}
+
+func setIsActive(ctx context.Context, a *app, name string, args []string, isActive bool) error {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	team := fs.String("team", "", "изменить только членство в этой команде")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errUsage
+	}
+
+	userID, err := uuid.Parse(positional[0])
+	if err != nil {
+		return fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	user, err := a.client.SetIsActive(ctx, userID, isActive, *team)
+	if err != nil {
+		return err
+	}
+
+	return a.printer.print(user, func(w *tabwriter.Writer) {
+		row(w, "USER_ID:", user.UserID)
+		row(w, "USERNAME:", user.Username)
+		row(w, "ACTIVE:", user.IsActive)
+		row(w, "SENIORITY:", user.Seniority)
+		row(w, "PRIMARY TEAM:", valueOr(user.TeamName, "-"))
+		row(w)
+		row(w, "TEAM", "ROLE", "ACTIVE", "PRIMARY")
+		for _, t := range user.Teams {
+			row(w, t.TeamName, t.Role, t.IsActive, t.IsPrimary)
+		}
+	})
+}
+
+func prCreate(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("pr create", flag.ContinueOnError)
+	id := fs.String("id", "", "идентификатор PR (по умолчанию случайный)")
+	name := fs.String("name", "", "название PR")
+	author := fs.String("author", "", "user_id автора")
+	team := fs.String("team", "", "команда PR (по умолчанию основная команда автора)")
+	var files, labels stringList
+	fs.Var(&files, "file", "изменённый файл, можно несколько раз")
+	fs.Var(&labels, "label", "метка, можно несколько раз")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 || *name == "" || *author == "" {
+		return errUsage
+	}
+
+	req := api.PullRequestCreateRequest{
+		TeamName:     *team,
+		ChangedFiles: files,
+		Labels:       labels,
+	}
+	req.PullRequestName = *name
+	if req.AuthorID, err = uuid.Parse(*author); err != nil {
+		return fmt.Errorf("invalid author: %w", err)
+	}
+	req.PullRequestID = uuid.New()
+	if *id != "" {
+		if req.PullRequestID, err = uuid.Parse(*id); err != nil {
+			return fmt.Errorf("invalid id: %w", err)
+		}
+	}
+
+	pr, err := a.client.CreatePullRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+	return a.printPullRequest(pr, nil)
+}
+
+func prMerge(ctx context.Context, a *app, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	id, err := uuid.Parse(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid pull_request_id: %w", err)
+	}
+
+	pr, err := a.client.MergePullRequest(ctx, id)
+	if err != nil {
+		return err
+	}
+	return a.printPullRequest(pr, nil)
+}
+
+func prReassign(ctx context.Context, a *app, args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+	id, err := uuid.Parse(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid pull_request_id: %w", err)
+	}
+	oldUserID, err := uuid.Parse(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid old_user_id: %w", err)
+	}
+
+	resp, err := a.client.ReassignPullRequest(ctx, id, oldUserID)
+	if err != nil {
+		return err
+	}
+	return a.printPullRequest(&resp.PullRequest, resp)
+}
+
+func prShow(ctx context.Context, a *app, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	id, err := uuid.Parse(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid pull_request_id: %w", err)
+	}
+
+	pr, err := a.client.GetPullRequest(ctx, id)
+	if err != nil {
+		return err
+	}
+	return a.printPullRequest(pr, nil)
+}
+
+// printPullRequest выводит PR; при переназначении в JSON и YAML выводится весь ответ reassign.
+func (a *app) printPullRequest(pr *api.PullRequest, reassign *api.PullRequestReassignResponse) error {
+	var v any = pr
+	if reassign != nil {
+		v = reassign
+	}
+
+	return a.printer.print(v, func(w *tabwriter.Writer) {
+		row(w, "PULL_REQUEST_ID:", pr.PullRequestID)
+		row(w, "NAME:", pr.PullRequestName)
+		row(w, "AUTHOR:", pr.AuthorID)
+		row(w, "TEAM:", valueOr(&pr.TeamName, "-"))
+		row(w, "STATUS:", pr.Status)
+		row(w, "REVIEWERS:", joinIDs(pr.AssignedReviewers))
+		if pr.ShadowReviewer != nil {
+			row(w, "SHADOW:", pr.ShadowReviewer)
+		}
+		if !pr.CreatedAt.IsZero() {
+			row(w, "CREATED:", pr.CreatedAt.Format(time.RFC3339))
+		}
+		if pr.MergedAt != nil {
+			row(w, "MERGED:", pr.MergedAt.Format(time.RFC3339))
+		}
+		if len(pr.Labels) > 0 {
+			row(w, "LABELS:", strings.Join(pr.Labels, ", "))
+		}
+		if reassign != nil {
+			row(w, "REPLACED_BY:", reassign.ReplacedBy)
+		}
+	})
+}
+
+func stats(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	team := fs.String("team", "", "команда (по умолчанию все)")
+	descendants := fs.Bool("descendants", false, "включить подкоманды")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return errUsage
+	}
+
+	resp, err := a.client.Stats(ctx, *team, *descendants)
+	if err != nil {
+		return err
+	}
+
+	return a.printer.print(resp, func(w *tabwriter.Writer) {
+		if resp.TeamName != "" {
+			row(w, "TEAM:", resp.TeamName)
+		}
+		row(w, "TOTAL PR:", resp.TotalPR)
+		row(w, "OPEN PR:", resp.OpenPR)
+		row(w)
+		row(w, "REVIEWER", "ASSIGNMENTS")
+
+		// По убыванию числа назначений, при равенстве — по имени
+		reviewers := make([]string, 0, len(resp.ReviewAssignments))
+		for name := range resp.ReviewAssignments {
+			reviewers = append(reviewers, name)
+		}
+		slices.SortFunc(reviewers, func(x, y string) int {
+			if d := resp.ReviewAssignments[y] - resp.ReviewAssignments[x]; d != 0 {
+				return d
+			}
+			return strings.Compare(x, y)
+		})
+		for _, name := range reviewers {
+			row(w, name, resp.ReviewAssignments[name])
+		}
+	})
+}
+
+func valueOr(value *string, fallback string) string {
+	if value == nil || *value == "" {
+		return fallback
+	}
+	return *value
+}
+
+func joinIDs(ids []uuid.UUID) string {
+	if len(ids) == 0 {
+		return "-"
+	}
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = id.String()
+	}
+	return strings.Join(parts, ", ")
+}
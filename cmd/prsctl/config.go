@@ -0,0 +1,61 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+const defaultServer = "http://localhost:8080"
+
+// config — настройки из файла; флаги и переменные PRSCTL_SERVER, PRSCTL_TOKEN имеют приоритет.
+type config struct {
+	Server string `yaml:"server"`
+	Token  string `yaml:"token"`
+	Output string `yaml:"output"`
+}
+
+// defaultConfigPath — $XDG_CONFIG_HOME/prsctl/config.yaml или его аналог на текущей ОС.
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "prsctl", "config.yaml")
+}
+
+// loadConfig читает файл настроек. Отсутствие файла по умолчанию не ошибка, явно указанного — ошибка.
+func loadConfig(path string, explicit bool) (*config, error) {
+	cfg := &config{}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist) && !explicit:
+		case err != nil:
+			return nil, err
+		default:
+			if err := yaml.Unmarshal(data, cfg); err != nil {
+				return nil, fmt.Errorf("parse %s: %w", path, err)
+			}
+		}
+	}
+
+	if server := os.Getenv("PRSCTL_SERVER"); server != "" {
+		cfg.Server = server
+	}
+	if token := os.Getenv("PRSCTL_TOKEN"); token != "" {
+		cfg.Token = token
+	}
+	if cfg.Server == "" {
+		cfg.Server = defaultServer
+	}
+	if cfg.Output == "" {
+		cfg.Output = string(outputTable)
+	}
+
+	return cfg, nil
+}
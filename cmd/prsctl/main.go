@@ -0,0 +1,164 @@
+// Команда prsctl управляет PRS через HTTP API:
+//
+//	prsctl [-config файл] [-server url] [-token токен] [-o table|json|yaml] <команда> ...
+//
+// Адрес сервиса и токен читаются из файла настроек (по умолчанию $XDG_CONFIG_HOME/prsctl/config.yaml),
+// переменных PRSCTL_SERVER и PRSCTL_TOKEN и флагов — в порядке возрастания приоритета.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/client"
+)
+
+const usageText = `usage: prsctl [-config file] [-server url] [-token token] [-o table|json|yaml] <command>
+
+commands:
+  team add <team> [-parent team] -member <user_id>:<username>[:seniority[:role]] ...
+  team add -f team.json
+  team get <team> [-descendants]
+  team update <team> (-parent team | -root)
+  user activate <user_id> [-team team]
+  user deactivate <user_id> [-team team]
+  pr create -name title -author <user_id> [-id uuid] [-team team] [-file path ...] [-label label ...]
+  pr merge <pull_request_id>
+  pr reassign <pull_request_id> <old_user_id>
+  pr show <pull_request_id>
+  stats [-team team] [-descendants]
+`
+
+type command func(ctx context.Context, app *app, args []string) error
+
+var commands = map[string]command{
+	"team add":        teamAdd,
+	"team get":        teamGet,
+	"team update":     teamUpdate,
+	"user activate":   userActivate,
+	"user deactivate": userDeactivate,
+	"pr create":       prCreate,
+	"pr merge":        prMerge,
+	"pr reassign":     prReassign,
+	"pr show":         prShow,
+	"stats":           stats,
+}
+
+type app struct {
+	client  *client.Client
+	printer *printer
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usageText)
+		fmt.Fprintln(os.Stderr, "\nflags:")
+		flag.PrintDefaults()
+	}
+	configPath := flag.String("config", "", "файл настроек (по умолчанию "+defaultConfigPath()+")")
+	server := flag.String("server", "", "адрес сервиса, например http://localhost:8080")
+	token := flag.String("token", "", "токен для заголовка Authorization")
+	output := flag.String("o", "", "формат вывода: table, json или yaml")
+	timeout := flag.Duration("timeout", 30*time.Second, "таймаут запроса")
+	flag.Parse()
+
+	path, explicit := *configPath, *configPath != ""
+	if !explicit {
+		path = defaultConfigPath()
+	}
+	cfg, err := loadConfig(path, explicit)
+	if err != nil {
+		fail(err)
+	}
+	if *server != "" {
+		cfg.Server = *server
+	}
+	if *token != "" {
+		cfg.Token = *token
+	}
+	if *output != "" {
+		cfg.Output = *output
+	}
+	if !outputFormat(cfg.Output).Valid() {
+		fail(fmt.Errorf("unknown output format %q", cfg.Output))
+	}
+
+	name, args := commandName(flag.Args())
+	cmd, ok := commands[name]
+	if !ok {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var opts []client.Option
+	if cfg.Token != "" {
+		opts = append(opts, client.WithToken(cfg.Token))
+	}
+	a := &app{
+		client:  client.New(cfg.Server, opts...),
+		printer: &printer{out: os.Stdout, format: outputFormat(cfg.Output)},
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	if err := cmd(ctx, a, args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		fail(err)
+	}
+}
+
+// commandName отделяет имя команды из одного или двух слов от её аргументов.
+func commandName(args []string) (string, []string) {
+	if len(args) >= 2 {
+		if name := args[0] + " " + args[1]; commands[name] != nil {
+			return name, args[2:]
+		}
+	}
+	if len(args) >= 1 {
+		return args[0], args[1:]
+	}
+	return "", nil
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "prsctl:", err)
+	os.Exit(1)
+}
+
+// parseArgs разбирает флаги, стоящие в любом месте среди позиционных аргументов, и возвращает последние.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// stringList — флаг, который можно указать несколько раз.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+type outputFormat string
+
+const (
+	outputTable outputFormat = "table"
+	outputJSON  outputFormat = "json"
+	outputYAML  outputFormat = "yaml"
+)
+
+func (f outputFormat) Valid() bool {
+	switch f {
+	case outputTable, outputJSON, outputYAML:
+		return true
+	}
+	return false
+}
+
+type printer struct {
+	out    io.Writer
+	format outputFormat
+}
+
+// print выводит v в JSON или YAML с полями как в API, а в табличном формате — функцией table.
+func (p *printer) print(v any, table func(w *tabwriter.Writer)) error {
+	switch p.format {
+	case outputJSON:
+		enc := json.NewEncoder(p.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case outputYAML:
+		return writeYAML(p.out, v)
+	}
+
+	tw := tabwriter.NewWriter(p.out, 0, 0, 2, ' ', 0)
+	table(tw)
+	return tw.Flush()
+}
+
+// writeYAML кодирует v через JSON: так имена полей берутся из json-тегов моделей API,
+// а порядок полей сохраняется.
+func writeYAML(w io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return err
+	}
+	blockStyle(&node)
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// blockStyle снимает с узлов JSON-стиль ({...}, [...], кавычки), оставляя обычный YAML.
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// row пишет строку таблицы из значений, разделённых табуляцией.
+func row(w io.Writer, values ...any) {
+	var buf bytes.Buffer
+	for i, v := range values {
+		if i > 0 {
+			buf.WriteByte('\t')
+		}
+		fmt.Fprint(&buf, v)
+	}
+	buf.WriteByte('\n')
+	_, _ = w.Write(buf.Bytes())
+}
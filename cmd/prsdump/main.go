@@ -0,0 +1,145 @@
+// Команда prsdump выгружает данные PRS (команды, пользователей, PR и историю назначений)
+// и восстанавливает их в пустую базу с сохранением идентификаторов и времени:
+//
+//	prsdump export [-format ndjson|zip] [-o файл]
+//	prsdump restore [-format ndjson|zip] файл
+//
+// База задаётся флагом -database-url или переменной DATABASE_URL.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/F3dosik/PRS.git/internal/dump"
+	"github.com/F3dosik/PRS.git/internal/repository"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = runExport(os.Args[2:])
+	case "restore":
+		err = runRestore(os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: prsdump export [-format ndjson|zip] [-o file]")
+	fmt.Fprintln(os.Stderr, "       prsdump restore [-format ndjson|zip] file")
+	os.Exit(2)
+}
+
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	databaseURL := fs.String("database-url", os.Getenv("DATABASE_URL"), "строка подключения к базе")
+	format := fs.String("format", string(dump.FormatNDJSON), "формат выгрузки: ndjson или zip")
+	output := fs.String("o", "", "файл выгрузки (по умолчанию stdout)")
+	_ = fs.Parse(args)
+
+	if !dump.Format(*format).Valid() {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	storage, err := openStorage(*databaseURL)
+	if err != nil {
+		return err
+	}
+	defer storage.Close()
+
+	var out io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		out = f
+	}
+
+	w, err := dump.NewWriter(dump.Format(*format), out)
+	if err != nil {
+		return err
+	}
+	if err := storage.Export(context.Background(), w); err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+
+	return w.Close()
+}
+
+func runRestore(args []string) error {
+	fs := flag.NewFlagSet("restore", flag.ExitOnError)
+	databaseURL := fs.String("database-url", os.Getenv("DATABASE_URL"), "строка подключения к базе")
+	format := fs.String("format", "", "формат выгрузки: ndjson или zip (по умолчанию по расширению файла)")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		usage()
+	}
+	path := fs.Arg(0)
+
+	if *format == "" {
+		*format = string(dump.FormatNDJSON)
+		if filepath.Ext(path) == ".zip" {
+			*format = string(dump.FormatZip)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	var r repository.DumpReader
+	switch dump.Format(*format) {
+	case dump.FormatNDJSON:
+		r = dump.NewNDJSONReader(f)
+	case dump.FormatZip:
+		info, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		if r, err = dump.NewZipReader(f, info.Size()); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	storage, err := openStorage(*databaseURL)
+	if err != nil {
+		return err
+	}
+	defer storage.Close()
+
+	if err := storage.Restore(context.Background(), r); err != nil {
+		return fmt.Errorf("restore: %w", err)
+	}
+
+	return nil
+}
+
+func openStorage(databaseURL string) (*repository.Storage, error) {
+	if databaseURL == "" {
+		return nil, errors.New("database url is required: set -database-url or DATABASE_URL")
+	}
+	return repository.NewStorage(databaseURL, repository.PoolConfig{ApplicationName: "prsdump"})
+}
@@ -0,0 +1,161 @@
+package assignment
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// AlgorithmVersion меняется при любом изменении Decide или Rank, влияющем на результат:
+// решения, принятые другой версией, могут не воспроизводиться.
+const AlgorithmVersion = 3
+
+// Причины, по которым кандидат не участвует в выборе.
+const (
+	ReasonInactive      = "inactive"
+	ReasonExcluded      = "excluded"       // Автор или уже назначенный ревьювер
+	ReasonAlreadyPicked = "already_picked" // Выбран или рассмотрен в предыдущем пуле
+	// Допущен, но последний свободный слот оставлен за senior
+	ReasonSeniorRequired = "senior_required"
+)
+
+// Pool — источник кандидатов в порядке предпочтения.
+type Pool struct {
+	Source     string      `json:"source"`
+	TeamName   *string     `json:"team_name,omitempty"`
+	Candidates []Candidate `json:"candidates"`
+}
+
+// Input — полный снимок данных, на основе которого принимается решение.
+// RequireSenior требует хотя бы одного senior среди ревьюверов с учётом остающихся на PR
+// (HasSenior — среди них уже есть senior). Shadow — выбрать дополнительно одного junior
+// теневым ревьювером сверх Limit.
+type Input struct {
+	Pools         []Pool      `json:"pools"`
+	Labels        []string    `json:"labels,omitempty"`
+	Exclude       []uuid.UUID `json:"exclude"`
+	Limit         int         `json:"limit"`
+	Weights       Weights     `json:"weights"`
+	RequireSenior bool        `json:"require_senior,omitempty"`
+	HasSenior     bool        `json:"has_senior,omitempty"`
+	Shadow        bool        `json:"shadow,omitempty"`
+}
+
+type Pick struct {
+	UserID uuid.UUID
+	Pool   int
+}
+
+// Evaluation — как кандидат был рассмотрен в конкретном пуле.
+type Evaluation struct {
+	UserID   uuid.UUID
+	Pool     int
+	Eligible bool
+	Reason   string
+	Score    float64
+	Rank     int // Позиция среди допущенных кандидатов пула, начиная с 1
+	Picked   bool
+	Shadow   bool
+}
+
+type Decision struct {
+	Seed        uint64
+	Version     int
+	Picks       []Pick
+	Shadow      *Pick
+	Evaluations []Evaluation
+}
+
+// Complete сообщает, заняты ли все запрошенные слоты: по решению, принятому на части пулов,
+// определяется, нужно ли рассматривать следующие.
+func (d Decision) Complete(in Input) bool {
+	return len(d.Picks) >= in.Limit && (!in.Shadow || d.Shadow != nil)
+}
+
+// Decide выбирает до in.Limit ревьюверов, обходя пулы по порядку. Результат полностью
+// определяется входными данными и seed.
+func Decide(in Input, seed uint64) Decision {
+	decision := Decision{Seed: seed, Version: AlgorithmVersion}
+	seen := make(map[uuid.UUID]bool)
+	needSenior := in.RequireSenior && !in.HasSenior
+
+	for poolIdx, pool := range in.Pools {
+		rnd := rand.New(rand.NewPCG(seed, uint64(poolIdx)))
+
+		var eligible []Candidate
+		for _, c := range pool.Candidates {
+			eval := Evaluation{UserID: c.UserID, Pool: poolIdx}
+			switch {
+			case slices.Contains(in.Exclude, c.UserID):
+				eval.Reason = ReasonExcluded
+			case seen[c.UserID]:
+				eval.Reason = ReasonAlreadyPicked
+			case !c.Active:
+				eval.Reason = ReasonInactive
+			default:
+				eval.Eligible = true
+				eval.Score = Score(c, in.Labels, in.Weights)
+				eligible = append(eligible, c)
+			}
+			decision.Evaluations = append(decision.Evaluations, eval)
+		}
+
+		ranked := Rank(eligible, in.Labels, in.Weights, rnd)
+		for rank, c := range ranked {
+			eval := findEvaluation(decision.Evaluations, poolIdx, c.UserID)
+			eval.Rank = rank + 1
+
+			free := in.Limit - len(decision.Picks)
+			switch {
+			case free <= 0:
+			case needSenior && free == 1 && c.Seniority != SenioritySenior:
+				eval.Reason = ReasonSeniorRequired
+			default:
+				eval.Picked = true
+				decision.Picks = append(decision.Picks, Pick{UserID: c.UserID, Pool: poolIdx})
+				needSenior = needSenior && c.Seniority != SenioritySenior
+			}
+		}
+
+		if in.Shadow && decision.Shadow == nil {
+			for _, c := range ranked {
+				eval := findEvaluation(decision.Evaluations, poolIdx, c.UserID)
+				if !eval.Picked && c.Seniority == SeniorityJunior {
+					eval.Shadow = true
+					decision.Shadow = &Pick{UserID: c.UserID, Pool: poolIdx}
+					break
+				}
+			}
+		}
+
+		// Рассмотренный пользователь не участвует в следующих пулах повторно
+		for _, c := range pool.Candidates {
+			seen[c.UserID] = seen[c.UserID] || c.Active
+		}
+	}
+
+	return decision
+}
+
+func findEvaluation(evals []Evaluation, pool int, userID uuid.UUID) *Evaluation {
+	for i := len(evals) - 1; i >= 0; i-- {
+		if evals[i].Pool == pool && evals[i].UserID == userID {
+			return &evals[i]
+		}
+	}
+	return nil
+}
+
+// NewSeedSource возвращает детерминированную последовательность seed'ов, порождённую seed.
+// Источник безопасен для конкурентного использования.
+func NewSeedSource(seed uint64) func() uint64 {
+	var mu sync.Mutex
+	rnd := rand.New(rand.NewPCG(seed, seed))
+	return func() uint64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return rnd.Uint64()
+	}
+}
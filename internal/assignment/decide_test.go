@@ -0,0 +1,240 @@
+package assignment
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestDecide(t *testing.T) {
+	author := userID(100)
+	// Навыки задают порядок кандидатов, чтобы результат не зависел от перемешивания равных
+	labels := []string{"a", "b", "c"}
+	candidate := func(n byte, seniority string, skills ...string) Candidate {
+		return Candidate{UserID: userID(n), Active: true, Seniority: seniority, Skills: skills}
+	}
+
+	tests := []struct {
+		name          string
+		in            Input
+		wantPicks     []uuid.UUID
+		wantShadow    *uuid.UUID
+		wantReserved  []uuid.UUID // Кандидаты с причиной ReasonSeniorRequired
+		wantPickPools []int
+	}{
+		{
+			name: "without rules best candidates win",
+			in: Input{
+				Pools: []Pool{{Candidates: []Candidate{
+					candidate(1, "junior", "a", "b", "c"),
+					candidate(2, "middle", "a", "b"),
+					candidate(3, "senior", "a"),
+				}}},
+				Limit: 2,
+			},
+			wantPicks:     []uuid.UUID{userID(1), userID(2)},
+			wantPickPools: []int{0, 0},
+		},
+		{
+			name: "last slot reserved for senior",
+			in: Input{
+				Pools: []Pool{{Candidates: []Candidate{
+					candidate(1, "junior", "a", "b", "c"),
+					candidate(2, "middle", "a", "b"),
+					candidate(3, "senior", "a"),
+				}}},
+				Limit:         2,
+				RequireSenior: true,
+			},
+			wantPicks:     []uuid.UUID{userID(1), userID(3)},
+			wantReserved:  []uuid.UUID{userID(2)},
+			wantPickPools: []int{0, 0},
+		},
+		{
+			name: "senior picked first frees the last slot",
+			in: Input{
+				Pools: []Pool{{Candidates: []Candidate{
+					candidate(1, "senior", "a", "b", "c"),
+					candidate(2, "middle", "a", "b"),
+					candidate(3, "junior", "a"),
+				}}},
+				Limit:         2,
+				RequireSenior: true,
+			},
+			wantPicks:     []uuid.UUID{userID(1), userID(2)},
+			wantPickPools: []int{0, 0},
+		},
+		{
+			name: "senior already on the PR",
+			in: Input{
+				Pools: []Pool{{Candidates: []Candidate{
+					candidate(1, "junior", "a", "b", "c"),
+					candidate(2, "middle", "a", "b"),
+					candidate(3, "senior", "a"),
+				}}},
+				Limit:         1,
+				RequireSenior: true,
+				HasSenior:     true,
+			},
+			wantPicks:     []uuid.UUID{userID(1)},
+			wantPickPools: []int{0},
+		},
+		{
+			name: "senior found in a later pool",
+			in: Input{
+				Pools: []Pool{
+					{Candidates: []Candidate{
+						candidate(1, "junior", "a", "b", "c"),
+						candidate(2, "middle", "a", "b"),
+					}},
+					{Candidates: []Candidate{
+						candidate(3, "senior"),
+					}},
+				},
+				Limit:         2,
+				RequireSenior: true,
+			},
+			wantPicks:     []uuid.UUID{userID(1), userID(3)},
+			wantReserved:  []uuid.UUID{userID(2)},
+			wantPickPools: []int{0, 1},
+		},
+		{
+			name: "no senior leaves the slot empty",
+			in: Input{
+				Pools: []Pool{{Candidates: []Candidate{
+					candidate(1, "junior", "a", "b"),
+					candidate(2, "middle", "a"),
+				}}},
+				Limit:         2,
+				RequireSenior: true,
+			},
+			wantPicks:     []uuid.UUID{userID(1)},
+			wantReserved:  []uuid.UUID{userID(2)},
+			wantPickPools: []int{0},
+		},
+		{
+			name: "shadow is the best junior not picked",
+			in: Input{
+				Pools: []Pool{{Candidates: []Candidate{
+					candidate(1, "junior", "a", "b", "c"),
+					candidate(2, "middle", "a", "b"),
+					candidate(3, "junior", "a"),
+					candidate(4, "junior"),
+				}}},
+				Limit:  2,
+				Shadow: true,
+			},
+			wantPicks:     []uuid.UUID{userID(1), userID(2)},
+			wantShadow:    ptr(userID(3)),
+			wantPickPools: []int{0, 0},
+		},
+		{
+			name: "shadow skips excluded and inactive juniors",
+			in: Input{
+				Pools: []Pool{
+					{Candidates: []Candidate{
+						candidate(1, "middle", "a"),
+						candidate(2, "junior", "a", "b", "c"),
+						{UserID: userID(3), Seniority: "junior", Skills: []string{"a", "b"}},
+					}},
+					{Candidates: []Candidate{
+						candidate(4, "junior"),
+					}},
+				},
+				Exclude: []uuid.UUID{author, userID(2)},
+				Limit:   1,
+				Shadow:  true,
+			},
+			wantPicks:     []uuid.UUID{userID(1)},
+			wantShadow:    ptr(userID(4)),
+			wantPickPools: []int{0},
+		},
+		{
+			name: "no junior means no shadow",
+			in: Input{
+				Pools: []Pool{{Candidates: []Candidate{
+					candidate(1, "middle", "a"),
+					candidate(2, "senior"),
+				}}},
+				Limit:  1,
+				Shadow: true,
+			},
+			wantPicks:     []uuid.UUID{userID(1)},
+			wantPickPools: []int{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := tt.in
+			in.Labels = labels
+			in.Weights = DefaultWeights
+			if in.Exclude == nil {
+				in.Exclude = []uuid.UUID{author}
+			}
+
+			for seed := uint64(0); seed < 5; seed++ {
+				decision := Decide(in, seed)
+
+				var picks []uuid.UUID
+				var pools []int
+				for _, p := range decision.Picks {
+					picks = append(picks, p.UserID)
+					pools = append(pools, p.Pool)
+				}
+				if !slices.Equal(picks, tt.wantPicks) {
+					t.Fatalf("seed %d: picks %v, want %v", seed, picks, tt.wantPicks)
+				}
+				if !slices.Equal(pools, tt.wantPickPools) {
+					t.Errorf("seed %d: pick pools %v, want %v", seed, pools, tt.wantPickPools)
+				}
+
+				switch {
+				case tt.wantShadow == nil && decision.Shadow != nil:
+					t.Errorf("seed %d: unexpected shadow %v", seed, decision.Shadow.UserID)
+				case tt.wantShadow != nil && (decision.Shadow == nil || decision.Shadow.UserID != *tt.wantShadow):
+					t.Errorf("seed %d: shadow %v, want %v", seed, decision.Shadow, *tt.wantShadow)
+				}
+
+				var reserved []uuid.UUID
+				for _, eval := range decision.Evaluations {
+					if eval.Reason == ReasonSeniorRequired {
+						reserved = append(reserved, eval.UserID)
+					}
+				}
+				if !slices.Equal(reserved, tt.wantReserved) {
+					t.Errorf("seed %d: senior_required %v, want %v", seed, reserved, tt.wantReserved)
+				}
+
+				if got, want := decision.Complete(in), len(tt.wantPicks) == in.Limit && (!in.Shadow || tt.wantShadow != nil); got != want {
+					t.Errorf("seed %d: Complete() = %v, want %v", seed, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDecideReproducible(t *testing.T) {
+	var candidates []Candidate
+	for n := byte(1); n <= 8; n++ {
+		candidates = append(candidates, Candidate{UserID: userID(n), Active: true, Seniority: SeniorityJunior})
+	}
+	in := Input{
+		Pools:   []Pool{{Candidates: candidates}},
+		Exclude: []uuid.UUID{userID(100)},
+		Limit:   2,
+		Weights: DefaultWeights,
+		Shadow:  true,
+	}
+
+	first := Decide(in, 42)
+	second := Decide(in, 42)
+	if !slices.Equal(first.Picks, second.Picks) || *first.Shadow != *second.Shadow {
+		t.Errorf("same seed gave different decisions: %v/%v and %v/%v", first.Picks, *first.Shadow, second.Picks, *second.Shadow)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
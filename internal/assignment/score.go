@@ -0,0 +1,84 @@
+// Package assignment содержит не зависящую от хранилища логику выбора ревьюверов.
+package assignment
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Candidate — пользователь из пула кандидатов в ревьюверы.
+type Candidate struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Active    bool      `json:"active"` // Активен сам пользователь и его членство в команде пула
+	Seniority string    `json:"seniority,omitempty"`
+	Skills    []string  `json:"skills,omitempty"`
+	Load      int       `json:"load"`             // Число открытых PR, где пользователь уже ревьювер
+	Recent    int       `json:"recent,omitempty"` // Сколько из последних PR автора пользователь ревьюил
+}
+
+const (
+	SeniorityJunior = "junior"
+	SenioritySenior = "senior"
+)
+
+// Weights задаёт вклад совпадения навыков, текущей загрузки и повторения пары автор–ревьювер
+// в оценку кандидата.
+type Weights struct {
+	Skill    float64 `json:"skill"`
+	Load     float64 `json:"load"`
+	Rotation float64 `json:"rotation,omitempty"`
+}
+
+// DefaultWeights: одно совпадение навыка с меткой PR перевешивает одно открытое ревью,
+// но не два.
+var DefaultWeights = Weights{Skill: 1, Load: 0.6}
+
+// NormalizeTag приводит навык или метку к каноническому виду.
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// Overlap возвращает количество меток PR, покрытых навыками кандидата.
+func Overlap(skills, labels []string) int {
+	matched := 0
+	for _, label := range labels {
+		label = NormalizeTag(label)
+		if slices.ContainsFunc(skills, func(skill string) bool { return NormalizeTag(skill) == label }) {
+			matched++
+		}
+	}
+	return matched
+}
+
+// Score — оценка кандидата для PR с метками labels: чем больше, тем предпочтительнее.
+func Score(c Candidate, labels []string, w Weights) float64 {
+	return w.Skill*float64(Overlap(c.Skills, labels)) - w.Load*float64(c.Load) - w.Rotation*float64(c.Recent)
+}
+
+// Rank упорядочивает кандидатов по убыванию оценки. Кандидаты с равной оценкой
+// перемешиваются источником rnd, чтобы нагрузка распределялась равномерно,
+// а результат воспроизводился при том же состоянии источника.
+func Rank(candidates []Candidate, labels []string, w Weights, rnd *rand.Rand) []Candidate {
+	ranked := slices.Clone(candidates)
+	rnd.Shuffle(len(ranked), func(i, j int) { ranked[i], ranked[j] = ranked[j], ranked[i] })
+
+	scores := make(map[uuid.UUID]float64, len(ranked))
+	for _, c := range ranked {
+		scores[c.UserID] = Score(c, labels, w)
+	}
+	slices.SortStableFunc(ranked, func(a, b Candidate) int {
+		switch sa, sb := scores[a.UserID], scores[b.UserID]; {
+		case sa > sb:
+			return -1
+		case sa < sb:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return ranked
+}
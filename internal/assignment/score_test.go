@@ -0,0 +1,172 @@
+package assignment
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func userID(n byte) uuid.UUID {
+	return uuid.UUID{15: n}
+}
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		name      string
+		candidate Candidate
+		labels    []string
+		weights   Weights
+		want      float64
+	}{
+		{
+			name:      "no labels and no load",
+			candidate: Candidate{Skills: []string{"go"}},
+			weights:   DefaultWeights,
+			want:      0,
+		},
+		{
+			name:      "overlap ignores case and spaces",
+			candidate: Candidate{Skills: []string{"Go", " postgres "}},
+			labels:    []string{"go", "POSTGRES", "frontend"},
+			weights:   DefaultWeights,
+			want:      2,
+		},
+		{
+			name:      "load lowers score",
+			candidate: Candidate{Load: 2},
+			weights:   DefaultWeights,
+			want:      -1.2,
+		},
+		{
+			name:      "one skill outweighs one open review",
+			candidate: Candidate{Skills: []string{"go"}, Load: 1},
+			labels:    []string{"go"},
+			weights:   DefaultWeights,
+			want:      0.4,
+		},
+		{
+			name:      "one skill does not outweigh two open reviews",
+			candidate: Candidate{Skills: []string{"go"}, Load: 2},
+			labels:    []string{"go"},
+			weights:   DefaultWeights,
+			want:      -0.2,
+		},
+		{
+			name:      "rotation penalty per recent review",
+			candidate: Candidate{Skills: []string{"go"}, Recent: 3},
+			labels:    []string{"go"},
+			weights:   Weights{Skill: 1, Load: 0.6, Rotation: 0.5},
+			want:      -0.5,
+		},
+		{
+			name:      "duplicate skills count a label once",
+			candidate: Candidate{Skills: []string{"go", "GO"}},
+			labels:    []string{"go"},
+			weights:   DefaultWeights,
+			want:      1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Score(tt.candidate, tt.labels, tt.weights)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Score() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRank(t *testing.T) {
+	tied := []Candidate{
+		{UserID: userID(1)},
+		{UserID: userID(2)},
+		{UserID: userID(3)},
+		{UserID: userID(4)},
+		{UserID: userID(5)},
+	}
+
+	tests := []struct {
+		name       string
+		candidates []Candidate
+		labels     []string
+		// wantFirst — префикс результата, не зависящий от seed
+		wantFirst []uuid.UUID
+	}{
+		{
+			name: "higher score first",
+			candidates: []Candidate{
+				{UserID: userID(1), Load: 3},
+				{UserID: userID(2), Skills: []string{"go"}},
+				{UserID: userID(3), Load: 1},
+			},
+			labels:    []string{"go"},
+			wantFirst: []uuid.UUID{userID(2), userID(3), userID(1)},
+		},
+		{
+			name:       "all tied",
+			candidates: tied,
+		},
+		{
+			name: "tie below a clear leader",
+			candidates: append([]Candidate{
+				{UserID: userID(9), Skills: []string{"go"}},
+			}, tied...),
+			labels:    []string{"go"},
+			wantFirst: []uuid.UUID{userID(9)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := slices.Clone(tt.candidates)
+			for seed := uint64(0); seed < 20; seed++ {
+				first := ids(Rank(tt.candidates, tt.labels, DefaultWeights, rand.New(rand.NewPCG(seed, 0))))
+				second := ids(Rank(tt.candidates, tt.labels, DefaultWeights, rand.New(rand.NewPCG(seed, 0))))
+				if !slices.Equal(first, second) {
+					t.Fatalf("seed %d: order differs between runs: %v and %v", seed, first, second)
+				}
+				if len(first) != len(tt.candidates) {
+					t.Fatalf("seed %d: got %d candidates, want %d", seed, len(first), len(tt.candidates))
+				}
+				if !slices.Equal(first[:len(tt.wantFirst)], tt.wantFirst) {
+					t.Errorf("seed %d: order %v, want prefix %v", seed, first, tt.wantFirst)
+				}
+			}
+			if !slices.EqualFunc(input, tt.candidates, func(a, b Candidate) bool { return a.UserID == b.UserID }) {
+				t.Errorf("Rank modified its input")
+			}
+		})
+	}
+}
+
+func TestRankShufflesTies(t *testing.T) {
+	var candidates []Candidate
+	for n := byte(1); n <= 5; n++ {
+		candidates = append(candidates, Candidate{UserID: userID(n)})
+	}
+
+	orders := make(map[string]bool)
+	for seed := uint64(0); seed < 20; seed++ {
+		ranked := Rank(candidates, nil, DefaultWeights, rand.New(rand.NewPCG(seed, 0)))
+		key := ""
+		for _, id := range ids(ranked) {
+			key += id.String()
+		}
+		orders[key] = true
+	}
+	if len(orders) < 2 {
+		t.Errorf("tied candidates got the same order for every seed")
+	}
+}
+
+func ids(candidates []Candidate) []uuid.UUID {
+	out := make([]uuid.UUID, 0, len(candidates))
+	for _, c := range candidates {
+		out = append(out, c.UserID)
+	}
+	return out
+}
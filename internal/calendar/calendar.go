@@ -0,0 +1,195 @@
+// Package calendar считает рабочее время с учётом часового пояса, рабочих дней и праздников.
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const minutesPerDay = 24 * 60
+
+// maxDays ограничивает перебор дней, чтобы расписание почти без рабочих дней не зацикливало расчёт.
+const maxDays = 366 * 5
+
+var ErrInvalidSchedule = errors.New("invalid schedule")
+
+// Holiday — нерабочий день. Year == 0 означает ежегодный праздник.
+type Holiday struct {
+	Year  int
+	Month time.Month
+	Day   int
+	Name  string
+}
+
+func (h Holiday) matches(year int, month time.Month, day int) bool {
+	return (h.Year == 0 || h.Year == year) && h.Month == month && h.Day == day
+}
+
+// Schedule — рабочее расписание: рабочие дни недели и интервал [Start, End) в минутах от полуночи
+// по местному времени Location.
+type Schedule struct {
+	Location *time.Location
+	Days     [7]bool // Индекс — time.Weekday
+	Start    int
+	End      int
+	Holidays []Holiday
+}
+
+// AlwaysOpen — расписание без выходных: рабочее время совпадает с календарным.
+func AlwaysOpen() Schedule {
+	return Schedule{
+		Location: time.UTC,
+		Days:     [7]bool{true, true, true, true, true, true, true},
+		End:      minutesPerDay,
+	}
+}
+
+func (s Schedule) Validate() error {
+	if s.Location == nil {
+		return fmt.Errorf("%w: time zone is required", ErrInvalidSchedule)
+	}
+	if s.Start < 0 || s.End > minutesPerDay || s.Start >= s.End {
+		return fmt.Errorf("%w: work hours must satisfy 00:00 <= start < end <= 24:00", ErrInvalidSchedule)
+	}
+	for _, working := range s.Days {
+		if working {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: at least one work day is required", ErrInvalidSchedule)
+}
+
+// window возвращает рабочий интервал даты или ok == false для выходного и праздника.
+func (s Schedule) window(year int, month time.Month, day int) (open, closeAt time.Time, ok bool) {
+	date := time.Date(year, month, day, 0, 0, 0, 0, s.Location)
+	// Вызывающие перебирают дни как day+i: 31 апреля должно совпасть с праздником 1 мая
+	year, month, day = date.Date()
+	if !s.Days[date.Weekday()] {
+		return time.Time{}, time.Time{}, false
+	}
+	for _, h := range s.Holidays {
+		if h.matches(year, month, day) {
+			return time.Time{}, time.Time{}, false
+		}
+	}
+
+	// time.Date сам корректирует переходы на летнее время
+	open = time.Date(year, month, day, s.Start/60, s.Start%60, 0, 0, s.Location)
+	closeAt = time.Date(year, month, day, s.End/60, s.End%60, 0, 0, s.Location)
+	return open, closeAt, true
+}
+
+// IsWorking сообщает, приходится ли момент t на рабочее время.
+func (s Schedule) IsWorking(t time.Time) bool {
+	local := t.In(s.Location)
+	open, closeAt, ok := s.window(local.Date())
+	return ok && !t.Before(open) && t.Before(closeAt)
+}
+
+// NextOpen возвращает ближайший момент рабочего времени, не раньше t.
+func (s Schedule) NextOpen(t time.Time) time.Time {
+	local := t.In(s.Location)
+	year, month, day := local.Date()
+	for i := 0; i < maxDays; i++ {
+		open, closeAt, ok := s.window(year, month, day+i)
+		if ok && closeAt.After(t) {
+			if open.After(t) {
+				return open
+			}
+			return t
+		}
+	}
+	return t
+}
+
+// Add прибавляет к start d рабочего времени.
+func (s Schedule) Add(start time.Time, d time.Duration) time.Time {
+	if d <= 0 {
+		return start
+	}
+
+	local := start.In(s.Location)
+	year, month, day := local.Date()
+	remaining := d
+	for i := 0; i < maxDays; i++ {
+		open, closeAt, ok := s.window(year, month, day+i)
+		if !ok || !closeAt.After(start) {
+			continue
+		}
+		from := open
+		if start.After(from) {
+			from = start
+		}
+		if available := closeAt.Sub(from); available >= remaining {
+			return from.Add(remaining)
+		} else {
+			remaining -= available
+		}
+	}
+	return start.Add(d)
+}
+
+// Between возвращает рабочее время в интервале [from, to).
+func (s Schedule) Between(from, to time.Time) time.Duration {
+	if !to.After(from) {
+		return 0
+	}
+
+	local := from.In(s.Location)
+	year, month, day := local.Date()
+	var total time.Duration
+	for i := 0; i < maxDays; i++ {
+		open, closeAt, ok := s.window(year, month, day+i)
+		if ok {
+			if open.Before(from) {
+				open = from
+			}
+			if closeAt.After(to) {
+				closeAt = to
+			}
+			if closeAt.After(open) {
+				total += closeAt.Sub(open)
+			}
+		}
+		if !time.Date(year, month, day+i+1, 0, 0, 0, 0, s.Location).Before(to) {
+			break
+		}
+	}
+	return total
+}
+
+var weekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// ParseWeekday разбирает сокращённое имя дня недели: "mon", "tue", ...
+func ParseWeekday(name string) (time.Weekday, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for i, day := range weekdays {
+		if day == name {
+			return time.Weekday(i), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: unknown weekday %q", ErrInvalidSchedule, name)
+}
+
+func FormatWeekday(day time.Weekday) string {
+	return weekdays[day]
+}
+
+// ParseClock разбирает время суток "HH:MM" в минуты от полуночи; "24:00" обозначает конец суток.
+func ParseClock(value string) (int, error) {
+	var hours, minutes int
+	if _, err := fmt.Sscanf(value, "%d:%d", &hours, &minutes); err != nil || len(value) != 5 {
+		return 0, fmt.Errorf("%w: time must be HH:MM, got %q", ErrInvalidSchedule, value)
+	}
+	total := hours*60 + minutes
+	if hours < 0 || minutes < 0 || minutes > 59 || total > minutesPerDay {
+		return 0, fmt.Errorf("%w: time out of range %q", ErrInvalidSchedule, value)
+	}
+	return total, nil
+}
+
+func FormatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,308 @@
+package calendar
+
+import (
+	"errors"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+// officeHours — пн–пт 09:00–18:00 в Берлине, 1 мая — ежегодный праздник, 10 марта 2025 — разовый.
+func officeHours(t *testing.T) Schedule {
+	return Schedule{
+		Location: mustLocation(t, "Europe/Berlin"),
+		Days:     [7]bool{time.Monday: true, time.Tuesday: true, time.Wednesday: true, time.Thursday: true, time.Friday: true},
+		Start:    9 * 60,
+		End:      18 * 60,
+		Holidays: []Holiday{
+			{Month: time.May, Day: 1, Name: "Labour Day"},
+			{Year: 2025, Month: time.March, Day: 10, Name: "Day off"},
+		},
+	}
+}
+
+// allDay — круглосуточно без выходных, но в часовом поясе с переходом на летнее время.
+func allDay(t *testing.T) Schedule {
+	s := AlwaysOpen()
+	s.Location = mustLocation(t, "Europe/Berlin")
+	return s
+}
+
+func TestScheduleAdd(t *testing.T) {
+	berlin := mustLocation(t, "Europe/Berlin")
+	at := func(year int, month time.Month, day, hour, min int) time.Time {
+		return time.Date(year, month, day, hour, min, 0, 0, berlin)
+	}
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		start    time.Time
+		d        time.Duration
+		want     time.Time
+	}{
+		{
+			name:     "within one day",
+			schedule: officeHours(t),
+			start:    at(2025, time.March, 5, 10, 0),
+			d:        3 * time.Hour,
+			want:     at(2025, time.March, 5, 13, 0),
+		},
+		{
+			name:     "ends exactly at closing",
+			schedule: officeHours(t),
+			start:    at(2025, time.March, 5, 10, 0),
+			d:        8 * time.Hour,
+			want:     at(2025, time.March, 5, 18, 0),
+		},
+		{
+			name:     "before opening starts at opening",
+			schedule: officeHours(t),
+			start:    at(2025, time.March, 5, 7, 30),
+			d:        time.Hour,
+			want:     at(2025, time.March, 5, 10, 0),
+		},
+		{
+			name:     "friday evening rolls over to monday",
+			schedule: officeHours(t),
+			start:    at(2025, time.March, 14, 17, 0),
+			d:        4 * time.Hour,
+			want:     at(2025, time.March, 17, 12, 0),
+		},
+		{
+			name:     "after friday closing starts on monday",
+			schedule: officeHours(t),
+			start:    at(2025, time.March, 14, 20, 0),
+			d:        2 * time.Hour,
+			want:     at(2025, time.March, 17, 11, 0),
+		},
+		{
+			name:     "weekend and one-off holiday are skipped",
+			schedule: officeHours(t),
+			start:    at(2025, time.March, 7, 17, 0),
+			d:        2 * time.Hour,
+			want:     at(2025, time.March, 11, 10, 0),
+		},
+		{
+			name:     "yearly holiday in another year",
+			schedule: officeHours(t),
+			start:    at(2026, time.April, 30, 17, 0),
+			d:        2 * time.Hour,
+			want:     at(2026, time.May, 4, 10, 0),
+		},
+		{
+			name:     "several days",
+			schedule: officeHours(t),
+			start:    at(2025, time.March, 11, 9, 0),
+			d:        20 * time.Hour,
+			want:     at(2025, time.March, 13, 11, 0),
+		},
+		{
+			name:     "zero duration",
+			schedule: officeHours(t),
+			start:    at(2025, time.March, 15, 12, 0),
+			want:     at(2025, time.March, 15, 12, 0),
+		},
+		{
+			name:     "spring forward day is 23 hours long",
+			schedule: allDay(t),
+			start:    at(2025, time.March, 30, 0, 0),
+			d:        24 * time.Hour,
+			want:     at(2025, time.March, 31, 1, 0),
+		},
+		{
+			name:     "fall back day is 25 hours long",
+			schedule: allDay(t),
+			start:    at(2025, time.October, 26, 0, 0),
+			d:        24 * time.Hour,
+			want:     at(2025, time.October, 26, 23, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.schedule.Add(tt.start, tt.d)
+			if !got.Equal(tt.want) {
+				t.Fatalf("Add(%v, %v) = %v, want %v", tt.start, tt.d, got.In(berlin), tt.want)
+			}
+			// Add и Between согласованы: между началом и результатом ровно d рабочего времени
+			if between := tt.schedule.Between(tt.start, got); between != tt.d {
+				t.Fatalf("Between(start, Add(start, d)) = %v, want %v", between, tt.d)
+			}
+		})
+	}
+}
+
+func TestScheduleBetween(t *testing.T) {
+	berlin := mustLocation(t, "Europe/Berlin")
+	at := func(year int, month time.Month, day, hour, min int) time.Time {
+		return time.Date(year, month, day, hour, min, 0, 0, berlin)
+	}
+	// Вечерняя смена до полуночи: 24:00 — конец суток, а не 00:00 того же дня
+	evening := Schedule{
+		Location: berlin,
+		Days:     [7]bool{true, true, true, true, true, true, true},
+		Start:    18 * 60,
+		End:      minutesPerDay,
+	}
+	// Ночная смена, которую пересекает переход на летнее время
+	night := Schedule{
+		Location: berlin,
+		Days:     [7]bool{true, true, true, true, true, true, true},
+		Start:    60,
+		End:      4 * 60,
+	}
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		from, to time.Time
+		want     time.Duration
+	}{
+		{"over weekend", officeHours(t), at(2025, time.March, 14, 17, 0), at(2025, time.March, 18, 10, 0), 11 * time.Hour},
+		{"outside work hours", officeHours(t), at(2025, time.March, 15, 9, 0), at(2025, time.March, 16, 18, 0), 0},
+		{"yearly holiday", officeHours(t), at(2029, time.April, 30, 9, 0), at(2029, time.May, 1, 18, 0), 9 * time.Hour},
+		{"empty interval", officeHours(t), at(2025, time.March, 5, 12, 0), at(2025, time.March, 5, 12, 0), 0},
+		{"reversed interval", officeHours(t), at(2025, time.March, 5, 12, 0), at(2025, time.March, 5, 10, 0), 0},
+		{"closing at 24:00", evening, at(2025, time.March, 5, 20, 0), at(2025, time.March, 6, 2, 0), 4 * time.Hour},
+		{"spring forward day", allDay(t), at(2025, time.March, 30, 0, 0), at(2025, time.March, 31, 0, 0), 23 * time.Hour},
+		{"fall back day", allDay(t), at(2025, time.October, 26, 0, 0), at(2025, time.October, 27, 0, 0), 25 * time.Hour},
+		{"night shift on spring forward", night, at(2025, time.March, 30, 0, 0), at(2025, time.March, 30, 12, 0), 2 * time.Hour},
+		{"night shift on fall back", night, at(2025, time.October, 26, 0, 0), at(2025, time.October, 26, 12, 0), 4 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.Between(tt.from, tt.to); got != tt.want {
+				t.Fatalf("Between(%v, %v) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleNextOpen(t *testing.T) {
+	berlin := mustLocation(t, "Europe/Berlin")
+	at := func(year int, month time.Month, day, hour, min int) time.Time {
+		return time.Date(year, month, day, hour, min, 0, 0, berlin)
+	}
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want time.Time
+	}{
+		{"during work hours", at(2025, time.March, 5, 12, 0), at(2025, time.March, 5, 12, 0)},
+		{"at opening", at(2025, time.March, 5, 9, 0), at(2025, time.March, 5, 9, 0)},
+		{"at closing", at(2025, time.March, 5, 18, 0), at(2025, time.March, 6, 9, 0)},
+		{"before opening", at(2025, time.March, 5, 6, 0), at(2025, time.March, 5, 9, 0)},
+		{"friday evening", at(2025, time.March, 14, 19, 0), at(2025, time.March, 17, 9, 0)},
+		{"saturday", at(2025, time.March, 15, 12, 0), at(2025, time.March, 17, 9, 0)},
+		{"before yearly holiday", at(2025, time.April, 30, 18, 30), at(2025, time.May, 2, 9, 0)},
+		// Момент в UTC переводится в пояс расписания: 07:30 UTC — 08:30 в Берлине зимой
+		{"other time zone", time.Date(2025, time.March, 5, 7, 30, 0, 0, time.UTC), at(2025, time.March, 5, 9, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := officeHours(t).NextOpen(tt.t); !got.Equal(tt.want) {
+				t.Fatalf("NextOpen(%v) = %v, want %v", tt.t, got.In(berlin), tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleIsWorking(t *testing.T) {
+	berlin := mustLocation(t, "Europe/Berlin")
+	s := officeHours(t)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2025, time.March, 5, 9, 0, 0, 0, berlin), true},
+		{time.Date(2025, time.March, 5, 17, 59, 0, 0, berlin), true},
+		{time.Date(2025, time.March, 5, 18, 0, 0, 0, berlin), false},
+		{time.Date(2025, time.March, 8, 12, 0, 0, 0, berlin), false},
+		{time.Date(2025, time.March, 10, 12, 0, 0, 0, berlin), false},
+		{time.Date(2030, time.May, 1, 12, 0, 0, 0, berlin), false},
+	}
+
+	for _, tt := range tests {
+		if got := s.IsWorking(tt.t); got != tt.want {
+			t.Errorf("IsWorking(%v) = %v, want %v", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestScheduleValidate(t *testing.T) {
+	valid := officeHours(t)
+	noDays := valid
+	noDays.Days = [7]bool{}
+	noZone := valid
+	noZone.Location = nil
+	reversed := valid
+	reversed.Start, reversed.End = 18*60, 9*60
+	pastMidnight := valid
+	pastMidnight.End = minutesPerDay + 1
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		wantErr  bool
+	}{
+		{"valid", valid, false},
+		{"always open", AlwaysOpen(), false},
+		{"no work days", noDays, true},
+		{"no time zone", noZone, true},
+		{"start after end", reversed, true},
+		{"end after 24:00", pastMidnight, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidSchedule) {
+				t.Fatalf("Validate() = %v, want ErrInvalidSchedule", err)
+			}
+		})
+	}
+}
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{"00:00", 0, false},
+		{"09:30", 9*60 + 30, false},
+		{"24:00", minutesPerDay, false},
+		{"24:01", 0, true},
+		{"9:30", 0, true},
+		{"09:60", 0, true},
+		{"noon", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseClock(tt.value)
+		if tt.wantErr != (err != nil) || got != tt.want {
+			t.Errorf("ParseClock(%q) = %d, %v; want %d, wantErr %v", tt.value, got, err, tt.want, tt.wantErr)
+		}
+		if err == nil && FormatClock(got) != tt.value {
+			t.Errorf("FormatClock(%d) = %q, want %q", got, FormatClock(got), tt.value)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package calendar
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+var ErrInvalidICS = errors.New("invalid iCalendar data")
+
+// maxEventDays ограничивает разворачивание многодневного события в отдельные даты.
+const maxEventDays = 366
+
+// ParseICS извлекает праздники из событий VEVENT календаря iCalendar (RFC 5545).
+// Многодневное событие даёт по празднику на каждую дату; события с RRULE:FREQ=YEARLY
+// становятся ежегодными. Время событий не учитывается — праздник занимает день целиком.
+func ParseICS(r io.Reader) ([]Holiday, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		holidays []Holiday
+		inEvent  bool
+		start    time.Time
+		end      time.Time
+		summary  string
+		yearly   bool
+	)
+	for _, line := range lines {
+		name, value := splitProperty(line)
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			inEvent, start, end, summary, yearly = true, time.Time{}, time.Time{}, "", false
+		case name == "END" && value == "VEVENT":
+			if !inEvent || start.IsZero() {
+				return nil, fmt.Errorf("%w: VEVENT without DTSTART", ErrInvalidICS)
+			}
+			holidays = append(holidays, expand(start, end, summary, yearly)...)
+			inEvent = false
+		case !inEvent:
+		case name == "DTSTART":
+			if start, err = parseDate(value); err != nil {
+				return nil, err
+			}
+		case name == "DTEND":
+			if end, err = parseDate(value); err != nil {
+				return nil, err
+			}
+			// DTEND не входит в событие: у DATE это первый свободный день, у DATE-TIME — момент,
+			// и день окончания занят, если событие заходит в него после полуночи
+			if strings.Contains(value, "T") && !strings.HasSuffix(value, "T000000") && !strings.HasSuffix(value, "T000000Z") {
+				end = end.AddDate(0, 0, 1)
+			}
+		case name == "SUMMARY":
+			summary = unescape(value)
+		case name == "RRULE":
+			yearly = strings.Contains(strings.ToUpper(value), "FREQ=YEARLY")
+		}
+	}
+	if inEvent {
+		return nil, fmt.Errorf("%w: unterminated VEVENT", ErrInvalidICS)
+	}
+
+	return holidays, nil
+}
+
+func expand(start, end time.Time, summary string, yearly bool) []Holiday {
+	if !end.After(start) {
+		end = start.AddDate(0, 0, 1)
+	}
+
+	var holidays []Holiday
+	for day := start; day.Before(end) && len(holidays) < maxEventDays; day = day.AddDate(0, 0, 1) {
+		h := Holiday{Year: day.Year(), Month: day.Month(), Day: day.Day(), Name: summary}
+		if yearly {
+			h.Year = 0
+		}
+		holidays = append(holidays, h)
+	}
+	return holidays
+}
+
+// unfold склеивает продолжения строк (начинаются с пробела или табуляции).
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read iCalendar: %w", err)
+	}
+	return lines, nil
+}
+
+// splitProperty разбирает строку "NAME;PARAM=...:VALUE"; параметры отбрасываются.
+func splitProperty(line string) (name, value string) {
+	head, value, _ := strings.Cut(line, ":")
+	name, _, _ = strings.Cut(head, ";")
+	return strings.ToUpper(name), strings.TrimSpace(value)
+}
+
+// parseDate берёт из DATE или DATE-TIME только календарную дату.
+func parseDate(value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("%w: bad date %q", ErrInvalidICS, value)
+	}
+	date, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: bad date %q", ErrInvalidICS, value)
+	}
+	return date, nil
+}
+
+func unescape(value string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
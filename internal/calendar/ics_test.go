@@ -0,0 +1,117 @@
+package calendar
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func event(props ...string) string {
+	return "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\n" + strings.Join(props, "\r\n") + "\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+}
+
+func TestParseICS(t *testing.T) {
+	tests := []struct {
+		name string
+		ics  string
+		want []Holiday
+	}{
+		{
+			name: "date end is exclusive",
+			ics:  event("DTSTART;VALUE=DATE:20250101", "DTEND;VALUE=DATE:20250102", "SUMMARY:New Year"),
+			want: []Holiday{{Year: 2025, Month: time.January, Day: 1, Name: "New Year"}},
+		},
+		{
+			name: "multi-day date over year boundary",
+			ics:  event("DTSTART;VALUE=DATE:20251231", "DTEND;VALUE=DATE:20260103", "SUMMARY:Break"),
+			want: []Holiday{
+				{Year: 2025, Month: time.December, Day: 31, Name: "Break"},
+				{Year: 2026, Month: time.January, Day: 1, Name: "Break"},
+				{Year: 2026, Month: time.January, Day: 2, Name: "Break"},
+			},
+		},
+		{
+			name: "date without end",
+			ics:  event("DTSTART;VALUE=DATE:20250308"),
+			want: []Holiday{{Year: 2025, Month: time.March, Day: 8}},
+		},
+		{
+			name: "date-time within one day",
+			ics:  event("DTSTART:20250505T090000Z", "DTEND:20250505T170000Z"),
+			want: []Holiday{{Year: 2025, Month: time.May, Day: 5}},
+		},
+		{
+			name: "date-time ending at midnight is exclusive",
+			ics:  event("DTSTART:20250505T120000", "DTEND:20250507T000000"),
+			want: []Holiday{
+				{Year: 2025, Month: time.May, Day: 5},
+				{Year: 2025, Month: time.May, Day: 6},
+			},
+		},
+		{
+			name: "date-time ending after midnight takes the end day",
+			ics:  event("DTSTART:20250505T120000", "DTEND:20250507T120000"),
+			want: []Holiday{
+				{Year: 2025, Month: time.May, Day: 5},
+				{Year: 2025, Month: time.May, Day: 6},
+				{Year: 2025, Month: time.May, Day: 7},
+			},
+		},
+		{
+			name: "explicit date-time value type",
+			ics:  event("DTSTART;VALUE=DATE-TIME:20250505T120000", "DTEND;VALUE=DATE-TIME;TZID=Europe/Berlin:20250506T120000"),
+			want: []Holiday{
+				{Year: 2025, Month: time.May, Day: 5},
+				{Year: 2025, Month: time.May, Day: 6},
+			},
+		},
+		{
+			name: "yearly",
+			ics:  event("DTSTART;VALUE=DATE:20200501", "DTEND;VALUE=DATE:20200502", "RRULE:FREQ=YEARLY;BYMONTH=5", "SUMMARY:Labour Day"),
+			want: []Holiday{{Month: time.May, Day: 1, Name: "Labour Day"}},
+		},
+		{
+			name: "folded summary with escapes",
+			ics:  event("DTSTART;VALUE=DATE:20250101", "SUMMARY:New Year\\, first\r\n  day\\; off"),
+			want: []Holiday{{Year: 2025, Month: time.January, Day: 1, Name: "New Year, first day; off"}},
+		},
+		{
+			name: "properties outside events are ignored",
+			ics:  "BEGIN:VCALENDAR\nDTSTART:20250101\nEND:VCALENDAR\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseICS(strings.NewReader(tt.ics))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("ParseICS() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseICSErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		ics  string
+	}{
+		{"event without start", event("SUMMARY:Nothing")},
+		{"unterminated event", "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20250101\nEND:VCALENDAR\n"},
+		{"bad date", event("DTSTART:2025-01-01")},
+		{"short date", event("DTSTART:2025")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseICS(strings.NewReader(tt.ics)); !errors.Is(err, ErrInvalidICS) {
+				t.Fatalf("ParseICS() = %v, want ErrInvalidICS", err)
+			}
+		})
+	}
+}
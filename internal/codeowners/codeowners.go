@@ -0,0 +1,152 @@
+// Package codeowners реализует сопоставление путей с шаблонами в духе файла CODEOWNERS GitHub.
+//
+// Поддерживаемый синтаксис:
+//   - шаблон без "/" (кроме завершающего) совпадает на любой глубине: "*.go", "docs/";
+//   - ведущий или внутренний "/" привязывает шаблон к корню репозитория: "/build", "api/v1";
+//   - завершающий "/" совпадает только с каталогом и всем его содержимым;
+//   - "*", "?" и классы "[...]" работают в пределах одного сегмента пути, "**" — через сегменты;
+//   - шаблон без масок в последнем сегменте совпадает и с файлом, и с каталогом со всем содержимым,
+//     а "docs/*" — только с файлами непосредственно в docs, как в GitHub.
+//
+// Отрицания ("!pattern") в CODEOWNERS не поддерживаются.
+package codeowners
+
+import (
+	"errors"
+	"path"
+	"strings"
+)
+
+var ErrInvalidPattern = errors.New("invalid code owners pattern")
+
+type pattern struct {
+	segments []string
+	dirOnly  bool
+	nested   bool // совпадение с каталогом распространяется на его содержимое
+}
+
+func compile(raw string) (pattern, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || strings.HasPrefix(raw, "!") || strings.HasPrefix(raw, "#") {
+		return pattern{}, ErrInvalidPattern
+	}
+
+	var p pattern
+	if strings.HasSuffix(raw, "/") {
+		p.dirOnly = true
+		raw = strings.TrimRight(raw, "/")
+	}
+
+	anchored := strings.HasPrefix(raw, "/")
+	raw = strings.TrimLeft(raw, "/")
+	if raw == "" {
+		// "/" или "*" на всё дерево
+		raw = "**"
+	}
+	if !anchored && !strings.Contains(raw, "/") {
+		raw = "**/" + raw
+	}
+
+	p.segments = strings.Split(raw, "/")
+	for _, segment := range p.segments {
+		if segment == "" {
+			return pattern{}, ErrInvalidPattern
+		}
+		if _, err := path.Match(segment, ""); err != nil {
+			return pattern{}, ErrInvalidPattern
+		}
+	}
+
+	last := p.segments[len(p.segments)-1]
+	p.nested = p.dirOnly || !strings.ContainsAny(last, "*?[")
+
+	return p, nil
+}
+
+// Validate проверяет синтаксис шаблона.
+func Validate(raw string) error {
+	_, err := compile(raw)
+	return err
+}
+
+// Match сообщает, покрывает ли шаблон путь к файлу (относительно корня репозитория).
+// Некорректный шаблон ни с чем не совпадает.
+func Match(raw, filePath string) bool {
+	p, err := compile(raw)
+	if err != nil {
+		return false
+	}
+	return p.match(splitPath(filePath))
+}
+
+// LastMatch возвращает индекс последнего шаблона, совпавшего с путём, или -1.
+// Как и в CODEOWNERS, побеждает последнее совпадение.
+func LastMatch(patterns []string, filePath string) int {
+	parts := splitPath(filePath)
+	for i := len(patterns) - 1; i >= 0; i-- {
+		p, err := compile(patterns[i])
+		if err != nil {
+			continue
+		}
+		if p.match(parts) {
+			return i
+		}
+	}
+	return -1
+}
+
+func splitPath(filePath string) []string {
+	filePath = strings.Trim(path.Clean("/"+filePath), "/")
+	if filePath == "" {
+		return nil
+	}
+	return strings.Split(filePath, "/")
+}
+
+func (p pattern) match(parts []string) bool {
+	if len(parts) == 0 {
+		return false
+	}
+
+	if !p.dirOnly && matchSegments(p.segments, parts) {
+		return true
+	}
+	if !p.nested {
+		return false
+	}
+
+	// Совпадение с любым каталогом-предком покрывает файл
+	for i := len(parts) - 1; i > 0; i-- {
+		if matchSegments(p.segments, parts[:i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchSegments(segments, parts []string) bool {
+	if len(segments) == 0 {
+		return len(parts) == 0
+	}
+
+	if segments[0] == "**" {
+		// Завершающий "**" требует хотя бы одного сегмента: "foo/**" не совпадает с самим foo
+		if len(segments) == 1 {
+			return len(parts) > 0
+		}
+		for i := 0; i <= len(parts); i++ {
+			if matchSegments(segments[1:], parts[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(parts) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(segments[0], parts[0]); !ok {
+		return false
+	}
+	return matchSegments(segments[1:], parts[1:])
+}
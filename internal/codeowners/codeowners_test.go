@@ -0,0 +1,139 @@
+package codeowners
+
+import (
+	"errors"
+	"testing"
+)
+
+// Примеры из документации GitHub по CODEOWNERS.
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"*", "README.md", true},
+		{"*", "src/app/main.go", true},
+
+		{"*.js", "app.js", true},
+		{"*.js", "src/components/app.js", true},
+		{"*.js", "app.jsx", false},
+		{"*.js", "src/app.ts", false},
+
+		// Ведущий "/" привязывает шаблон к корню
+		{"/build/logs/", "build/logs/debug.log", true},
+		{"/build/logs/", "build/logs/2024/01/debug.log", true},
+		{"/build/logs/", "src/build/logs/debug.log", false},
+		{"/build/logs/", "build/debug.log", false},
+
+		// "docs/*" — только файлы непосредственно в docs
+		{"docs/*", "docs/getting-started.md", true},
+		{"docs/*", "docs/build-app/troubleshooting.md", false},
+
+		// "apps/" — каталог apps на любой глубине со всем содержимым
+		{"apps/", "apps/web/main.go", true},
+		{"apps/", "src/apps/web/deep/main.go", true},
+		{"apps/", "apps", false},
+		{"apps/", "myapps/main.go", false},
+
+		// "/docs/" — только каталог docs в корне
+		{"/docs/", "docs/index.md", true},
+		{"/docs/", "guide/docs/index.md", false},
+
+		{"**/logs", "build/logs/debug.log", true},
+		{"**/logs", "scripts/logs/run.log", true},
+		{"**/logs", "deeply/nested/logs/app.log", true},
+		{"**/logs", "logs/app.log", true},
+		{"**/logs", "build/logstash/app.log", false},
+
+		// Внутренний "/" тоже привязывает к корню
+		{"api/v1", "api/v1/users.go", true},
+		{"api/v1", "internal/api/v1/users.go", false},
+
+		{"internal/**/handler", "internal/handler/users.go", true},
+		{"internal/**/handler", "internal/v2/http/handler/users.go", true},
+		{"/internal/**", "internal", false},
+		{"/internal/**", "internal/app.go", true},
+
+		{"*.[ch]", "src/main.c", true},
+		{"file?.txt", "file1.txt", true},
+		{"file?.txt", "file10.txt", false},
+
+		// Некорректный шаблон ни с чем не совпадает
+		{"!*.js", "app.js", false},
+		{"[", "[", false},
+	}
+
+	for _, tt := range tests {
+		if got := Match(tt.pattern, tt.path); got != tt.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestLastMatch(t *testing.T) {
+	patterns := []string{
+		"*",
+		"*.js",
+		"/build/logs/",
+		"docs/*",
+		"apps/",
+		"*.js",
+	}
+
+	tests := []struct {
+		path string
+		want int
+	}{
+		{"README.md", 0},
+		// Повторный шаблон ниже перекрывает первый
+		{"src/app.js", 5},
+		{"build/logs/debug.log", 2},
+		// Более позднее правило побеждает более конкретное раннее
+		{"apps/web/index.js", 5},
+		{"apps/web/index.css", 4},
+		{"docs/getting-started.md", 3},
+		{"docs/build-app/troubleshooting.md", 0},
+	}
+
+	for _, tt := range tests {
+		if got := LastMatch(patterns, tt.path); got != tt.want {
+			t.Errorf("LastMatch(%q) = %d, want %d", tt.path, got, tt.want)
+		}
+	}
+
+	if got := LastMatch([]string{"docs/"}, "src/main.go"); got != -1 {
+		t.Errorf("LastMatch without match = %d, want -1", got)
+	}
+	if got := LastMatch([]string{"*.go", "!main.go"}, "main.go"); got != 0 {
+		t.Errorf("LastMatch skips invalid patterns: got %d, want 0", got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		pattern string
+		valid   bool
+	}{
+		{"*.go", true},
+		{"/build/logs/", true},
+		{"**/logs", true},
+		{"/", true},
+		{"", false},
+		{"   ", false},
+		{"!vendor/", false},
+		{"# comment", false},
+		{"docs//api", false},
+		{"[a-", false},
+	}
+
+	for _, tt := range tests {
+		err := Validate(tt.pattern)
+		if tt.valid && err != nil {
+			t.Errorf("Validate(%q) = %v, want nil", tt.pattern, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidPattern) {
+			t.Errorf("Validate(%q) = %v, want ErrInvalidPattern", tt.pattern, err)
+		}
+	}
+}
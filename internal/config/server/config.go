@@ -3,21 +3,90 @@ package server
 import (
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/F3dosik/PRS.git/internal/middleware"
 	"github.com/F3dosik/PRS.git/pkg/logger"
 	"github.com/caarlos0/env/v6"
 )
 
 type ServerConfig struct {
-	Port    string `env:"APP_PORT"`
-	LogMode string `env:"LOG_MODE"`
+	Port     string `env:"APP_PORT"`
+	GRPCPort string `env:"GRPC_PORT"`
+	LogMode  string `env:"LOG_MODE"`
+
+	// OpenAPIValidation — off, requests или test (запросы и ответы)
+	OpenAPIValidation string `env:"OPENAPI_VALIDATION"`
 
 	DatabaseURL string `env:"DATABASE_URL"`
+
+	// AutoMigrate применяет встроенные миграции при старте сервера
+	AutoMigrate bool `env:"AUTO_MIGRATE"`
+
+	// Пул соединений с базой; нулевые размеры и время жизни — значения по умолчанию pgxpool
+	DBMaxConns         int           `env:"DB_MAX_CONNS"`
+	DBMinConns         int           `env:"DB_MIN_CONNS"`
+	DBMaxConnLifetime  time.Duration `env:"DB_MAX_CONN_LIFETIME"`
+	DBStatementTimeout time.Duration `env:"DB_STATEMENT_TIMEOUT"`
+	DBApplicationName  string        `env:"DB_APPLICATION_NAME"`
+
+	BackfillInterval time.Duration `env:"BACKFILL_INTERVAL"`
+	SLACheckInterval time.Duration `env:"SLA_CHECK_INTERVAL"`
+
+	// AssignmentSeed, если задан, делает последовательность решений о назначении воспроизводимой
+	AssignmentSeed uint64 `env:"ASSIGNMENT_SEED"`
+
+	// Уведомления в Slack включаются заданием SLACK_TOKEN или SLACK_WEBHOOK_URL
+	SlackAPIURL         string        `env:"SLACK_API_URL"`
+	SlackToken          string        `env:"SLACK_TOKEN"`
+	SlackWebhookURL     string        `env:"SLACK_WEBHOOK_URL"`
+	SlackRateInterval   time.Duration `env:"SLACK_RATE_INTERVAL"`
+	SlackMaxAttempts    int           `env:"SLACK_MAX_ATTEMPTS"`
+	NotifyInterval      time.Duration `env:"NOTIFY_INTERVAL"`
+	NotifyTemplatesFile string        `env:"NOTIFY_TEMPLATES_FILE"`
+
+	// Почтовый дайджест включается заданием SMTP_HOST
+	SMTPHost            string        `env:"SMTP_HOST"`
+	SMTPPort            int           `env:"SMTP_PORT"`
+	SMTPUsername        string        `env:"SMTP_USERNAME"`
+	SMTPPassword        string        `env:"SMTP_PASSWORD"`
+	SMTPFrom            string        `env:"SMTP_FROM"`
+	DigestCheckInterval time.Duration `env:"DIGEST_CHECK_INTERVAL"`
+	DigestTemplateDir   string        `env:"DIGEST_TEMPLATE_DIR"`
+
+	// AdminToken открывает /admin/*: запрос должен передать его как Authorization: Bearer.
+	// Без токена административные маршруты недоступны
+	AdminToken string `env:"ADMIN_TOKEN"`
+
+	// Ограничения на запросы к /graphql
+	GraphQLMaxDepth      int `env:"GRAPHQL_MAX_DEPTH"`
+	GraphQLMaxComplexity int `env:"GRAPHQL_MAX_COMPLEXITY"`
 }
 
 const (
-	defaultPort    = ":8080"
-	defaultLogMode = string(logger.ModeDevelopment)
+	defaultPort     = ":8080"
+	defaultGRPCPort = ":9090"
+	defaultLogMode  = string(logger.ModeDevelopment)
+
+	// По умолчанию проверка выключена: ошибки валидатора не совпадают с кодами ошибок хендлеров v1
+	defaultOpenAPIValidation = string(middleware.ValidationOff)
+
+	defaultBackfillInterval = time.Minute
+	defaultSLACheckInterval = 5 * time.Minute
+
+	defaultSlackAPIURL       = "https://slack.com/api"
+	defaultSlackRateInterval = time.Second
+	defaultSlackMaxAttempts  = 3
+	defaultNotifyInterval    = 5 * time.Second
+
+	defaultSMTPPort            = 25
+	defaultSMTPFrom            = "prs@localhost"
+	defaultDigestCheckInterval = 5 * time.Minute
+
+	defaultGraphQLMaxDepth      = 10
+	defaultGraphQLMaxComplexity = 1000
+
+	defaultDBApplicationName = "prs"
 )
 
 func (c *ServerConfig) Validate() error {
@@ -29,12 +98,82 @@ func (c *ServerConfig) Validate() error {
 		c.Port = ":" + c.Port
 	}
 
+	if c.GRPCPort == "" {
+		c.GRPCPort = defaultGRPCPort
+	}
+
+	if c.GRPCPort[0] != ':' {
+		c.GRPCPort = ":" + c.GRPCPort
+	}
+
 	switch c.LogMode {
 	case string(logger.ModeDevelopment), string(logger.ModeProduction):
 	default:
 		c.LogMode = defaultLogMode
 	}
 
+	switch c.OpenAPIValidation {
+	case string(middleware.ValidationOff), string(middleware.ValidationRequests), string(middleware.ValidationTest):
+	default:
+		c.OpenAPIValidation = defaultOpenAPIValidation
+	}
+
+	if c.BackfillInterval <= 0 {
+		c.BackfillInterval = defaultBackfillInterval
+	}
+
+	if c.SLACheckInterval <= 0 {
+		c.SLACheckInterval = defaultSLACheckInterval
+	}
+
+	if c.SlackAPIURL == "" {
+		c.SlackAPIURL = defaultSlackAPIURL
+	}
+
+	if c.SlackRateInterval <= 0 {
+		c.SlackRateInterval = defaultSlackRateInterval
+	}
+
+	if c.SlackMaxAttempts <= 0 {
+		c.SlackMaxAttempts = defaultSlackMaxAttempts
+	}
+
+	if c.NotifyInterval <= 0 {
+		c.NotifyInterval = defaultNotifyInterval
+	}
+
+	if c.SMTPPort <= 0 {
+		c.SMTPPort = defaultSMTPPort
+	}
+
+	if c.SMTPFrom == "" {
+		c.SMTPFrom = defaultSMTPFrom
+	}
+
+	if c.DigestCheckInterval <= 0 {
+		c.DigestCheckInterval = defaultDigestCheckInterval
+	}
+
+	if c.GraphQLMaxDepth <= 0 {
+		c.GraphQLMaxDepth = defaultGraphQLMaxDepth
+	}
+
+	if c.GraphQLMaxComplexity <= 0 {
+		c.GraphQLMaxComplexity = defaultGraphQLMaxComplexity
+	}
+
+	if c.DBApplicationName == "" {
+		c.DBApplicationName = defaultDBApplicationName
+	}
+
+	if c.DBMaxConns < 0 || c.DBMinConns < 0 {
+		return fmt.Errorf("DB_MAX_CONNS and DB_MIN_CONNS can not be negative")
+	}
+
+	if c.DBMaxConns > 0 && c.DBMinConns > c.DBMaxConns {
+		return fmt.Errorf("DB_MIN_CONNS can not exceed DB_MAX_CONNS")
+	}
+
 	if c.DatabaseURL == "" {
 		return fmt.Errorf("DATABASE_URL can not be empty")
 	}
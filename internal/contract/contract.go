@@ -0,0 +1,334 @@
+// Package contract проверяет запущенный сервис на соответствие спецификациям api/openapi*.yml:
+// вызывает каждую операцию под каждым из servers спецификации и сверяет статус и тело ответа
+// со схемой. Сервис лучше запускать с OPENAPI_VALIDATION=test и на отдельной базе — проверка
+// создаёт команду, пользователей и PR. Используется командой cmd/contract и тестом сервера.
+package contract
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	spec "github.com/F3dosik/PRS.git/api"
+	"github.com/F3dosik/PRS.git/internal/middleware"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/google/uuid"
+)
+
+var errSkipped = errors.New("skipped")
+
+type Checker struct {
+	baseURL    string
+	client     *http.Client
+	adminToken string         // Передаётся операциям со security, то есть /admin/*
+	fixtures   map[string]any // Значения параметров и полей по имени: team_name, user_id, ...
+}
+
+// Result — итог проверки одной операции под одним из servers спецификации.
+type Result struct {
+	Method  string
+	Path    string // С префиксом сервера
+	Status  int
+	Skipped bool // Поток, который нельзя дочитать до конца
+	Err     error
+}
+
+func New(baseURL string, client *http.Client, adminToken string) *Checker {
+	return &Checker{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		client:     client,
+		adminToken: adminToken,
+	}
+}
+
+// LoadSpecs загружает все встроенные спецификации в порядке версий.
+func LoadSpecs() ([]*openapi3.T, error) {
+	docs := make([]*openapi3.T, 0, len(spec.Specs))
+	for _, name := range spec.Specs {
+		doc, err := middleware.LoadOpenAPI(spec.FS, name)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+// CheckSpec проверяет все операции спецификации под каждым из её servers. Перед первой
+// проверкой нужен Setup.
+func (c *Checker) CheckSpec(doc *openapi3.T) []Result {
+	servers := doc.Servers
+	if len(servers) == 0 {
+		servers = openapi3.Servers{{URL: "/"}}
+	}
+
+	var results []Result
+	for _, server := range servers {
+		prefix := strings.TrimSuffix(server.URL, "/")
+		for _, path := range doc.Paths.InMatchingOrder() {
+			item := doc.Paths.Value(path)
+			methods := make([]string, 0, len(item.Operations()))
+			for method := range item.Operations() {
+				methods = append(methods, method)
+			}
+			sort.Strings(methods)
+
+			for _, method := range methods {
+				route := &routers.Route{Spec: doc, Server: server, Path: path, PathItem: item, Method: method, Operation: item.GetOperation(method)}
+				status, err := c.check(route, prefix)
+				result := Result{Method: method, Path: prefix + path, Status: status, Err: err}
+				if errors.Is(err, errSkipped) {
+					result.Skipped, result.Err = true, nil
+				}
+				results = append(results, result)
+			}
+		}
+	}
+
+	return results
+}
+
+// Setup создаёт команду из трёх пользователей и PR, чтобы операции отвечали не только 404.
+func (c *Checker) Setup() error {
+	suffix := uuid.NewString()[:8]
+	team := "contract-" + suffix
+	users := []string{uuid.NewString(), uuid.NewString(), uuid.NewString()}
+	prID := uuid.NewString()
+
+	members := make([]map[string]any, len(users))
+	for i, userID := range users {
+		members[i] = map[string]any{
+			"user_id":   userID,
+			"username":  fmt.Sprintf("contract-%s-%d", suffix, i),
+			"is_active": true,
+		}
+	}
+	if _, err := c.post("/team/add", map[string]any{"team_name": team, "members": members}, http.StatusCreated); err != nil {
+		return err
+	}
+
+	body, err := c.post("/pullRequest/create", map[string]any{
+		"pull_request_id":   prID,
+		"pull_request_name": "contract " + suffix,
+		"author_id":         users[0],
+	}, http.StatusCreated)
+	if err != nil {
+		return err
+	}
+
+	var created struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return fmt.Errorf("decode pull request: %w", err)
+	}
+	reviewer := users[1]
+	if len(created.PR.AssignedReviewers) > 0 {
+		reviewer = created.PR.AssignedReviewers[0]
+	}
+
+	c.fixtures = map[string]any{
+		"team_name":       team,
+		"user_id":         reviewer,
+		"author_id":       users[0],
+		"old_user_id":     reviewer,
+		"pull_request_id": prID,
+		"parent_team":     nil,
+	}
+
+	return nil
+}
+
+func (c *Checker) post(path string, payload any, want int) ([]byte, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Post(c.baseURL+path, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != want {
+		return nil, fmt.Errorf("POST %s: status %d: %s", path, resp.StatusCode, body)
+	}
+
+	return body, nil
+}
+
+// check вызывает операцию под префиксом сервера с обязательными параметрами и проверяет ответ
+// по спецификации.
+func (c *Checker) check(route *routers.Route, prefix string) (int, error) {
+	op := route.Operation
+	if streams(op) {
+		return 0, errSkipped
+	}
+
+	path := route.Path
+	query := url.Values{}
+	header := http.Header{}
+	for _, ref := range op.Parameters {
+		param := ref.Value
+		value, ok := c.fixtures[param.Name]
+		if !ok && !param.Required {
+			continue
+		}
+		if !ok {
+			value = c.sample(param.Name, param.Schema)
+		}
+		raw := fmt.Sprint(value)
+
+		switch param.In {
+		case openapi3.ParameterInPath:
+			path = strings.ReplaceAll(path, "{"+param.Name+"}", url.PathEscape(raw))
+		case openapi3.ParameterInQuery:
+			query.Set(param.Name, raw)
+		case openapi3.ParameterInHeader:
+			header.Set(param.Name, raw)
+		}
+	}
+
+	var body io.Reader
+	if op.RequestBody != nil {
+		contentType, data, err := c.requestBody(op.RequestBody.Value)
+		if err != nil {
+			return 0, err
+		}
+		header.Set("Content-Type", contentType)
+		body = bytes.NewReader(data)
+	}
+
+	target := c.baseURL + prefix + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	req, err := http.NewRequest(route.Method, target, body)
+	if err != nil {
+		return 0, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	if op.Security != nil && len(*op.Security) > 0 && c.adminToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.adminToken)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	options := &openapi3filter.Options{IncludeResponseStatus: true}
+	options.WithCustomSchemaErrorFunc(func(err *openapi3.SchemaError) string {
+		return fmt.Sprintf("%q: %s", "/"+strings.Join(err.JSONPointer(), "/"), err.Reason)
+	})
+
+	err = openapi3filter.ValidateResponse(context.Background(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{Request: req, Route: route, Options: options},
+		Status:                 resp.StatusCode,
+		Header:                 resp.Header,
+		Body:                   resp.Body,
+		Options:                options,
+	})
+
+	return resp.StatusCode, err
+}
+
+func (c *Checker) requestBody(body *openapi3.RequestBody) (string, []byte, error) {
+	if media := body.Content.Get("application/json"); media != nil {
+		data, err := json.Marshal(c.sample("", media.Schema))
+		return "application/json", data, err
+	}
+
+	if body.Content.Get("text/calendar") != nil {
+		return "text/calendar", []byte("BEGIN:VCALENDAR\r\nVERSION:2.0\r\nBEGIN:VEVENT\r\n" +
+			"DTSTART;VALUE=DATE:20991231\r\nSUMMARY:contract\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"), nil
+	}
+
+	for contentType := range body.Content {
+		return "", nil, fmt.Errorf("no sample for request body %s", contentType)
+	}
+
+	return "", nil, fmt.Errorf("request body has no content")
+}
+
+// sample подбирает значение по схеме: значение из fixtures, пример, значение по умолчанию,
+// первое значение перечисления или заглушку по типу. Из объектов берутся обязательные поля
+// и поля, для которых есть fixtures.
+func (c *Checker) sample(name string, ref *openapi3.SchemaRef) any {
+	if value, ok := c.fixtures[name]; ok {
+		return value
+	}
+	if ref == nil || ref.Value == nil {
+		return nil
+	}
+
+	schema := ref.Value
+	switch {
+	case schema.Example != nil:
+		return schema.Example
+	case schema.Default != nil:
+		return schema.Default
+	case len(schema.Enum) > 0:
+		return schema.Enum[0]
+	}
+
+	switch {
+	case schema.Type.Is(openapi3.TypeObject):
+		object := make(map[string]any)
+		for prop, propRef := range schema.Properties {
+			_, fixture := c.fixtures[prop]
+			if fixture || slices.Contains(schema.Required, prop) {
+				object[prop] = c.sample(prop, propRef)
+			}
+		}
+		return object
+	case schema.Type.Is(openapi3.TypeArray):
+		return []any{c.sample("", schema.Items)}
+	case schema.Type.Is(openapi3.TypeBoolean):
+		return false
+	case schema.Type.Is(openapi3.TypeInteger), schema.Type.Is(openapi3.TypeNumber):
+		if schema.Min != nil {
+			return *schema.Min
+		}
+		return 1
+	case schema.Format == "date-time":
+		return time.Now().UTC().Format(time.RFC3339)
+	case strings.HasSuffix(name, "_id"):
+		return uuid.NewString()
+	default:
+		return "contract"
+	}
+}
+
+// streams сообщает, что операция отвечает потоком, который нельзя дочитать до конца.
+func streams(op *openapi3.Operation) bool {
+	for _, ref := range op.Responses.Map() {
+		if ref.Value != nil && ref.Value.Content.Get("text/event-stream") != nil {
+			return true
+		}
+	}
+
+	return false
+}
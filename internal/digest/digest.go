@@ -0,0 +1,135 @@
+// Package digest рассылает по почте дайджест ожидающих ревью.
+package digest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Subscription — подписка пользователя с уже определённым часовым поясом.
+type Subscription struct {
+	UserID     uuid.UUID
+	Username   string
+	Email      string
+	Frequency  api.DigestFrequency
+	SendAt     int // Минуты от полуночи по местному времени
+	Weekday    time.Weekday
+	Location   *time.Location
+	CreatedAt  time.Time
+	LastSentAt *time.Time
+}
+
+// LastSlot возвращает последний запланированный момент отправки, не позже now.
+func (s Subscription) LastSlot(now time.Time) time.Time {
+	local := now.In(s.Location)
+	year, month, day := local.Date()
+	if s.Frequency == api.DigestWeekly {
+		day -= (int(local.Weekday()) - int(s.Weekday) + 7) % 7
+	}
+
+	slot := time.Date(year, month, day, s.SendAt/60, s.SendAt%60, 0, 0, s.Location)
+	if slot.After(now) {
+		step := 1
+		if s.Frequency == api.DigestWeekly {
+			step = 7
+		}
+		slot = time.Date(year, month, day-step, s.SendAt/60, s.SendAt%60, 0, 0, s.Location)
+	}
+	return slot
+}
+
+// Due сообщает, пора ли отправить дайджест: наступил момент отправки, после которого
+// дайджест ещё не отправлялся. Моменты до оформления подписки не учитываются.
+func (s Subscription) Due(now time.Time) bool {
+	slot := s.LastSlot(now)
+	if slot.Before(s.CreatedAt) {
+		return false
+	}
+	return s.LastSentAt == nil || s.LastSentAt.Before(slot)
+}
+
+type Store interface {
+	DigestSubscriptions(ctx context.Context) ([]Subscription, error)
+	DigestReviews(ctx context.Context, userID uuid.UUID) ([]api.DigestReview, error)
+	MarkDigestSent(ctx context.Context, userID uuid.UUID, sentAt time.Time) error
+}
+
+// Mail — письмо с текстовой и HTML-версией.
+type Mail struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, mail Mail) error
+}
+
+// Report — итог одного прохода рассылки.
+type Report struct {
+	Sent   int
+	Empty  int // Подписчики без ожидающих ревью: письмо не отправляется
+	Failed int
+}
+
+type Job struct {
+	store     Store
+	mailer    Mailer
+	templates *Templates
+	logger    *zap.SugaredLogger
+}
+
+func NewJob(store Store, mailer Mailer, templates *Templates, logger *zap.SugaredLogger) *Job {
+	return &Job{store: store, mailer: mailer, templates: templates, logger: logger}
+}
+
+// Run отправляет дайджесты всем подписчикам, у которых наступил момент отправки.
+// Неудачная отправка не отмечается и повторяется следующим проходом.
+func (j *Job) Run(ctx context.Context, now time.Time) (*Report, error) {
+	subscriptions, err := j.store.DigestSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	for _, sub := range subscriptions {
+		if !sub.Due(now) {
+			continue
+		}
+
+		reviews, err := j.store.DigestReviews(ctx, sub.UserID)
+		if err != nil {
+			return report, err
+		}
+
+		if len(reviews) == 0 {
+			report.Empty++
+		} else {
+			mail, err := j.templates.Render(sub, reviews, now)
+			if err != nil {
+				return report, err
+			}
+			if err = j.mailer.Send(ctx, mail); err != nil {
+				if ctx.Err() != nil {
+					return report, ctx.Err()
+				}
+				j.logger.Errorw("digest delivery failed", "user_id", sub.UserID, "err", err)
+				report.Failed++
+				continue
+			}
+			report.Sent++
+		}
+
+		if err = j.store.MarkDigestSent(ctx, sub.UserID, now); err != nil {
+			return report, fmt.Errorf("mark digest sent: %w", err)
+		}
+	}
+
+	return report, nil
+}
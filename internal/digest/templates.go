@@ -0,0 +1,128 @@
+package digest
+
+import (
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+)
+
+//go:embed templates
+var defaultTemplates embed.FS
+
+const (
+	subjectFile = "subject.txt.tmpl"
+	textFile    = "digest.txt.tmpl"
+	htmlFile    = "digest.html.tmpl"
+)
+
+// Templates — шаблоны темы, текстовой и HTML-версии письма.
+type Templates struct {
+	subject *texttemplate.Template
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+}
+
+// Data — данные шаблонов дайджеста.
+type Data struct {
+	Username  string
+	Frequency api.DigestFrequency
+	Reviews   []ReviewData
+}
+
+type ReviewData struct {
+	api.DigestReview
+	Waiting string // Сколько PR ждёт ревью, например "2d 5h"
+}
+
+var funcs = map[string]any{
+	"date": func(t time.Time) string { return t.Format("2006-01-02 15:04 MST") },
+}
+
+// LoadTemplates читает шаблоны из каталога dir; файлы, которых там нет, берутся по умолчанию.
+// Пустой dir означает шаблоны по умолчанию.
+func LoadTemplates(dir string) (*Templates, error) {
+	read := func(name string) (string, error) {
+		if dir != "" {
+			raw, err := os.ReadFile(filepath.Join(dir, name))
+			if err == nil {
+				return string(raw), nil
+			}
+			if !os.IsNotExist(err) {
+				return "", fmt.Errorf("read template %s: %w", name, err)
+			}
+		}
+		raw, err := defaultTemplates.ReadFile("templates/" + name)
+		if err != nil {
+			return "", fmt.Errorf("read default template %s: %w", name, err)
+		}
+		return string(raw), nil
+	}
+
+	var (
+		t   Templates
+		src string
+		err error
+	)
+	if src, err = read(subjectFile); err != nil {
+		return nil, err
+	}
+	if t.subject, err = texttemplate.New(subjectFile).Funcs(funcs).Parse(src); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", subjectFile, err)
+	}
+	if src, err = read(textFile); err != nil {
+		return nil, err
+	}
+	if t.text, err = texttemplate.New(textFile).Funcs(funcs).Parse(src); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", textFile, err)
+	}
+	if src, err = read(htmlFile); err != nil {
+		return nil, err
+	}
+	if t.html, err = htmltemplate.New(htmlFile).Funcs(funcs).Parse(src); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", htmlFile, err)
+	}
+
+	return &t, nil
+}
+
+func (t *Templates) Render(sub Subscription, reviews []api.DigestReview, now time.Time) (Mail, error) {
+	data := Data{Username: sub.Username, Frequency: sub.Frequency}
+	for _, review := range reviews {
+		review.AssignedAt = review.AssignedAt.In(sub.Location)
+		data.Reviews = append(data.Reviews, ReviewData{DigestReview: review, Waiting: waiting(now.Sub(review.AssignedAt))})
+	}
+
+	var subject, text, html strings.Builder
+	if err := t.subject.Execute(&subject, data); err != nil {
+		return Mail{}, fmt.Errorf("render digest subject: %w", err)
+	}
+	if err := t.text.Execute(&text, data); err != nil {
+		return Mail{}, fmt.Errorf("render digest text: %w", err)
+	}
+	if err := t.html.Execute(&html, data); err != nil {
+		return Mail{}, fmt.Errorf("render digest html: %w", err)
+	}
+
+	return Mail{
+		To:      sub.Email,
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+func waiting(d time.Duration) string {
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	if days > 0 {
+		return fmt.Sprintf("%dd %dh", days, hours)
+	}
+	return fmt.Sprintf("%dh", hours)
+}
@@ -0,0 +1,289 @@
+// Package dump кодирует выгрузку хранилища (repository.DumpTables) в NDJSON или zip-архив CSV
+// и читает её обратно для восстановления.
+package dump
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+
+	"github.com/F3dosik/PRS.git/internal/repository"
+)
+
+type Format string
+
+const (
+	// FormatNDJSON — одна строка таблицы на строку файла: {"table": "...", "row": {...}}
+	FormatNDJSON Format = "ndjson"
+	// FormatZip — zip-архив с файлом <таблица>.csv на каждую таблицу; NULL записывается пустым полем
+	FormatZip Format = "zip"
+)
+
+func (f Format) Valid() bool {
+	switch f {
+	case FormatNDJSON, FormatZip:
+		return true
+	}
+	return false
+}
+
+func (f Format) ContentType() string {
+	if f == FormatZip {
+		return "application/zip"
+	}
+	return "application/x-ndjson"
+}
+
+// Extension — расширение файла выгрузки без точки.
+func (f Format) Extension() string {
+	return string(f)
+}
+
+// Writer пишет выгрузку; Close дописывает буферы и, для zip, оглавление архива.
+type Writer interface {
+	repository.DumpWriter
+	Close() error
+}
+
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatNDJSON:
+		return newNDJSONWriter(w), nil
+	case FormatZip:
+		return &zipWriter{zw: zip.NewWriter(w)}, nil
+	}
+	return nil, fmt.Errorf("unknown dump format %q", format)
+}
+
+type ndjsonLine struct {
+	Table string          `json:"table"`
+	Row   json.RawMessage `json:"row"`
+}
+
+type ndjsonWriter struct {
+	buf   *bufio.Writer
+	enc   *json.Encoder
+	table string
+}
+
+func newNDJSONWriter(w io.Writer) *ndjsonWriter {
+	buf := bufio.NewWriter(w)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	return &ndjsonWriter{buf: buf, enc: enc}
+}
+
+func (w *ndjsonWriter) Table(table repository.DumpTable) error {
+	w.table = table.Name
+	return nil
+}
+
+func (w *ndjsonWriter) Row(row json.RawMessage) error {
+	return w.enc.Encode(ndjsonLine{Table: w.table, Row: row})
+}
+
+func (w *ndjsonWriter) Close() error {
+	return w.buf.Flush()
+}
+
+type zipWriter struct {
+	zw    *zip.Writer
+	csv   *csv.Writer
+	table repository.DumpTable
+}
+
+func (w *zipWriter) Table(table repository.DumpTable) error {
+	if err := w.flush(); err != nil {
+		return err
+	}
+
+	f, err := w.zw.Create(table.Name + ".csv")
+	if err != nil {
+		return err
+	}
+
+	w.table = table
+	w.csv = csv.NewWriter(f)
+	return w.csv.Write(table.Columns)
+}
+
+func (w *zipWriter) Row(row json.RawMessage) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(row, &fields); err != nil {
+		return err
+	}
+
+	record := make([]string, len(w.table.Columns))
+	for i, column := range w.table.Columns {
+		value, err := csvValue(fields[column], slices.Contains(w.table.JSONColumns, column))
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", w.table.Name, column, err)
+		}
+		record[i] = value
+	}
+
+	return w.csv.Write(record)
+}
+
+func (w *zipWriter) flush() error {
+	if w.csv == nil {
+		return nil
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+func (w *zipWriter) Close() error {
+	if err := w.flush(); err != nil {
+		return err
+	}
+	return w.zw.Close()
+}
+
+// csvValue переводит JSON-значение в поле CSV: строки без кавычек, JSON-колонки — текстом документа.
+func csvValue(value json.RawMessage, isJSON bool) (string, error) {
+	if len(value) == 0 || string(value) == "null" {
+		return "", nil
+	}
+	if isJSON || value[0] != '"' {
+		return string(value), nil
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// NewNDJSONReader читает выгрузку в формате FormatNDJSON.
+func NewNDJSONReader(r io.Reader) repository.DumpReader {
+	return &ndjsonReader{dec: json.NewDecoder(r)}
+}
+
+type ndjsonReader struct {
+	dec  *json.Decoder
+	line int
+}
+
+func (r *ndjsonReader) Next() (string, json.RawMessage, error) {
+	var line ndjsonLine
+	err := r.dec.Decode(&line)
+	if errors.Is(err, io.EOF) {
+		return "", nil, io.EOF
+	}
+	r.line++
+	if err != nil {
+		return "", nil, fmt.Errorf("line %d: %w", r.line, err)
+	}
+	if line.Table == "" || len(line.Row) == 0 {
+		return "", nil, fmt.Errorf("line %d: table and row are required", r.line)
+	}
+
+	return line.Table, line.Row, nil
+}
+
+// NewZipReader читает выгрузку в формате FormatZip; таблицы без файла в архиве пропускаются.
+func NewZipReader(r io.ReaderAt, size int64) (repository.DumpReader, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	return &zipReader{zr: zr}, nil
+}
+
+type zipReader struct {
+	zr      *zip.Reader
+	next    int // Индекс следующей таблицы в repository.DumpTables
+	table   repository.DumpTable
+	file    io.ReadCloser
+	csv     *csv.Reader
+	columns []string
+}
+
+func (r *zipReader) Next() (string, json.RawMessage, error) {
+	for {
+		if r.csv == nil {
+			if err := r.open(); err != nil {
+				return "", nil, err
+			}
+			continue
+		}
+
+		record, err := r.csv.Read()
+		if errors.Is(err, io.EOF) {
+			_ = r.file.Close()
+			r.csv = nil
+			continue
+		}
+		if err != nil {
+			return "", nil, fmt.Errorf("%s.csv: %w", r.table.Name, err)
+		}
+
+		row, err := r.row(record)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s.csv: %w", r.table.Name, err)
+		}
+		return r.table.Name, row, nil
+	}
+}
+
+// open открывает CSV следующей таблицы, которая есть в архиве, и читает её заголовок.
+func (r *zipReader) open() error {
+	for ; r.next < len(repository.DumpTables); r.next++ {
+		table := repository.DumpTables[r.next]
+		f, err := r.zr.Open(table.Name + ".csv")
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		r.next++
+
+		reader := csv.NewReader(f)
+		header, err := reader.Read()
+		if err != nil {
+			_ = f.Close()
+			return fmt.Errorf("%s.csv: read header: %w", table.Name, err)
+		}
+		for _, column := range header {
+			if !slices.Contains(table.Columns, column) {
+				_ = f.Close()
+				return fmt.Errorf("%s.csv: unknown column %q", table.Name, column)
+			}
+		}
+
+		r.table, r.file, r.csv, r.columns = table, f, reader, header
+		return nil
+	}
+
+	return io.EOF
+}
+
+func (r *zipReader) row(record []string) (json.RawMessage, error) {
+	fields := make(map[string]any, len(record))
+	for i, column := range r.columns {
+		switch {
+		case record[i] == "" && slices.Contains(r.table.TextColumns, column):
+			fields[column] = ""
+		case record[i] == "":
+			fields[column] = nil
+		case slices.Contains(r.table.JSONColumns, column):
+			if !json.Valid([]byte(record[i])) {
+				return nil, fmt.Errorf("column %s is not valid JSON", column)
+			}
+			fields[column] = json.RawMessage(record[i])
+		default:
+			fields[column] = record[i]
+		}
+	}
+
+	return json.Marshal(fields)
+}
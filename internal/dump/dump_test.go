@@ -0,0 +1,199 @@
+package dump
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/F3dosik/PRS.git/internal/repository"
+)
+
+type dumpRow struct {
+	table string
+	row   string
+}
+
+// testRows покрывают каждую таблицу выгрузки: UUID, время с микросекундами и поясом, NULL,
+// дробные числа, JSON-колонку, пустой текст и текст, который в CSV приходится экранировать.
+var testRows = []dumpRow{
+	{"teams", `{"id":"0b7e6a52-3c1d-4f7a-9a51-2f0c8d1e4b10","name":"backend","parent_id":null,"created_at":"2025-03-01T10:00:00.123456+00:00"}`},
+	{"teams", `{"id":"5d2f9c44-8e6b-4a3f-b1c7-6e9a0d3f2c21","name":"payments","parent_id":"0b7e6a52-3c1d-4f7a-9a51-2f0c8d1e4b10","created_at":"2025-03-02T08:30:15.5+03:00"}`},
+	{"users", `{"id":"9a4c1e7b-2d5f-4b8a-8c3e-1f6d0a9b7e32","name":"Ann \"Nan\", QA","is_active":true,"seniority":"senior","created_at":"2025-03-01T10:00:01+00:00"}`},
+	{"users", `{"id":"c3e8b2a1-7f4d-4e9c-a6b5-0d1f2e3c4b43","name":"Bob\nMultiline","is_active":false,"seniority":"junior","created_at":"2025-03-01T10:00:02+00:00"}`},
+	{"team_memberships", `{"user_id":"9a4c1e7b-2d5f-4b8a-8c3e-1f6d0a9b7e32","team_id":"0b7e6a52-3c1d-4f7a-9a51-2f0c8d1e4b10","role":"member","is_active":true,"is_primary":true,"created_at":"2025-03-01T10:00:01+00:00"}`},
+	{"team_fallbacks", `{"team_id":"5d2f9c44-8e6b-4a3f-b1c7-6e9a0d3f2c21","position":0,"kind":"team","fallback_team_id":"0b7e6a52-3c1d-4f7a-9a51-2f0c8d1e4b10"}`},
+	{"team_fallbacks", `{"team_id":"5d2f9c44-8e6b-4a3f-b1c7-6e9a0d3f2c21","position":1,"kind":"any_active","fallback_team_id":null}`},
+	{"code_owner_rules", `{"id":3,"team_id":"0b7e6a52-3c1d-4f7a-9a51-2f0c8d1e4b10","position":0,"pattern":"/docs/, \"api\""}`},
+	{"code_owner_rule_owners", `{"rule_id":3,"user_id":null,"owner_team_id":"5d2f9c44-8e6b-4a3f-b1c7-6e9a0d3f2c21"}`},
+	{"team_review_rules", `{"team_id":"0b7e6a52-3c1d-4f7a-9a51-2f0c8d1e4b10","require_senior":true,"shadow_junior":false,"rotation_window":5,"rotation_penalty":0.25}`},
+	{"team_sla", `{"team_id":"0b7e6a52-3c1d-4f7a-9a51-2f0c8d1e4b10","first_review_hours":4,"reassign_after_hours":null}`},
+	{"team_schedules", `{"team_id":"0b7e6a52-3c1d-4f7a-9a51-2f0c8d1e4b10","timezone":"Europe/Berlin","work_days":62,"start_minute":540,"end_minute":1080}`},
+	{"user_schedules", `{"user_id":"9a4c1e7b-2d5f-4b8a-8c3e-1f6d0a9b7e32","timezone":"Asia/Tokyo","work_days":31,"start_minute":0,"end_minute":1440}`},
+	{"holidays", `{"id":1,"team_id":null,"year":null,"month":5,"day":1,"name":"Labour Day"}`},
+	{"holidays", `{"id":2,"team_id":"0b7e6a52-3c1d-4f7a-9a51-2f0c8d1e4b10","year":2025,"month":12,"day":31,"name":""}`},
+	{"user_skills", `{"user_id":"9a4c1e7b-2d5f-4b8a-8c3e-1f6d0a9b7e32","skill":"go"}`},
+	{"pull_request", `{"id":"e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a54","title":"Add retries","author_id":"9a4c1e7b-2d5f-4b8a-8c3e-1f6d0a9b7e32","team_id":"0b7e6a52-3c1d-4f7a-9a51-2f0c8d1e4b10","status":"MERGED","reviewer1_id":"c3e8b2a1-7f4d-4e9c-a6b5-0d1f2e3c4b43","reviewer2_id":null,"shadow_reviewer_id":null,"need_more_reviewers":false,"created_at":"2025-03-03T12:00:00.000001+00:00","merged_at":"2025-03-04T09:15:00+00:00"}`},
+	{"pull_request_files", `{"pull_request_id":"e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a54","path":"cmd/app/main.go"}`},
+	{"pull_request_labels", `{"pull_request_id":"e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a54","label":"backend"}`},
+	{"assignment_decisions", `{"id":7,"pull_request_id":"e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a54","reason":"create","seed":-9223372036854775807,"algorithm_version":2,"input":{"candidates":[{"user_id":"c3e8b2a1-7f4d-4e9c-a6b5-0d1f2e3c4b43","score":1.5}],"labels":["backend"]},"created_at":"2025-03-03T12:00:00.000002+00:00"}`},
+	{"review_assignments", `{"id":12,"pull_request_id":"e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a54","reviewer_id":"c3e8b2a1-7f4d-4e9c-a6b5-0d1f2e3c4b43","source":"team","source_team_id":"0b7e6a52-3c1d-4f7a-9a51-2f0c8d1e4b10","decision_id":7,"shadow":false,"assigned_at":"2025-03-03T12:00:00.000002+00:00","unassigned_at":null,"sla_breached_at":null,"sla_escalated_at":null}`},
+}
+
+func writeDump(t *testing.T, format Format, rows []dumpRow) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w, err := NewWriter(format, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Writer получает таблицы в порядке DumpTables, как при Export
+	for _, table := range repository.DumpTables {
+		if err := w.Table(table); err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range rows {
+			if r.table != table.Name {
+				continue
+			}
+			if err := w.Row(json.RawMessage(r.row)); err != nil {
+				t.Fatalf("%s: %v", r.table, err)
+			}
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func readDump(t *testing.T, r repository.DumpReader) []dumpRow {
+	t.Helper()
+
+	var rows []dumpRow
+	for {
+		table, row, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return rows
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		rows = append(rows, dumpRow{table: table, row: string(row)})
+	}
+}
+
+// decodeRow раскладывает строку по колонкам; для zip скаляры сравниваются текстом, потому что CSV
+// не хранит тип, а при восстановлении json_populate_recordset разбирает их по типу колонки.
+func decodeRow(t *testing.T, r dumpRow, asText bool) map[string]any {
+	t.Helper()
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(r.row), &fields); err != nil {
+		t.Fatalf("%s: %v", r.table, err)
+	}
+
+	index := slices.IndexFunc(repository.DumpTables, func(d repository.DumpTable) bool { return d.Name == r.table })
+	if index < 0 {
+		t.Fatalf("unknown table %q", r.table)
+	}
+	table := repository.DumpTables[index]
+
+	out := make(map[string]any, len(fields))
+	for column, value := range fields {
+		// UseNumber: BIGINT seed не помещается в float64 без потерь
+		dec := json.NewDecoder(bytes.NewReader(value))
+		dec.UseNumber()
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			t.Fatalf("%s.%s: %v", r.table, column, err)
+		}
+		if asText && v != nil && !slices.Contains(table.JSONColumns, column) {
+			if _, ok := v.(string); !ok {
+				v = string(value)
+			}
+		}
+		out[column] = v
+	}
+	return out
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		format Format
+		read   func(data []byte) (repository.DumpReader, error)
+	}{
+		{
+			format: FormatNDJSON,
+			read: func(data []byte) (repository.DumpReader, error) {
+				return NewNDJSONReader(bytes.NewReader(data)), nil
+			},
+		},
+		{
+			format: FormatZip,
+			read: func(data []byte) (repository.DumpReader, error) {
+				return NewZipReader(bytes.NewReader(data), int64(len(data)))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			data := writeDump(t, tt.format, testRows)
+			r, err := tt.read(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := readDump(t, r)
+
+			if len(got) != len(testRows) {
+				t.Fatalf("got %d rows, want %d", len(got), len(testRows))
+			}
+			asText := tt.format == FormatZip
+			for i, want := range testRows {
+				if got[i].table != want.table {
+					t.Fatalf("row %d: table %q, want %q", i, got[i].table, want.table)
+				}
+				if g, w := decodeRow(t, got[i], asText), decodeRow(t, want, asText); !reflect.DeepEqual(g, w) {
+					t.Errorf("row %d (%s):\n got %v\nwant %v", i, want.table, g, w)
+				}
+			}
+		})
+	}
+}
+
+func TestRowsCoverDumpTables(t *testing.T) {
+	for _, table := range repository.DumpTables {
+		if !slices.ContainsFunc(testRows, func(r dumpRow) bool { return r.table == table.Name }) {
+			t.Errorf("no test row for table %s", table.Name)
+		}
+	}
+}
+
+func TestZipReaderRejectsUnknownColumn(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(FormatZip, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	table := repository.DumpTable{Name: "users", Columns: []string{"id", "password"}}
+	if err := w.Table(table); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewZipReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := r.Next(); err == nil || errors.Is(err, io.EOF) {
+		t.Fatalf("Next() = %v, want unknown column error", err)
+	}
+}
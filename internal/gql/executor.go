@@ -0,0 +1,69 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"go.uber.org/zap"
+)
+
+// Config — ограничения на запросы GraphQL.
+type Config struct {
+	MaxDepth      int
+	MaxComplexity int
+}
+
+// Request — тело запроса GraphQL.
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+	Extensions    map[string]any `json:"extensions,omitempty"`
+}
+
+// Executor выполняет запросы GraphQL поверх repository.Storage.
+type Executor struct {
+	schema  graphql.Schema
+	storage *repository.Storage
+	config  Config
+}
+
+func NewExecutor(storage *repository.Storage, logger *zap.SugaredLogger, config Config) (*Executor, error) {
+	schema, err := newSchema(storage, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Executor{schema: schema, storage: storage, config: config}, nil
+}
+
+// Execute разбирает, валидирует и проверяет запрос на ограничения, после чего выполняет его
+// с отдельным набором загрузчиков, общим для всех полей запроса.
+func (e *Executor) Execute(ctx context.Context, req Request) *graphql.Result {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	if validation := graphql.ValidateDocument(&e.schema, doc, nil); !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}
+	}
+
+	if errs := checkLimits(&e.schema, doc, e.config.MaxDepth, e.config.MaxComplexity); len(errs) > 0 {
+		return &graphql.Result{Errors: errs}
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        e.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       withLoaders(ctx, newLoaders(e.storage)),
+	})
+}
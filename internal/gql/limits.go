@@ -0,0 +1,138 @@
+package gql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// listFactor — во сколько раз дороже поля под списком: стоимость вложенной выборки
+// умножается на него, поскольку она повторяется для каждого элемента.
+const listFactor = 10
+
+// cost — глубина и сложность выборки.
+type cost struct {
+	depth      int
+	complexity int
+}
+
+// checkLimits считает глубину и сложность каждой операции документа. Каждое поле стоит 1,
+// выборка под полем-списком — в listFactor раз больше. Служебные поля (__typename, __schema)
+// не учитываются, чтобы работала интроспекция. Документ должен быть уже провалидирован.
+func checkLimits(schema *graphql.Schema, doc *ast.Document, maxDepth, maxComplexity int) []gqlerrors.FormattedError {
+	m := &measurer{
+		schema:    schema,
+		fragments: make(map[string]*ast.FragmentDefinition),
+		visiting:  make(map[string]bool),
+	}
+	for _, def := range doc.Definitions {
+		if frag, ok := def.(*ast.FragmentDefinition); ok {
+			m.fragments[frag.Name.Value] = frag
+		}
+	}
+
+	var errs []gqlerrors.FormattedError
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+
+		var root graphql.Type = schema.QueryType()
+		if op.Operation == ast.OperationTypeMutation {
+			root = schema.MutationType()
+		}
+		c := m.selectionSet(op.SelectionSet, root)
+
+		if c.depth > maxDepth {
+			errs = append(errs, gqlerrors.NewFormattedError(
+				fmt.Sprintf("query depth %d exceeds the limit of %d", c.depth, maxDepth)))
+		}
+		if c.complexity > maxComplexity {
+			errs = append(errs, gqlerrors.NewFormattedError(
+				fmt.Sprintf("query complexity %d exceeds the limit of %d", c.complexity, maxComplexity)))
+		}
+	}
+
+	return errs
+}
+
+type measurer struct {
+	schema    *graphql.Schema
+	fragments map[string]*ast.FragmentDefinition
+	visiting  map[string]bool // Защита от циклов фрагментов
+}
+
+func (m *measurer) selectionSet(set *ast.SelectionSet, parent graphql.Type) cost {
+	var total cost
+	if set == nil {
+		return total
+	}
+
+	for _, selection := range set.Selections {
+		var c cost
+		switch s := selection.(type) {
+		case *ast.Field:
+			c = m.field(s, parent)
+		case *ast.InlineFragment:
+			typ := parent
+			if s.TypeCondition != nil {
+				typ = m.schema.Type(s.TypeCondition.Name.Value)
+			}
+			c = m.selectionSet(s.SelectionSet, typ)
+		case *ast.FragmentSpread:
+			frag, ok := m.fragments[s.Name.Value]
+			if !ok || m.visiting[s.Name.Value] {
+				continue
+			}
+			m.visiting[s.Name.Value] = true
+			c = m.selectionSet(frag.SelectionSet, m.schema.Type(frag.TypeCondition.Name.Value))
+			delete(m.visiting, s.Name.Value)
+		}
+		total.depth = max(total.depth, c.depth)
+		total.complexity += c.complexity
+	}
+
+	return total
+}
+
+func (m *measurer) field(f *ast.Field, parent graphql.Type) cost {
+	if strings.HasPrefix(f.Name.Value, "__") {
+		return cost{}
+	}
+
+	var fieldType graphql.Type
+	switch p := parent.(type) {
+	case *graphql.Object:
+		if def, ok := p.Fields()[f.Name.Value]; ok {
+			fieldType = def.Type
+		}
+	case *graphql.Interface:
+		if def, ok := p.Fields()[f.Name.Value]; ok {
+			fieldType = def.Type
+		}
+	}
+
+	factor := 1
+	for {
+		if nonNull, ok := fieldType.(*graphql.NonNull); ok {
+			fieldType = nonNull.OfType
+			continue
+		}
+		if list, ok := fieldType.(*graphql.List); ok {
+			factor *= listFactor
+			fieldType = list.OfType
+			continue
+		}
+		break
+	}
+
+	child := m.selectionSet(f.SelectionSet, fieldType)
+	return cost{
+		depth:      child.depth + 1,
+		complexity: 1 + child.complexity*factor,
+	}
+}
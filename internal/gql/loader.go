@@ -0,0 +1,96 @@
+package gql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+// loader собирает ключи, запрошенные на одном уровне запроса, и загружает их одной выборкой.
+// load возвращает thunk: исполнитель GraphQL вызывает thunk'и в ширину, уже после того как
+// все поля уровня зарегистрировали свои ключи, поэтому первый вызов загружает весь накопленный набор.
+// Результаты кешируются на время запроса; отсутствующему ключу соответствует нулевое значение.
+type loader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	pending []K
+	queued  map[K]bool
+	loaded  map[K]V
+	errs    map[K]error
+}
+
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:  fetch,
+		queued: make(map[K]bool),
+		loaded: make(map[K]V),
+		errs:   make(map[K]error),
+	}
+}
+
+func (l *loader[K, V]) load(ctx context.Context, key K) func() (V, error) {
+	l.mu.Lock()
+	if !l.queued[key] {
+		l.queued[key] = true
+		l.pending = append(l.pending, key)
+	}
+	l.mu.Unlock()
+
+	return func() (V, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if len(l.pending) > 0 {
+			l.flush(ctx)
+		}
+		return l.loaded[key], l.errs[key]
+	}
+}
+
+// flush загружает накопленные ключи; вызывается под l.mu.
+func (l *loader[K, V]) flush(ctx context.Context) {
+	keys := l.pending
+	l.pending = nil
+
+	values, err := l.fetch(ctx, keys)
+	for _, key := range keys {
+		if err != nil {
+			l.errs[key] = err
+			continue
+		}
+		l.loaded[key] = values[key]
+	}
+}
+
+// loaders — набор загрузчиков одного запроса.
+type loaders struct {
+	teams       *loader[string, *api.Team]
+	users       *loader[uuid.UUID, *api.User]
+	pullReqs    *loader[uuid.UUID, *api.PullRequest]
+	reviews     *loader[uuid.UUID, []api.PullRequest]
+	assignments *loader[uuid.UUID, []api.ReviewAssignment]
+}
+
+func newLoaders(storage *repository.Storage) *loaders {
+	return &loaders{
+		teams:       newLoader(storage.TeamsByNames),
+		users:       newLoader(storage.UsersByIDs),
+		pullReqs:    newLoader(storage.PullRequestsByIDs),
+		reviews:     newLoader(storage.ReviewsByUserIDs),
+		assignments: newLoader(storage.AssignmentsByPullRequestIDs),
+	}
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,507 @@
+package gql
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+	"go.uber.org/zap"
+)
+
+// statsSource — результат /stats вместе с параметрами, по которым лениво считаются задержки.
+type statsSource struct {
+	stats              *api.StatsResponseV2
+	includeDescendants bool
+}
+
+// schemaBuilder строит схему; типы ссылаются друг на друга, поэтому поля задаются thunk'ами.
+type schemaBuilder struct {
+	storage *repository.Storage
+	logger  *zap.SugaredLogger
+
+	status          *graphql.Enum
+	team            *graphql.Object
+	teamMember      *graphql.Object
+	user            *graphql.Object
+	membership      *graphql.Object
+	pullRequest     *graphql.Object
+	assignment      *graphql.Object
+	stats           *graphql.Object
+	reviewCount     *graphql.Object
+	latency         *graphql.Object
+	latencySummary  *graphql.Object
+	reviewerLatency *graphql.Object
+}
+
+func newSchema(storage *repository.Storage, logger *zap.SugaredLogger) (graphql.Schema, error) {
+	b := &schemaBuilder{storage: storage, logger: logger}
+
+	b.status = graphql.NewEnum(graphql.EnumConfig{
+		Name: "PullRequestStatus",
+		Values: graphql.EnumValueConfigMap{
+			"OPEN":   &graphql.EnumValueConfig{Value: api.StatusOpen},
+			"MERGED": &graphql.EnumValueConfig{Value: api.StatusMerged},
+		},
+	})
+
+	b.team = graphql.NewObject(graphql.ObjectConfig{Name: "Team", Fields: graphql.FieldsThunk(b.teamFields)})
+	b.teamMember = graphql.NewObject(graphql.ObjectConfig{Name: "TeamMember", Fields: graphql.FieldsThunk(b.teamMemberFields)})
+	b.user = graphql.NewObject(graphql.ObjectConfig{Name: "User", Fields: graphql.FieldsThunk(b.userFields)})
+	b.membership = graphql.NewObject(graphql.ObjectConfig{Name: "Membership", Fields: graphql.FieldsThunk(b.membershipFields)})
+	b.pullRequest = graphql.NewObject(graphql.ObjectConfig{Name: "PullRequest", Fields: graphql.FieldsThunk(b.pullRequestFields)})
+	b.assignment = graphql.NewObject(graphql.ObjectConfig{Name: "Assignment", Fields: graphql.FieldsThunk(b.assignmentFields)})
+	b.stats = graphql.NewObject(graphql.ObjectConfig{Name: "Stats", Fields: graphql.FieldsThunk(b.statsFields)})
+	b.reviewCount = graphql.NewObject(graphql.ObjectConfig{Name: "ReviewCount", Fields: graphql.FieldsThunk(b.reviewCountFields)})
+	b.latency = graphql.NewObject(graphql.ObjectConfig{Name: "LatencyStats", Fields: graphql.FieldsThunk(b.latencyFields)})
+	b.latencySummary = graphql.NewObject(graphql.ObjectConfig{Name: "LatencySummary", Fields: graphql.FieldsThunk(b.latencySummaryFields)})
+	b.reviewerLatency = graphql.NewObject(graphql.ObjectConfig{Name: "ReviewerLatency", Fields: graphql.FieldsThunk(b.reviewerLatencyFields)})
+
+	query := graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: graphql.FieldsThunk(b.queryFields)})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+func (b *schemaBuilder) queryFields() graphql.Fields {
+	return graphql.Fields{
+		"team": &graphql.Field{
+			Type:        b.team,
+			Description: "Команда; при includeDescendants в members попадают и участники подкоманд.",
+			Args: graphql.FieldConfigArgument{
+				"name":               &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"includeDescendants": &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				name, _ := p.Args["name"].(string)
+				if includeDescendants, _ := p.Args["includeDescendants"].(bool); includeDescendants {
+					team, err := b.storage.GetTeam(p.Context, name, true)
+					if isNotFound(err) {
+						return nil, nil
+					}
+					if err != nil {
+						return nil, b.resolveError(err)
+					}
+					return team, nil
+				}
+				return b.loadTeam(p.Context, name), nil
+			},
+		},
+		"user": &graphql.Field{
+			Type: b.user,
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				id, err := argUUID(p, "id")
+				if err != nil {
+					return nil, err
+				}
+				return b.loadUser(p.Context, id), nil
+			},
+		},
+		"pullRequest": &graphql.Field{
+			Type: b.pullRequest,
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				id, err := argUUID(p, "id")
+				if err != nil {
+					return nil, err
+				}
+				load := loadersFrom(p.Context).pullReqs.load(p.Context, id)
+				return func() (any, error) {
+					pr, err := load()
+					if err != nil {
+						return nil, b.resolveError(err)
+					}
+					if pr == nil {
+						return nil, nil
+					}
+					return *pr, nil
+				}, nil
+			},
+		},
+		"stats": &graphql.Field{
+			Type:        graphql.NewNonNull(b.stats),
+			Description: "Статистика по всем PR либо по PR команды teamName.",
+			Args: graphql.FieldConfigArgument{
+				"teamName":           &graphql.ArgumentConfig{Type: graphql.String, DefaultValue: ""},
+				"includeDescendants": &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				teamName, _ := p.Args["teamName"].(string)
+				includeDescendants, _ := p.Args["includeDescendants"].(bool)
+				stats, err := b.storage.GetReviewerStats(p.Context, teamName, includeDescendants)
+				if err != nil {
+					return nil, b.resolveError(err)
+				}
+				return statsSource{stats: stats, includeDescendants: includeDescendants}, nil
+			},
+		},
+	}
+}
+
+func (b *schemaBuilder) teamFields() graphql.Fields {
+	return graphql.Fields{
+		"name": field(graphql.NewNonNull(graphql.String), func(t *api.Team) any { return t.TeamName }),
+		"parent": &graphql.Field{
+			Type: b.team,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				t := p.Source.(*api.Team)
+				if t.ParentTeam == nil {
+					return nil, nil
+				}
+				return b.loadTeam(p.Context, *t.ParentTeam), nil
+			},
+		},
+		"members": field(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(b.teamMember))), func(t *api.Team) any {
+			return t.Members
+		}),
+	}
+}
+
+func (b *schemaBuilder) teamMemberFields() graphql.Fields {
+	return graphql.Fields{
+		"user": &graphql.Field{
+			Type: graphql.NewNonNull(b.user),
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return b.loadUser(p.Context, p.Source.(api.TeamMember).UserID), nil
+			},
+		},
+		"username":  field(graphql.NewNonNull(graphql.String), func(m api.TeamMember) any { return m.Username }),
+		"role":      field(graphql.String, func(m api.TeamMember) any { return m.Role }),
+		"isActive":  field(graphql.NewNonNull(graphql.Boolean), func(m api.TeamMember) any { return m.IsActive }),
+		"seniority": field(graphql.String, func(m api.TeamMember) any { return string(m.Seniority) }),
+		"teamName": field(graphql.String, func(m api.TeamMember) any {
+			if m.TeamName == "" {
+				return nil
+			}
+			return m.TeamName
+		}),
+	}
+}
+
+func (b *schemaBuilder) userFields() graphql.Fields {
+	return graphql.Fields{
+		"id":        field(graphql.NewNonNull(graphql.ID), func(u *api.User) any { return u.UserID.String() }),
+		"username":  field(graphql.NewNonNull(graphql.String), func(u *api.User) any { return u.Username }),
+		"isActive":  field(graphql.NewNonNull(graphql.Boolean), func(u *api.User) any { return u.IsActive }),
+		"seniority": field(graphql.NewNonNull(graphql.String), func(u *api.User) any { return string(u.Seniority) }),
+		"team": &graphql.Field{
+			Type:        b.team,
+			Description: "Основная команда.",
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				u := p.Source.(*api.User)
+				if u.TeamName == nil {
+					return nil, nil
+				}
+				return b.loadTeam(p.Context, *u.TeamName), nil
+			},
+		},
+		"memberships": field(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(b.membership))), func(u *api.User) any {
+			return u.Teams
+		}),
+		"reviews": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(b.pullRequest))),
+			Description: "PR, где пользователь сейчас обязательный или теневой ревьювер, от новых к старым.",
+			Args: graphql.FieldConfigArgument{
+				"status": &graphql.ArgumentConfig{Type: b.status},
+			},
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				status, _ := p.Args["status"].(api.PRStatus)
+				load := loadersFrom(p.Context).reviews.load(p.Context, p.Source.(*api.User).UserID)
+				return func() (any, error) {
+					prs, err := load()
+					if err != nil {
+						return nil, b.resolveError(err)
+					}
+					reviews := make([]api.PullRequest, 0, len(prs))
+					for _, pr := range prs {
+						if status == "" || pr.Status == status {
+							reviews = append(reviews, pr)
+						}
+					}
+					return reviews, nil
+				}, nil
+			},
+		},
+	}
+}
+
+func (b *schemaBuilder) membershipFields() graphql.Fields {
+	return graphql.Fields{
+		"team": &graphql.Field{
+			Type: graphql.NewNonNull(b.team),
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return b.loadTeam(p.Context, p.Source.(api.UserTeam).TeamName), nil
+			},
+		},
+		"role":      field(graphql.String, func(m api.UserTeam) any { return m.Role }),
+		"isActive":  field(graphql.NewNonNull(graphql.Boolean), func(m api.UserTeam) any { return m.IsActive }),
+		"isPrimary": field(graphql.NewNonNull(graphql.Boolean), func(m api.UserTeam) any { return m.IsPrimary }),
+	}
+}
+
+func (b *schemaBuilder) pullRequestFields() graphql.Fields {
+	return graphql.Fields{
+		"id":        field(graphql.NewNonNull(graphql.ID), func(pr api.PullRequest) any { return pr.PullRequestID.String() }),
+		"name":      field(graphql.NewNonNull(graphql.String), func(pr api.PullRequest) any { return pr.PullRequestName }),
+		"status":    field(graphql.NewNonNull(b.status), func(pr api.PullRequest) any { return pr.Status }),
+		"createdAt": field(graphql.DateTime, func(pr api.PullRequest) any { return pr.CreatedAt }),
+		"mergedAt": field(graphql.DateTime, func(pr api.PullRequest) any {
+			if pr.MergedAt == nil {
+				return nil
+			}
+			return *pr.MergedAt
+		}),
+		"team": &graphql.Field{
+			Type: b.team,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				pr := p.Source.(api.PullRequest)
+				if pr.TeamName == "" {
+					return nil, nil
+				}
+				return b.loadTeam(p.Context, pr.TeamName), nil
+			},
+		},
+		"author": &graphql.Field{
+			Type: graphql.NewNonNull(b.user),
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return b.loadUser(p.Context, p.Source.(api.PullRequest).AuthorID), nil
+			},
+		},
+		"reviewers": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(b.user))),
+			Description: "Обязательные ревьюверы.",
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return b.loadUsers(p.Context, p.Source.(api.PullRequest).AssignedReviewers), nil
+			},
+		},
+		"shadowReviewer": &graphql.Field{
+			Type: b.user,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				pr := p.Source.(api.PullRequest)
+				if pr.ShadowReviewer == nil {
+					return nil, nil
+				}
+				return b.loadUser(p.Context, *pr.ShadowReviewer), nil
+			},
+		},
+		"assignments": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(b.assignment))),
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				load := loadersFrom(p.Context).assignments.load(p.Context, p.Source.(api.PullRequest).PullRequestID)
+				return func() (any, error) {
+					assignments, err := load()
+					if err != nil {
+						return nil, b.resolveError(err)
+					}
+					if assignments == nil {
+						assignments = []api.ReviewAssignment{}
+					}
+					return assignments, nil
+				}, nil
+			},
+		},
+	}
+}
+
+func (b *schemaBuilder) assignmentFields() graphql.Fields {
+	return graphql.Fields{
+		"reviewer": &graphql.Field{
+			Type: graphql.NewNonNull(b.user),
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return b.loadUser(p.Context, p.Source.(api.ReviewAssignment).ReviewerID), nil
+			},
+		},
+		"source": field(graphql.NewNonNull(graphql.String), func(a api.ReviewAssignment) any { return string(a.Source) }),
+		"sourceTeam": &graphql.Field{
+			Type: b.team,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				a := p.Source.(api.ReviewAssignment)
+				if a.SourceTeam == nil {
+					return nil, nil
+				}
+				return b.loadTeam(p.Context, *a.SourceTeam), nil
+			},
+		},
+		"decisionId": field(graphql.ID, func(a api.ReviewAssignment) any {
+			if a.DecisionID == nil {
+				return nil
+			}
+			return strconv.FormatInt(*a.DecisionID, 10)
+		}),
+		"shadow": field(graphql.NewNonNull(graphql.Boolean), func(a api.ReviewAssignment) any { return a.Shadow }),
+	}
+}
+
+func (b *schemaBuilder) statsFields() graphql.Fields {
+	return graphql.Fields{
+		"teamName": field(graphql.String, func(s statsSource) any {
+			if s.stats.TeamName == "" {
+				return nil
+			}
+			return s.stats.TeamName
+		}),
+		"totalPR": field(graphql.NewNonNull(graphql.Int), func(s statsSource) any { return s.stats.TotalPR }),
+		"openPR":  field(graphql.NewNonNull(graphql.Int), func(s statsSource) any { return s.stats.OpenPR }),
+		"reviewAssignments": field(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(b.reviewCount))), func(s statsSource) any {
+			return s.stats.ReviewAssignments
+		}),
+		"latency": &graphql.Field{
+			Type:        graphql.NewNonNull(b.latency),
+			Description: "Задержки по слитым PR в рабочих часах.",
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				s := p.Source.(statsSource)
+				latency, err := b.storage.GetLatencyStats(p.Context, s.stats.TeamName, s.includeDescendants)
+				if err != nil {
+					return nil, b.resolveError(err)
+				}
+				return latency, nil
+			},
+		},
+	}
+}
+
+func (b *schemaBuilder) reviewCountFields() graphql.Fields {
+	return graphql.Fields{
+		"user": &graphql.Field{
+			Type: graphql.NewNonNull(b.user),
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return b.loadUser(p.Context, p.Source.(api.ReviewerCount).UserID), nil
+			},
+		},
+		"count": field(graphql.NewNonNull(graphql.Int), func(c api.ReviewerCount) any { return c.Count }),
+	}
+}
+
+func (b *schemaBuilder) latencyFields() graphql.Fields {
+	return graphql.Fields{
+		"merge": field(graphql.NewNonNull(b.latencySummary), func(l *api.LatencyStatsResponse) any { return l.Merge }),
+		"reviewers": field(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(b.reviewerLatency))), func(l *api.LatencyStatsResponse) any {
+			return l.Reviewers
+		}),
+	}
+}
+
+func (b *schemaBuilder) latencySummaryFields() graphql.Fields {
+	return graphql.Fields{
+		"count":       field(graphql.NewNonNull(graphql.Int), func(s api.LatencySummary) any { return s.Count }),
+		"meanHours":   field(graphql.NewNonNull(graphql.Float), func(s api.LatencySummary) any { return s.MeanHours }),
+		"medianHours": field(graphql.NewNonNull(graphql.Float), func(s api.LatencySummary) any { return s.MedianHours }),
+		"p90Hours":    field(graphql.NewNonNull(graphql.Float), func(s api.LatencySummary) any { return s.P90Hours }),
+	}
+}
+
+func (b *schemaBuilder) reviewerLatencyFields() graphql.Fields {
+	return graphql.Fields{
+		"user": &graphql.Field{
+			Type: graphql.NewNonNull(b.user),
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return b.loadUser(p.Context, p.Source.(api.ReviewerLatency).UserID), nil
+			},
+		},
+		"latency": field(graphql.NewNonNull(b.latencySummary), func(r api.ReviewerLatency) any { return r.LatencySummary }),
+	}
+}
+
+// loadTeam, loadUser и loadUsers регистрируют ключи в загрузчиках запроса и возвращают thunk'и.
+func (b *schemaBuilder) loadTeam(ctx context.Context, name string) func() (any, error) {
+	load := loadersFrom(ctx).teams.load(ctx, name)
+	return func() (any, error) {
+		team, err := load()
+		if err != nil {
+			return nil, b.resolveError(err)
+		}
+		if team == nil {
+			return nil, nil
+		}
+		return team, nil
+	}
+}
+
+func (b *schemaBuilder) loadUser(ctx context.Context, id uuid.UUID) func() (any, error) {
+	load := loadersFrom(ctx).users.load(ctx, id)
+	return func() (any, error) {
+		user, err := load()
+		if err != nil {
+			return nil, b.resolveError(err)
+		}
+		if user == nil {
+			return nil, nil
+		}
+		return user, nil
+	}
+}
+
+func (b *schemaBuilder) loadUsers(ctx context.Context, ids []uuid.UUID) func() (any, error) {
+	loads := make([]func() (*api.User, error), len(ids))
+	for i, id := range ids {
+		loads[i] = loadersFrom(ctx).users.load(ctx, id)
+	}
+	return func() (any, error) {
+		users := make([]*api.User, 0, len(loads))
+		for _, load := range loads {
+			user, err := load()
+			if err != nil {
+				return nil, b.resolveError(err)
+			}
+			if user != nil {
+				users = append(users, user)
+			}
+		}
+		return users, nil
+	}
+}
+
+// field описывает поле, значение которого просто берётся из источника типа T.
+func field[T any](typ graphql.Output, get func(T) any) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return get(p.Source.(T)), nil
+		},
+	}
+}
+
+func argUUID(p graphql.ResolveParams, name string) (uuid.UUID, error) {
+	raw, _ := p.Args[name].(string)
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, &apiError{api.NewAPIError(api.ErrInvalidParameter, "invalid "+name+" format")}
+	}
+	return id, nil
+}
+
+func isNotFound(err error) bool {
+	var apiErr *api.APIError
+	return errors.As(err, &apiErr) && apiErr.Code == api.ErrNotFound
+}
+
+// apiError передаёт код ошибки REST API в extensions.code ответа GraphQL.
+type apiError struct {
+	*api.APIError
+}
+
+func (e *apiError) Error() string {
+	return e.Message
+}
+
+func (e *apiError) Extensions() map[string]any {
+	return map[string]any{"code": e.Code}
+}
+
+// resolveError отдаёт клиенту ошибки API как есть, а внутренние ошибки только журналирует.
+func (b *schemaBuilder) resolveError(err error) error {
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) {
+		return &apiError{apiErr}
+	}
+
+	b.logger.Warn("cannot resolve graphql field", zap.Error(err))
+	return errors.New("internal error")
+}
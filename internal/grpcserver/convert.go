@@ -0,0 +1,428 @@
+package grpcserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	prsv1 "github.com/F3dosik/PRS.git/pkg/pb/prs/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func uuidStrings(ids []uuid.UUID) []string {
+	if ids == nil {
+		return nil
+	}
+
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = id.String()
+	}
+	return out
+}
+
+func parseUUIDs(raw []string, code api.ErrorCode, field string) ([]uuid.UUID, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, len(raw))
+	for i, s := range raw {
+		id, err := parseUUID(s, code, field)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func parseOptionalUUID(raw string, code api.ErrorCode, field string) (*uuid.UUID, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	id, err := parseUUID(raw, code, field)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func optionalUUID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+
+	s := id.String()
+	return &s
+}
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func optionalInt32(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	i := int32(*v)
+	return &i
+}
+
+func teamFromProto(t *prsv1.Team) (*api.Team, error) {
+	team := &api.Team{
+		TeamName:   t.GetTeamName(),
+		ParentTeam: t.ParentTeam,
+		Members:    make([]api.TeamMember, 0, len(t.GetMembers())),
+	}
+	for _, m := range t.GetMembers() {
+		userID, err := parseUUID(m.GetUserId(), api.ErrInvalidTeam, "user_id")
+		if err != nil {
+			return nil, err
+		}
+		team.Members = append(team.Members, api.TeamMember{
+			UserID:    userID,
+			Username:  m.GetUsername(),
+			IsActive:  m.GetIsActive(),
+			Role:      m.GetRole(),
+			Seniority: api.Seniority(m.GetSeniority()),
+		})
+	}
+
+	return team, nil
+}
+
+func teamToProto(t *api.Team) *prsv1.Team {
+	team := &prsv1.Team{
+		TeamName:   t.TeamName,
+		ParentTeam: t.ParentTeam,
+		Members:    make([]*prsv1.TeamMember, len(t.Members)),
+	}
+	for i, m := range t.Members {
+		team.Members[i] = &prsv1.TeamMember{
+			UserId:    m.UserID.String(),
+			Username:  m.Username,
+			IsActive:  m.IsActive,
+			Role:      m.Role,
+			Seniority: string(m.Seniority),
+			TeamName:  m.TeamName,
+		}
+	}
+
+	return team
+}
+
+func fallbackFromProto(f *prsv1.TeamFallback) *api.TeamFallback {
+	fallback := &api.TeamFallback{
+		TeamName: f.GetTeamName(),
+		Chain:    make([]api.FallbackEntry, len(f.GetChain())),
+	}
+	for i, e := range f.GetChain() {
+		fallback.Chain[i] = api.FallbackEntry{Kind: api.FallbackKind(e.GetKind()), TeamName: e.GetTeamName()}
+	}
+
+	return fallback
+}
+
+func fallbackToProto(f *api.TeamFallback) *prsv1.TeamFallback {
+	fallback := &prsv1.TeamFallback{
+		TeamName: f.TeamName,
+		Chain:    make([]*prsv1.FallbackEntry, len(f.Chain)),
+	}
+	for i, e := range f.Chain {
+		fallback.Chain[i] = &prsv1.FallbackEntry{Kind: string(e.Kind), TeamName: e.TeamName}
+	}
+
+	return fallback
+}
+
+func teamNodeToProto(n *api.TeamNode) *prsv1.TeamNode {
+	node := &prsv1.TeamNode{
+		TeamName:   n.TeamName,
+		ParentTeam: n.ParentTeam,
+		Children:   make([]*prsv1.TeamNode, len(n.Children)),
+	}
+	for i := range n.Children {
+		node.Children[i] = teamNodeToProto(&n.Children[i])
+	}
+
+	return node
+}
+
+func ownersFromProto(o *prsv1.TeamOwners) (*api.TeamOwners, error) {
+	owners := &api.TeamOwners{
+		TeamName: o.GetTeamName(),
+		Rules:    make([]api.CodeOwnerRule, 0, len(o.GetRules())),
+	}
+	for _, r := range o.GetRules() {
+		users, err := parseUUIDs(r.GetUsers(), api.ErrInvalidParameter, "users")
+		if err != nil {
+			return nil, err
+		}
+		owners.Rules = append(owners.Rules, api.CodeOwnerRule{
+			Pattern: r.GetPattern(),
+			Users:   users,
+			Teams:   r.GetTeams(),
+		})
+	}
+
+	return owners, nil
+}
+
+func ownersToProto(o *api.TeamOwners) *prsv1.TeamOwners {
+	owners := &prsv1.TeamOwners{
+		TeamName: o.TeamName,
+		Rules:    make([]*prsv1.CodeOwnerRule, len(o.Rules)),
+	}
+	for i, r := range o.Rules {
+		owners.Rules[i] = &prsv1.CodeOwnerRule{
+			Pattern: r.Pattern,
+			Users:   uuidStrings(r.Users),
+			Teams:   r.Teams,
+		}
+	}
+
+	return owners
+}
+
+func reviewRulesFromProto(r *prsv1.TeamReviewRules) *api.TeamReviewRules {
+	return &api.TeamReviewRules{
+		TeamName:        r.GetTeamName(),
+		RequireSenior:   r.GetRequireSenior(),
+		ShadowJunior:    r.GetShadowJunior(),
+		RotationWindow:  int(r.GetRotationWindow()),
+		RotationPenalty: r.GetRotationPenalty(),
+	}
+}
+
+func reviewRulesToProto(r *api.TeamReviewRules) *prsv1.TeamReviewRules {
+	return &prsv1.TeamReviewRules{
+		TeamName:        r.TeamName,
+		RequireSenior:   r.RequireSenior,
+		ShadowJunior:    r.ShadowJunior,
+		RotationWindow:  int32(r.RotationWindow),
+		RotationPenalty: r.RotationPenalty,
+	}
+}
+
+func slaFromProto(s *prsv1.TeamSLA) *api.TeamSLA {
+	teamSLA := &api.TeamSLA{
+		TeamName:         s.GetTeamName(),
+		FirstReviewHours: int(s.GetFirstReviewHours()),
+	}
+	if s.ReassignAfterHours != nil {
+		hours := int(*s.ReassignAfterHours)
+		teamSLA.ReassignAfterHours = &hours
+	}
+
+	return teamSLA
+}
+
+func slaToProto(s *api.TeamSLA) *prsv1.TeamSLA {
+	return &prsv1.TeamSLA{
+		TeamName:           s.TeamName,
+		FirstReviewHours:   int32(s.FirstReviewHours),
+		ReassignAfterHours: optionalInt32(s.ReassignAfterHours),
+	}
+}
+
+func workScheduleFromProto(s *prsv1.WorkSchedule) api.WorkSchedule {
+	return api.WorkSchedule{
+		TimeZone:  s.GetTimeZone(),
+		WorkDays:  s.GetWorkDays(),
+		WorkStart: s.GetWorkStart(),
+		WorkEnd:   s.GetWorkEnd(),
+	}
+}
+
+func workScheduleToProto(s api.WorkSchedule) *prsv1.WorkSchedule {
+	return &prsv1.WorkSchedule{
+		TimeZone:  s.TimeZone,
+		WorkDays:  s.WorkDays,
+		WorkStart: s.WorkStart,
+		WorkEnd:   s.WorkEnd,
+	}
+}
+
+func teamScheduleToProto(s *api.TeamSchedule) *prsv1.TeamSchedule {
+	return &prsv1.TeamSchedule{
+		TeamName: s.TeamName,
+		Schedule: workScheduleToProto(s.WorkSchedule),
+	}
+}
+
+func userScheduleToProto(s *api.UserSchedule) *prsv1.UserSchedule {
+	return &prsv1.UserSchedule{
+		UserId:   s.UserID.String(),
+		Schedule: workScheduleToProto(s.WorkSchedule),
+	}
+}
+
+func userToProto(u *api.User) *prsv1.User {
+	user := &prsv1.User{
+		UserId:    u.UserID.String(),
+		Username:  u.Username,
+		TeamName:  u.TeamName,
+		IsActive:  u.IsActive,
+		Seniority: string(u.Seniority),
+		Teams:     make([]*prsv1.UserTeam, len(u.Teams)),
+	}
+	for i, t := range u.Teams {
+		user.Teams[i] = &prsv1.UserTeam{
+			TeamName:  t.TeamName,
+			Role:      t.Role,
+			IsActive:  t.IsActive,
+			IsPrimary: t.IsPrimary,
+		}
+	}
+
+	return user
+}
+
+func digestToProto(d *api.DigestSubscription) *prsv1.DigestSubscription {
+	return &prsv1.DigestSubscription{
+		UserId:     d.UserID.String(),
+		Email:      d.Email,
+		Frequency:  string(d.Frequency),
+		SendAt:     d.SendAt,
+		Weekday:    d.Weekday,
+		LastSentAt: optionalTimestamp(d.LastSentAt),
+	}
+}
+
+func pullRequestToProto(pr *api.PullRequest) *prsv1.PullRequest {
+	out := &prsv1.PullRequest{
+		PullRequestId:     pr.PullRequestID.String(),
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorID.String(),
+		TeamName:          pr.TeamName,
+		Status:            string(pr.Status),
+		AssignedReviewers: uuidStrings(pr.AssignedReviewers),
+		ShadowReviewer:    optionalUUID(pr.ShadowReviewer),
+		CreatedAt:         timestamp(pr.CreatedAt),
+		MergedAt:          optionalTimestamp(pr.MergedAt),
+		ChangedFiles:      pr.ChangedFiles,
+		Labels:            pr.Labels,
+		Assignments:       make([]*prsv1.ReviewAssignment, len(pr.Assignments)),
+	}
+	for i, a := range pr.Assignments {
+		out.Assignments[i] = &prsv1.ReviewAssignment{
+			ReviewerId: a.ReviewerID.String(),
+			Source:     string(a.Source),
+			SourceTeam: a.SourceTeam,
+			DecisionId: a.DecisionID,
+			Shadow:     a.Shadow,
+		}
+	}
+
+	return out
+}
+
+func pullRequestsToProto(prs []api.PullRequest) []*prsv1.PullRequest {
+	out := make([]*prsv1.PullRequest, len(prs))
+	for i := range prs {
+		out[i] = pullRequestToProto(&prs[i])
+	}
+	return out
+}
+
+func decisionToProto(d *api.AssignmentDecision) *prsv1.AssignmentDecision {
+	out := &prsv1.AssignmentDecision{
+		DecisionId:       d.DecisionID,
+		Reason:           string(d.Reason),
+		Seed:             d.Seed,
+		AlgorithmVersion: int32(d.AlgorithmVersion),
+		CreatedAt:        timestamp(d.CreatedAt),
+		Labels:           d.Labels,
+		Limit:            int32(d.Limit),
+		RequireSenior:    d.RequireSenior,
+		Shadow:           d.Shadow,
+		Recorded:         uuidStrings(d.Recorded),
+		Picked:           uuidStrings(d.Picked),
+		Reproduced:       d.Reproduced,
+		Candidates:       make([]*prsv1.CandidateExplanation, len(d.Candidates)),
+	}
+	if d.ReplayError != "" {
+		out.ReplayError = &d.ReplayError
+	}
+	for i, c := range d.Candidates {
+		out.Candidates[i] = &prsv1.CandidateExplanation{
+			UserId:     c.UserID.String(),
+			Source:     string(c.Source),
+			SourceTeam: c.SourceTeam,
+			Eligible:   c.Eligible,
+			Reason:     c.Reason,
+			Seniority:  string(c.Seniority),
+			Skills:     c.Skills,
+			Load:       int32(c.Load),
+			Recent:     int32(c.Recent),
+			Score:      c.Score,
+			Rank:       int32(c.Rank),
+			Picked:     c.Picked,
+			Shadow:     c.Shadow,
+		}
+	}
+
+	return out
+}
+
+func overdueToProto(o *api.OverdueReview) *prsv1.OverdueReview {
+	return &prsv1.OverdueReview{
+		PullRequestId:   o.PullRequestID.String(),
+		PullRequestName: o.PullRequestName,
+		AuthorId:        o.AuthorID.String(),
+		TeamName:        o.TeamName,
+		ReviewerId:      o.ReviewerID.String(),
+		AssignedAt:      timestamp(o.AssignedAt),
+		DueAt:           timestamp(o.DueAt),
+		ReassignAt:      optionalTimestamp(o.ReassignAt),
+		BreachedAt:      optionalTimestamp(o.BreachedAt),
+	}
+}
+
+func latencyToProto(l api.LatencySummary) *prsv1.LatencySummary {
+	return &prsv1.LatencySummary{
+		Count:       int32(l.Count),
+		MeanHours:   l.MeanHours,
+		MedianHours: l.MedianHours,
+		P90Hours:    l.P90Hours,
+	}
+}
+
+func eventToProto(e *api.Event) (*prsv1.Event, error) {
+	var payload map[string]any
+	if len(e.Payload) > 0 {
+		if err := json.Unmarshal(e.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("decode event payload: %w", err)
+		}
+	}
+	payloadStruct, err := structpb.NewStruct(payload)
+	if err != nil {
+		return nil, fmt.Errorf("convert event payload: %w", err)
+	}
+
+	return &prsv1.Event{
+		Id:            e.ID,
+		Type:          string(e.Type),
+		PullRequestId: optionalUUID(e.PullRequestID),
+		TeamId:        optionalUUID(e.TeamID),
+		UserId:        optionalUUID(e.UserID),
+		Payload:       payloadStruct,
+		CreatedAt:     timestamp(e.CreatedAt),
+	}, nil
+}
@@ -0,0 +1,77 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain — домен ErrorInfo, в Reason которого передаётся api.ErrorCode.
+const errorDomain = "prs"
+
+// statusCode сопоставляет коды ошибок REST API кодам gRPC.
+func statusCode(code api.ErrorCode) codes.Code {
+	switch code {
+	case api.ErrInvalidJSON, api.ErrInvalidTeam, api.ErrInvalidParameter,
+		api.ErrInvalidUser, api.ErrInvalidPR:
+		return codes.InvalidArgument
+	case api.ErrNotFound:
+		return codes.NotFound
+	case api.ErrUnauthorized:
+		return codes.Unauthenticated
+	case api.ErrTeamExist, api.ErrPRExist:
+		return codes.AlreadyExists
+	case api.ErrPRMerged, api.ErrNotAssigned, api.ErrNoCandidate, api.ErrHierarchyCycle:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// toStatus превращает ошибку хранилища в статус gRPC. Код api.ErrorCode сохраняется
+// в деталях статуса, чтобы клиенты могли различать ошибки так же, как в REST API.
+func toStatus(err error) error {
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) {
+		st := status.New(statusCode(apiErr.Code), apiErr.Message)
+		if detailed, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+			Reason: string(apiErr.Code),
+			Domain: errorDomain,
+		}); detailsErr == nil {
+			st = detailed
+		}
+		return st.Err()
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+
+	return status.Error(codes.Internal, "internal error")
+}
+
+func invalid(code api.ErrorCode, message string) error {
+	return toStatus(api.NewAPIError(code, message))
+}
+
+// parseUUID разбирает обязательный идентификатор; ошибка получает код ErrorCode, как в REST API.
+func parseUUID(raw string, code api.ErrorCode, field string) (uuid.UUID, error) {
+	if raw == "" {
+		return uuid.Nil, invalid(code, field+" is required")
+	}
+
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, invalid(code, "invalid "+field+" format")
+	}
+
+	return id, nil
+}
@@ -0,0 +1,85 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	prsv1 "github.com/F3dosik/PRS.git/pkg/pb/prs/v1"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+const (
+	streamPollInterval = time.Second
+	streamBatchSize    = 100
+)
+
+// StreamEvents отдаёт журнал событий так же, как /events/stream: с события после last_event_id,
+// а без него — с новых событий. Поток завершается при остановке сервера.
+func (s *Service) StreamEvents(req *prsv1.StreamEventsRequest, stream grpc.ServerStreamingServer[prsv1.StreamEventsResponse]) error {
+	var userID *uuid.UUID
+	if req.GetUserId() != "" {
+		id, err := parseUUID(req.GetUserId(), api.ErrInvalidUser, "user_id")
+		if err != nil {
+			return err
+		}
+		userID = &id
+	}
+
+	types := make([]api.EventType, len(req.GetTypes()))
+	for i, t := range req.GetTypes() {
+		types[i] = api.EventType(t)
+	}
+
+	if req.LastEventId != nil && *req.LastEventId < 0 {
+		return invalid(api.ErrInvalidParameter, "last_event_id must be an event id")
+	}
+
+	ctx, cancel := context.WithTimeout(stream.Context(), 5*time.Second)
+	filter, err := s.storage.NewEventFilter(ctx, req.GetTeamName(), req.GetIncludeDescendants(), userID, types)
+	cursor := req.GetLastEventId()
+	if err == nil && req.LastEventId == nil {
+		cursor, err = s.storage.LatestEventID(ctx)
+	}
+	cancel()
+	if err != nil {
+		s.logger.Warn("cannot open event stream", zap.Error(err))
+		return toStatus(err)
+	}
+
+	poll := time.NewTicker(streamPollInterval)
+	defer poll.Stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-s.done:
+			return nil
+		case <-poll.C:
+			ctx, cancel := context.WithTimeout(stream.Context(), 5*time.Second)
+			events, err := s.storage.FilterEvents(ctx, cursor, filter, streamBatchSize)
+			cancel()
+			if err != nil {
+				if stream.Context().Err() == nil {
+					s.logger.Warn("cannot read events", zap.Error(err))
+				}
+				continue
+			}
+
+			for i := range events {
+				event, err := eventToProto(&events[i])
+				if err != nil {
+					s.logger.Warn("cannot encode event", zap.Error(err))
+					return toStatus(err)
+				}
+				if err = stream.Send(&prsv1.StreamEventsResponse{Event: event}); err != nil {
+					return err
+				}
+				cursor = events[i].ID
+			}
+		}
+	}
+}
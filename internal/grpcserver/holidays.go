@@ -0,0 +1,55 @@
+package grpcserver
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/calendar"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	prsv1 "github.com/F3dosik/PRS.git/pkg/pb/prs/v1"
+	"go.uber.org/zap"
+)
+
+func (s *Service) GetHolidays(ctx context.Context, req *prsv1.GetHolidaysRequest) (*prsv1.GetHolidaysResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	holidays, err := s.storage.GetHolidays(ctx, req.GetTeamName())
+	if err != nil {
+		s.logger.Warn("cannot get holidays", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	resp := &prsv1.GetHolidaysResponse{Holidays: make([]*prsv1.Holiday, len(holidays.Holidays))}
+	for i, h := range holidays.Holidays {
+		resp.Holidays[i] = &prsv1.Holiday{
+			Date:      h.Date,
+			Name:      h.Name,
+			Recurring: h.Recurring,
+			TeamName:  h.TeamName,
+		}
+	}
+
+	return resp, nil
+}
+
+// ImportHolidays принимает файл iCalendar целиком; размер сообщения ограничен настройками gRPC-сервера.
+func (s *Service) ImportHolidays(ctx context.Context, req *prsv1.ImportHolidaysRequest) (*prsv1.ImportHolidaysResponse, error) {
+	holidays, err := calendar.ParseICS(bytes.NewReader(req.GetIcs()))
+	if err != nil {
+		s.logger.Warn("cannot parse iCalendar", zap.Error(err))
+		return nil, invalid(api.ErrInvalidParameter, err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	imported, err := s.storage.ImportHolidays(ctx, req.GetTeamName(), holidays, req.GetReplace())
+	if err != nil {
+		s.logger.Warn("cannot import holidays", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.ImportHolidaysResponse{Imported: int32(imported)}, nil
+}
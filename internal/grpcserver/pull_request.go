@@ -0,0 +1,224 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	prsv1 "github.com/F3dosik/PRS.git/pkg/pb/prs/v1"
+	"go.uber.org/zap"
+)
+
+func (s *Service) CreatePullRequest(ctx context.Context, req *prsv1.CreatePullRequestRequest) (*prsv1.CreatePullRequestResponse, error) {
+	prID, err := parseUUID(req.GetPullRequestId(), api.ErrInvalidPR, "pull_request_id")
+	if err != nil {
+		return nil, err
+	}
+	authorID, err := parseUUID(req.GetAuthorId(), api.ErrInvalidPR, "author_id")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPullRequestName() == "" {
+		s.logger.Warn("pull request is invalid")
+		return nil, invalid(api.ErrInvalidPR, "invalid pull request")
+	}
+	for _, file := range req.GetChangedFiles() {
+		if file == "" {
+			return nil, invalid(api.ErrInvalidPR, "changed_files must not contain empty paths")
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	pr, err := s.storage.PullRequestCreate(ctx, &api.PullRequestCreateRequest{
+		PullRequestShort: api.PullRequestShort{
+			PullRequestID:   prID,
+			PullRequestName: req.GetPullRequestName(),
+			AuthorID:        authorID,
+		},
+		TeamName:     req.GetTeamName(),
+		ChangedFiles: req.GetChangedFiles(),
+		Labels:       req.GetLabels(),
+	})
+	if err != nil {
+		s.logger.Warn("cannot create pull request", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.CreatePullRequestResponse{Pr: pullRequestToProto(pr)}, nil
+}
+
+func (s *Service) MergePullRequest(ctx context.Context, req *prsv1.MergePullRequestRequest) (*prsv1.MergePullRequestResponse, error) {
+	prID, err := parseUUID(req.GetPullRequestId(), api.ErrInvalidPR, "pull_request_id")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	pr, err := s.storage.PullRequestMerge(ctx, prID)
+	if err != nil {
+		s.logger.Warn("cannot pull request merge", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.MergePullRequestResponse{Pr: pullRequestToProto(pr)}, nil
+}
+
+func (s *Service) ReassignReviewer(ctx context.Context, req *prsv1.ReassignReviewerRequest) (*prsv1.ReassignReviewerResponse, error) {
+	prID, err := parseUUID(req.GetPullRequestId(), api.ErrInvalidPR, "pull_request_id")
+	if err != nil {
+		return nil, err
+	}
+	oldUserID, err := parseUUID(req.GetOldUserId(), api.ErrInvalidUser, "old_user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := s.storage.PullRequestReassign(ctx, prID, oldUserID)
+	if err != nil {
+		s.logger.Warn("cannot pull request reassign", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.ReassignReviewerResponse{
+		Pr:         pullRequestToProto(&resp.PullRequest),
+		ReplacedBy: resp.ReplacedBy.String(),
+	}, nil
+}
+
+func (s *Service) FillReviewers(ctx context.Context, req *prsv1.FillReviewersRequest) (*prsv1.FillReviewersResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	prs, err := s.storage.FillReviewers(ctx, req.GetTeamName())
+	if err != nil {
+		s.logger.Warn("cannot fill reviewers", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.FillReviewersResponse{PullRequests: pullRequestsToProto(prs)}, nil
+}
+
+func (s *Service) ExplainAssignment(ctx context.Context, req *prsv1.ExplainAssignmentRequest) (*prsv1.ExplainAssignmentResponse, error) {
+	prID, err := parseUUID(req.GetPullRequestId(), api.ErrInvalidPR, "pull_request_id")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	explanation, err := s.storage.ExplainAssignment(ctx, prID, req.DecisionId)
+	if err != nil {
+		s.logger.Warn("cannot explain assignment", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	resp := &prsv1.ExplainAssignmentResponse{
+		PullRequestId: explanation.PullRequestID.String(),
+		Decisions:     make([]*prsv1.AssignmentDecision, len(explanation.Decisions)),
+	}
+	for i := range explanation.Decisions {
+		resp.Decisions[i] = decisionToProto(&explanation.Decisions[i])
+	}
+
+	return resp, nil
+}
+
+func (s *Service) GetOverdue(ctx context.Context, req *prsv1.GetOverdueRequest) (*prsv1.GetOverdueResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	overdue, err := s.storage.GetOverdue(ctx, req.GetTeamName(), req.GetIncludeDescendants(), time.Now())
+	if err != nil {
+		s.logger.Warn("cannot get overdue reviews", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	resp := &prsv1.GetOverdueResponse{Overdue: make([]*prsv1.OverdueReview, len(overdue.Overdue))}
+	for i := range overdue.Overdue {
+		resp.Overdue[i] = overdueToProto(&overdue.Overdue[i])
+	}
+
+	return resp, nil
+}
+
+func (s *Service) GetPullRequest(ctx context.Context, req *prsv1.GetPullRequestRequest) (*prsv1.GetPullRequestResponse, error) {
+	prID, err := parseUUID(req.GetPullRequestId(), api.ErrInvalidPR, "pull_request_id")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	pr, err := s.storage.GetPullRequest(ctx, prID)
+	if err != nil {
+		s.logger.Warn("cannot get pull request", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.GetPullRequestResponse{Pr: pullRequestToProto(pr)}, nil
+}
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+)
+
+func (s *Service) ListPullRequests(ctx context.Context, req *prsv1.ListPullRequestsRequest) (*prsv1.ListPullRequestsResponse, error) {
+	filter := &api.PullRequestFilter{
+		TeamName:           req.GetTeamName(),
+		IncludeDescendants: req.GetIncludeDescendants(),
+		Status:             api.PRStatus(req.GetStatus()),
+		NeedMoreReviewers:  req.NeedMoreReviewers,
+		Cursor:             req.GetCursor(),
+		Limit:              defaultListLimit,
+	}
+	if filter.Status != "" && filter.Status != api.StatusOpen && filter.Status != api.StatusMerged {
+		return nil, invalid(api.ErrInvalidParameter, "status must be OPEN or MERGED")
+	}
+
+	var err error
+	if filter.AuthorID, err = parseOptionalUUID(req.GetAuthorId(), api.ErrInvalidUser, "author_id"); err != nil {
+		return nil, err
+	}
+	if filter.ReviewerID, err = parseOptionalUUID(req.GetReviewerId(), api.ErrInvalidUser, "reviewer_id"); err != nil {
+		return nil, err
+	}
+	if req.CreatedFrom != nil {
+		t := req.GetCreatedFrom().AsTime()
+		filter.CreatedFrom = &t
+	}
+	if req.CreatedTo != nil {
+		t := req.GetCreatedTo().AsTime()
+		filter.CreatedTo = &t
+	}
+	if req.Limit != nil {
+		if req.GetLimit() < 1 || req.GetLimit() > maxListLimit {
+			return nil, invalid(api.ErrInvalidParameter, "limit must be between 1 and 200")
+		}
+		filter.Limit = int(req.GetLimit())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	list, err := s.storage.ListPullRequests(ctx, filter)
+	if err != nil {
+		s.logger.Warn("cannot list pull requests", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.ListPullRequestsResponse{
+		PullRequests: pullRequestsToProto(list.PullRequests),
+		NextCursor:   list.NextCursor,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/repository"
+	prsv1 "github.com/F3dosik/PRS.git/pkg/pb/prs/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// Service реализует prsv1.PRSServiceServer поверх того же хранилища, что и REST API.
+type Service struct {
+	prsv1.UnimplementedPRSServiceServer
+
+	storage *repository.Storage
+	logger  *zap.SugaredLogger
+	done    <-chan struct{} // Закрывается при остановке сервера и завершает потоки событий
+}
+
+func NewService(storage *repository.Storage, logger *zap.SugaredLogger, done <-chan struct{}) *Service {
+	return &Service{
+		storage: storage,
+		logger:  logger,
+		done:    done,
+	}
+}
+
+// NewServer создаёт gRPC-сервер с сервисом PRS, журналированием вызовов и reflection для grpcurl.
+func NewServer(service *Service, logger *zap.SugaredLogger) *grpc.Server {
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryLogging(logger)),
+		grpc.ChainStreamInterceptor(streamLogging(logger)),
+	)
+	prsv1.RegisterPRSServiceServer(srv, service)
+	reflection.Register(srv)
+
+	return srv
+}
+
+func unaryLogging(logger *zap.SugaredLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logCall(ctx, logger, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+func streamLogging(logger *zap.SugaredLogger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logCall(ss.Context(), logger, info.FullMethod, start, err)
+		return err
+	}
+}
+
+func logCall(ctx context.Context, logger *zap.SugaredLogger, method string, start time.Time, err error) {
+	var clientIP string
+	if p, ok := peer.FromContext(ctx); ok {
+		clientIP = p.Addr.String()
+	}
+
+	logger.Infow("grpc_request",
+		"method", method,
+		"code", status.Code(err).String(),
+		"duration", time.Since(start),
+		"client_ip", clientIP,
+	)
+}
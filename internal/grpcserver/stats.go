@@ -0,0 +1,81 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	prsv1 "github.com/F3dosik/PRS.git/pkg/pb/prs/v1"
+	"go.uber.org/zap"
+)
+
+func (s *Service) GetStats(ctx context.Context, req *prsv1.GetStatsRequest) (*prsv1.GetStatsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	stats, err := s.storage.GetStats(ctx, req.GetTeamName(), req.GetIncludeDescendants())
+	if err != nil {
+		s.logger.Warn("cannot get stats", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	resp := &prsv1.GetStatsResponse{
+		TeamName:          stats.TeamName,
+		TotalPr:           int32(stats.TotalPR),
+		OpenPr:            int32(stats.OpenPR),
+		ReviewAssignments: make(map[string]int32, len(stats.ReviewAssignments)),
+	}
+	for username, count := range stats.ReviewAssignments {
+		resp.ReviewAssignments[username] = int32(count)
+	}
+
+	return resp, nil
+}
+
+func (s *Service) GetPairStats(ctx context.Context, req *prsv1.GetPairStatsRequest) (*prsv1.GetPairStatsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	stats, err := s.storage.GetPairStats(ctx, req.GetTeamName(), req.GetIncludeDescendants())
+	if err != nil {
+		s.logger.Warn("cannot get pair stats", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	resp := &prsv1.GetPairStatsResponse{
+		TeamName:  stats.TeamName,
+		Authors:   uuidStrings(stats.Authors),
+		Reviewers: uuidStrings(stats.Reviewers),
+		Matrix:    make([]*prsv1.PairRow, len(stats.Matrix)),
+	}
+	for i, row := range stats.Matrix {
+		counts := make([]int32, len(row))
+		for j, count := range row {
+			counts[j] = int32(count)
+		}
+		resp.Matrix[i] = &prsv1.PairRow{Counts: counts}
+	}
+
+	return resp, nil
+}
+
+func (s *Service) GetLatencyStats(ctx context.Context, req *prsv1.GetLatencyStatsRequest) (*prsv1.GetLatencyStatsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	stats, err := s.storage.GetLatencyStats(ctx, req.GetTeamName(), req.GetIncludeDescendants())
+	if err != nil {
+		s.logger.Warn("cannot get latency stats", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	resp := &prsv1.GetLatencyStatsResponse{
+		TeamName:  stats.TeamName,
+		Merge:     latencyToProto(stats.Merge),
+		Reviewers: make([]*prsv1.ReviewerLatency, len(stats.Reviewers)),
+	}
+	for i, r := range stats.Reviewers {
+		resp.Reviewers[i] = &prsv1.ReviewerLatency{UserId: r.UserID.String(), Latency: latencyToProto(r.LatencySummary)}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,276 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	prsv1 "github.com/F3dosik/PRS.git/pkg/pb/prs/v1"
+	"go.uber.org/zap"
+)
+
+func (s *Service) AddTeam(ctx context.Context, req *prsv1.AddTeamRequest) (*prsv1.AddTeamResponse, error) {
+	team, err := teamFromProto(req.GetTeam())
+	if err != nil {
+		return nil, err
+	}
+
+	if team.TeamName == "" || len(team.Members) == 0 {
+		s.logger.Warn("team name or members are invalid")
+		return nil, invalid(api.ErrInvalidTeam, "team name or members are invalid")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := s.storage.UpdateTeam(ctx, team); err != nil {
+		s.logger.Warn("cannot update team", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.AddTeamResponse{Team: teamToProto(team)}, nil
+}
+
+func (s *Service) GetTeam(ctx context.Context, req *prsv1.GetTeamRequest) (*prsv1.GetTeamResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalid(api.ErrInvalidParameter, "team_name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	team, err := s.storage.GetTeam(ctx, req.GetTeamName(), req.GetIncludeDescendants())
+	if err != nil {
+		s.logger.Warn("cannot get team", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.GetTeamResponse{Team: teamToProto(team)}, nil
+}
+
+func (s *Service) GetTeamFallback(ctx context.Context, req *prsv1.GetTeamFallbackRequest) (*prsv1.GetTeamFallbackResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalid(api.ErrInvalidParameter, "team_name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	fallback, err := s.storage.GetTeamFallback(ctx, req.GetTeamName())
+	if err != nil {
+		s.logger.Warn("cannot get team fallback", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.GetTeamFallbackResponse{Fallback: fallbackToProto(fallback)}, nil
+}
+
+func (s *Service) SetTeamFallback(ctx context.Context, req *prsv1.SetTeamFallbackRequest) (*prsv1.SetTeamFallbackResponse, error) {
+	fallback := fallbackFromProto(req.GetFallback())
+	if fallback.TeamName == "" {
+		return nil, invalid(api.ErrInvalidTeam, "team_name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := s.storage.SetTeamFallback(ctx, fallback); err != nil {
+		s.logger.Warn("cannot set team fallback", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.SetTeamFallbackResponse{Fallback: fallbackToProto(fallback)}, nil
+}
+
+func (s *Service) GetTeamSubtree(ctx context.Context, req *prsv1.GetTeamSubtreeRequest) (*prsv1.GetTeamSubtreeResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalid(api.ErrInvalidParameter, "team_name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	subtree, err := s.storage.GetTeamSubtree(ctx, req.GetTeamName())
+	if err != nil {
+		s.logger.Warn("cannot get team subtree", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.GetTeamSubtreeResponse{Subtree: teamNodeToProto(subtree)}, nil
+}
+
+func (s *Service) SetTeamParent(ctx context.Context, req *prsv1.SetTeamParentRequest) (*prsv1.SetTeamParentResponse, error) {
+	if req.GetTeamName() == "" || (req.ParentTeam != nil && *req.ParentTeam == "") {
+		return nil, invalid(api.ErrInvalidTeam, "team_name and parent_team must not be empty")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	team, err := s.storage.SetTeamParent(ctx, req.GetTeamName(), req.GetParentTeam())
+	if err != nil {
+		s.logger.Warn("cannot set team parent", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.SetTeamParentResponse{Team: teamToProto(team)}, nil
+}
+
+func (s *Service) GetTeamOwners(ctx context.Context, req *prsv1.GetTeamOwnersRequest) (*prsv1.GetTeamOwnersResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalid(api.ErrInvalidParameter, "team_name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	owners, err := s.storage.GetTeamOwners(ctx, req.GetTeamName())
+	if err != nil {
+		s.logger.Warn("cannot get team owners", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.GetTeamOwnersResponse{Owners: ownersToProto(owners)}, nil
+}
+
+func (s *Service) SetTeamOwners(ctx context.Context, req *prsv1.SetTeamOwnersRequest) (*prsv1.SetTeamOwnersResponse, error) {
+	owners, err := ownersFromProto(req.GetOwners())
+	if err != nil {
+		return nil, err
+	}
+
+	if owners.TeamName == "" {
+		return nil, invalid(api.ErrInvalidTeam, "team_name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := s.storage.SetTeamOwners(ctx, owners); err != nil {
+		s.logger.Warn("cannot set team owners", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.SetTeamOwnersResponse{Owners: ownersToProto(owners)}, nil
+}
+
+func (s *Service) DeleteTeamOwners(ctx context.Context, req *prsv1.DeleteTeamOwnersRequest) (*prsv1.DeleteTeamOwnersResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalid(api.ErrInvalidParameter, "team_name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := s.storage.DeleteTeamOwners(ctx, req.GetTeamName()); err != nil {
+		s.logger.Warn("cannot delete team owners", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.DeleteTeamOwnersResponse{}, nil
+}
+
+func (s *Service) GetTeamReviewRules(ctx context.Context, req *prsv1.GetTeamReviewRulesRequest) (*prsv1.GetTeamReviewRulesResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalid(api.ErrInvalidParameter, "team_name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	rules, err := s.storage.GetTeamReviewRules(ctx, req.GetTeamName())
+	if err != nil {
+		s.logger.Warn("cannot get team review rules", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.GetTeamReviewRulesResponse{Rules: reviewRulesToProto(rules)}, nil
+}
+
+func (s *Service) SetTeamReviewRules(ctx context.Context, req *prsv1.SetTeamReviewRulesRequest) (*prsv1.SetTeamReviewRulesResponse, error) {
+	rules := reviewRulesFromProto(req.GetRules())
+	if rules.TeamName == "" {
+		return nil, invalid(api.ErrInvalidTeam, "team_name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := s.storage.SetTeamReviewRules(ctx, rules); err != nil {
+		s.logger.Warn("cannot set team review rules", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.SetTeamReviewRulesResponse{Rules: reviewRulesToProto(rules)}, nil
+}
+
+func (s *Service) GetTeamSLA(ctx context.Context, req *prsv1.GetTeamSLARequest) (*prsv1.GetTeamSLAResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalid(api.ErrInvalidParameter, "team_name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	teamSLA, err := s.storage.GetTeamSLA(ctx, req.GetTeamName())
+	if err != nil {
+		s.logger.Warn("cannot get team sla", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.GetTeamSLAResponse{Sla: slaToProto(teamSLA)}, nil
+}
+
+func (s *Service) SetTeamSLA(ctx context.Context, req *prsv1.SetTeamSLARequest) (*prsv1.SetTeamSLAResponse, error) {
+	teamSLA := slaFromProto(req.GetSla())
+	if teamSLA.TeamName == "" {
+		return nil, invalid(api.ErrInvalidTeam, "team_name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := s.storage.SetTeamSLA(ctx, teamSLA); err != nil {
+		s.logger.Warn("cannot set team sla", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.SetTeamSLAResponse{Sla: slaToProto(teamSLA)}, nil
+}
+
+func (s *Service) GetTeamSchedule(ctx context.Context, req *prsv1.GetTeamScheduleRequest) (*prsv1.GetTeamScheduleResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalid(api.ErrInvalidParameter, "team_name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	schedule, err := s.storage.GetTeamSchedule(ctx, req.GetTeamName())
+	if err != nil {
+		s.logger.Warn("cannot get team schedule", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.GetTeamScheduleResponse{Schedule: teamScheduleToProto(schedule)}, nil
+}
+
+func (s *Service) SetTeamSchedule(ctx context.Context, req *prsv1.SetTeamScheduleRequest) (*prsv1.SetTeamScheduleResponse, error) {
+	schedule := &api.TeamSchedule{
+		TeamName:     req.GetSchedule().GetTeamName(),
+		WorkSchedule: workScheduleFromProto(req.GetSchedule().GetSchedule()),
+	}
+	if schedule.TeamName == "" {
+		return nil, invalid(api.ErrInvalidTeam, "team_name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := s.storage.SetTeamSchedule(ctx, schedule); err != nil {
+		s.logger.Warn("cannot set team schedule", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.SetTeamScheduleResponse{Schedule: teamScheduleToProto(schedule)}, nil
+}
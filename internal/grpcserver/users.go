@@ -0,0 +1,291 @@
+package grpcserver
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	prsv1 "github.com/F3dosik/PRS.git/pkg/pb/prs/v1"
+	"go.uber.org/zap"
+)
+
+func (s *Service) SetIsActive(ctx context.Context, req *prsv1.SetIsActiveRequest) (*prsv1.SetIsActiveResponse, error) {
+	userID, err := parseUUID(req.GetUserId(), api.ErrInvalidUser, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	user, err := s.storage.SetIsActive(ctx, userID, req.GetTeamName(), req.GetIsActive())
+	if err != nil {
+		s.logger.Warn("cannot set is_active", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.SetIsActiveResponse{User: userToProto(user)}, nil
+}
+
+func (s *Service) SetSeniority(ctx context.Context, req *prsv1.SetSeniorityRequest) (*prsv1.SetSeniorityResponse, error) {
+	userID, err := parseUUID(req.GetUserId(), api.ErrInvalidUser, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	user, err := s.storage.SetUserSeniority(ctx, userID, api.Seniority(req.GetSeniority()))
+	if err != nil {
+		s.logger.Warn("cannot set seniority", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.SetSeniorityResponse{User: userToProto(user)}, nil
+}
+
+func (s *Service) GetUser(ctx context.Context, req *prsv1.GetUserRequest) (*prsv1.GetUserResponse, error) {
+	userID, err := parseUUID(req.GetUserId(), api.ErrInvalidUser, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	user, err := s.storage.GetUser(ctx, userID)
+	if err != nil {
+		s.logger.Warn("cannot get user", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.GetUserResponse{User: userToProto(user)}, nil
+}
+
+func (s *Service) GetReview(ctx context.Context, req *prsv1.GetReviewRequest) (*prsv1.GetReviewResponse, error) {
+	userID, err := parseUUID(req.GetUserId(), api.ErrInvalidUser, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	review, err := s.storage.GetReview(ctx, userID)
+	if err != nil {
+		s.logger.Warn("cannot get review", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	resp := &prsv1.GetReviewResponse{
+		UserId:       review.UserID.String(),
+		PullRequests: make([]*prsv1.PullRequestShort, len(review.PullRequests)),
+	}
+	for i, pr := range review.PullRequests {
+		resp.PullRequests[i] = &prsv1.PullRequestShort{
+			PullRequestId:   pr.PullRequestID.String(),
+			PullRequestName: pr.PullRequestName,
+			AuthorId:        pr.AuthorID.String(),
+			Status:          string(pr.Status),
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *Service) GetSkills(ctx context.Context, req *prsv1.GetSkillsRequest) (*prsv1.GetSkillsResponse, error) {
+	userID, err := parseUUID(req.GetUserId(), api.ErrInvalidUser, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	skills, err := s.storage.GetUserSkills(ctx, userID)
+	if err != nil {
+		s.logger.Warn("cannot get user skills", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.GetSkillsResponse{Skills: &prsv1.UserSkills{UserId: skills.UserID.String(), Skills: skills.Skills}}, nil
+}
+
+func (s *Service) SetSkills(ctx context.Context, req *prsv1.SetSkillsRequest) (*prsv1.SetSkillsResponse, error) {
+	userID, err := parseUUID(req.GetSkills().GetUserId(), api.ErrInvalidUser, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	skills := &api.UserSkills{UserID: userID, Skills: req.GetSkills().GetSkills()}
+	for _, skill := range skills.Skills {
+		if strings.Contains(skill, ",") {
+			return nil, invalid(api.ErrInvalidParameter, "skills must not contain commas")
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := s.storage.SetUserSkills(ctx, skills); err != nil {
+		s.logger.Warn("cannot set user skills", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.SetSkillsResponse{Skills: &prsv1.UserSkills{UserId: skills.UserID.String(), Skills: skills.Skills}}, nil
+}
+
+func (s *Service) GetUserSchedule(ctx context.Context, req *prsv1.GetUserScheduleRequest) (*prsv1.GetUserScheduleResponse, error) {
+	userID, err := parseUUID(req.GetUserId(), api.ErrInvalidUser, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	schedule, err := s.storage.GetUserSchedule(ctx, userID)
+	if err != nil {
+		s.logger.Warn("cannot get user schedule", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.GetUserScheduleResponse{Schedule: userScheduleToProto(schedule)}, nil
+}
+
+func (s *Service) SetUserSchedule(ctx context.Context, req *prsv1.SetUserScheduleRequest) (*prsv1.SetUserScheduleResponse, error) {
+	userID, err := parseUUID(req.GetSchedule().GetUserId(), api.ErrInvalidUser, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &api.UserSchedule{
+		UserID:       userID,
+		WorkSchedule: workScheduleFromProto(req.GetSchedule().GetSchedule()),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := s.storage.SetUserSchedule(ctx, schedule); err != nil {
+		s.logger.Warn("cannot set user schedule", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.SetUserScheduleResponse{Schedule: userScheduleToProto(schedule)}, nil
+}
+
+func (s *Service) GetAvailability(ctx context.Context, req *prsv1.GetAvailabilityRequest) (*prsv1.GetAvailabilityResponse, error) {
+	userID, err := parseUUID(req.GetUserId(), api.ErrInvalidUser, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	at := time.Now()
+	if req.GetAt() != nil {
+		if err := req.GetAt().CheckValid(); err != nil {
+			return nil, invalid(api.ErrInvalidParameter, "at must be a valid timestamp")
+		}
+		at = req.GetAt().AsTime()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	availability, err := s.storage.GetAvailability(ctx, userID, at)
+	if err != nil {
+		s.logger.Warn("cannot get availability", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.GetAvailabilityResponse{Availability: &prsv1.Availability{
+		UserId:          availability.UserID.String(),
+		TimeZone:        availability.TimeZone,
+		At:              timestamp(availability.At),
+		LocalTime:       availability.LocalTime,
+		Available:       availability.Available,
+		NextAvailableAt: timestamp(availability.NextAvailableAt),
+	}}, nil
+}
+
+func (s *Service) GetSlackUser(ctx context.Context, req *prsv1.GetSlackUserRequest) (*prsv1.GetSlackUserResponse, error) {
+	userID, err := parseUUID(req.GetUserId(), api.ErrInvalidUser, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	user, err := s.storage.GetSlackUser(ctx, userID)
+	if err != nil {
+		s.logger.Warn("cannot get slack user", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.GetSlackUserResponse{Slack: &prsv1.SlackUser{UserId: user.UserID.String(), SlackId: user.SlackID}}, nil
+}
+
+func (s *Service) SetSlackUser(ctx context.Context, req *prsv1.SetSlackUserRequest) (*prsv1.SetSlackUserResponse, error) {
+	userID, err := parseUUID(req.GetSlack().GetUserId(), api.ErrInvalidUser, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	user := &api.SlackUser{UserID: userID, SlackID: req.GetSlack().GetSlackId()}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := s.storage.SetSlackUser(ctx, user); err != nil {
+		s.logger.Warn("cannot set slack user", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.SetSlackUserResponse{Slack: &prsv1.SlackUser{UserId: user.UserID.String(), SlackId: user.SlackID}}, nil
+}
+
+func (s *Service) GetDigest(ctx context.Context, req *prsv1.GetDigestRequest) (*prsv1.GetDigestResponse, error) {
+	userID, err := parseUUID(req.GetUserId(), api.ErrInvalidUser, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	sub, err := s.storage.GetDigestSubscription(ctx, userID)
+	if err != nil {
+		s.logger.Warn("cannot get digest subscription", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.GetDigestResponse{Digest: digestToProto(sub)}, nil
+}
+
+func (s *Service) SetDigest(ctx context.Context, req *prsv1.SetDigestRequest) (*prsv1.SetDigestResponse, error) {
+	userID, err := parseUUID(req.GetDigest().GetUserId(), api.ErrInvalidUser, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &api.DigestSubscription{
+		UserID:    userID,
+		Email:     req.GetDigest().GetEmail(),
+		Frequency: api.DigestFrequency(req.GetDigest().GetFrequency()),
+		SendAt:    req.GetDigest().GetSendAt(),
+		Weekday:   req.GetDigest().GetWeekday(),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := s.storage.SetDigestSubscription(ctx, sub); err != nil {
+		s.logger.Warn("cannot set digest subscription", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &prsv1.SetDigestResponse{Digest: digestToProto(sub)}, nil
+}
@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	streamPollInterval = time.Second
+	// streamHeartbeat меньше типичных таймаутов простоя у прокси (30–60 с)
+	streamHeartbeat = 15 * time.Second
+	streamBatchSize = 100
+	streamRetry     = 3 * time.Second
+)
+
+// HandlerEventsStream закрывает потоки при закрытии done: иначе открытые соединения
+// не дали бы серверу завершиться штатно.
+func HandlerEventsStream(storage *repository.Storage, logger *zap.SugaredLogger, done <-chan struct{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		eventsStream(w, r, storage, logger, done)
+	}
+}
+
+// eventsStream отдаёт журнал событий как Server-Sent Events. Поток продолжается с события после
+// Last-Event-ID (заголовок или параметр last_event_id), а без него — с новых событий.
+// Порядок — как у FilterEvents: события, зафиксированные позже соседей с большим id, приходят
+// после переподключения, а не теряются; id в потоке поэтому не обязательно возрастают.
+func eventsStream(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger, done <-chan struct{}) {
+	query := r.URL.Query()
+	includeDescendants, err := QueryBool(r, "include_descendants")
+	if err != nil {
+		RespondError(w, err)
+		return
+	}
+
+	var userID *uuid.UUID
+	if raw := query.Get("user_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			apiErr := api.NewAPIError(api.ErrInvalidUser, "invalid user_id format")
+			RespondError(w, apiErr)
+			return
+		}
+		userID = &id
+	}
+
+	var types []api.EventType
+	if raw := query.Get("types"); raw != "" {
+		for _, t := range strings.Split(raw, ",") {
+			types = append(types, api.EventType(strings.TrimSpace(t)))
+		}
+	}
+
+	lastID := r.Header.Get("Last-Event-ID")
+	if lastID == "" {
+		lastID = query.Get("last_event_id")
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	filter, err := storage.NewEventFilter(ctx, query.Get("team_name"), includeDescendants, userID, types)
+	if err == nil && lastID == "" {
+		var latest int64
+		latest, err = storage.LatestEventID(ctx)
+		lastID = strconv.FormatInt(latest, 10)
+	}
+	cancel()
+	if err != nil {
+		logger.Warn("cannot open event stream", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	cursor, err := strconv.ParseInt(lastID, 10, 64)
+	if err != nil || cursor < 0 {
+		apiErr := api.NewAPIError(api.ErrInvalidParameter, "Last-Event-ID must be an event id")
+		RespondError(w, apiErr)
+		return
+	}
+
+	// Поток живёт дольше WriteTimeout сервера
+	rc := http.NewResponseController(w)
+	if err = rc.SetWriteDeadline(time.Time{}); err != nil {
+		logger.Warn("cannot disable write deadline", zap.Error(err))
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", streamRetry.Milliseconds())
+	if err = rc.Flush(); err != nil {
+		logger.Warn("event stream is not supported by response writer", zap.Error(err))
+		return
+	}
+
+	poll := time.NewTicker(streamPollInterval)
+	defer poll.Stop()
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-done:
+			return
+		case <-heartbeat.C:
+			if _, err = fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			if err = rc.Flush(); err != nil {
+				return
+			}
+		case <-poll.C:
+			ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+			events, err := storage.FilterEvents(ctx, cursor, filter, streamBatchSize)
+			cancel()
+			if err != nil {
+				if r.Context().Err() == nil {
+					logger.Warn("cannot read events", zap.Error(err))
+				}
+				continue
+			}
+			if len(events) == 0 {
+				continue
+			}
+
+			for _, e := range events {
+				data, err := json.Marshal(e)
+				if err != nil {
+					logger.Warn("cannot encode event", zap.Error(err))
+					return
+				}
+				if _, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data); err != nil {
+					return
+				}
+				cursor = e.ID
+			}
+			if err = rc.Flush(); err != nil {
+				return
+			}
+			heartbeat.Reset(streamHeartbeat)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/dump"
+	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"go.uber.org/zap"
+)
+
+// exportTimeout рассчитан на выгрузку всей базы, а не на обычный запрос
+const exportTimeout = 10 * time.Minute
+
+func HandlerAdminExport(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		adminExport(w, r, storage, logger)
+	}
+}
+
+// adminExport отдаёт выгрузку потоком: ошибку можно вернуть статусом, только пока не записан ни один байт.
+func adminExport(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	format := dump.Format(r.URL.Query().Get("format"))
+	if format == "" {
+		format = dump.FormatNDJSON
+	}
+	if !format.Valid() {
+		apiErr := api.NewAPIError(api.ErrInvalidParameter, "format must be ndjson or zip")
+		RespondError(w, apiErr)
+		return
+	}
+
+	// Выгрузка пишется дольше WriteTimeout сервера
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		logger.Warn("cannot disable write deadline", zap.Error(err))
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), exportTimeout)
+	defer cancel()
+
+	filename := fmt.Sprintf("prs-%s.%s", time.Now().UTC().Format("20060102T150405Z"), format.Extension())
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+
+	out := &countingWriter{w: w}
+	dw, err := dump.NewWriter(format, out)
+	if err == nil {
+		err = storage.Export(ctx, dw)
+	}
+	if err == nil {
+		err = dw.Close()
+	}
+	if err != nil {
+		logger.Warn("cannot export data", zap.Error(err))
+		if out.n == 0 {
+			w.Header().Del("Content-Disposition")
+			RespondError(w, err)
+		}
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func HandlerAdminPoolStats(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		adminPoolStats(w, storage, logger)
+	}
+}
+
+func adminPoolStats(w http.ResponseWriter, storage *repository.Storage, logger *zap.SugaredLogger) {
+	RespondJSON(w, http.StatusOK, api.PoolStatsResponse{Pool: storage.PoolStats()})
+	logger.Debug("sending HTTP 200 response")
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/gql"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"go.uber.org/zap"
+)
+
+func HandlerGraphQL(executor *gql.Executor, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		graphQL(w, r, executor, logger)
+	}
+}
+
+// graphQL принимает запрос в теле POST либо в query-параметрах GET (query, operationName, variables).
+// Ошибки выполнения запроса возвращаются в поле errors ответа со статусом 200.
+func graphQL(w http.ResponseWriter, r *http.Request, executor *gql.Executor, logger *zap.SugaredLogger) {
+	var req gql.Request
+	if r.Method == http.MethodGet {
+		query := r.URL.Query()
+		req.Query = query.Get("query")
+		req.OperationName = query.Get("operationName")
+		if raw := query.Get("variables"); raw != "" {
+			if err := json.Unmarshal([]byte(raw), &req.Variables); err != nil {
+				RespondError(w, api.NewAPIError(api.ErrInvalidParameter, "variables must be a JSON object"))
+				return
+			}
+		}
+	} else if err := DecodeJSON(r, &req); err != nil {
+		logger.Warn("cannot decode graphql JSON", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	if req.Query == "" {
+		RespondError(w, api.NewAPIError(api.ErrInvalidParameter, "query is required"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	result := executor.Execute(ctx, req)
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, result)
+}
@@ -4,8 +4,11 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
-	"github.com/F3dosik/PRS.git/internal/models/api"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
 )
 
 const (
@@ -24,6 +27,66 @@ func DecodeJSON(r *http.Request, dst any) error {
 	return nil
 }
 
+// QueryBool читает необязательный булев query-параметр; отсутствие параметра означает false.
+func QueryBool(r *http.Request, name string) (bool, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return false, nil
+	}
+
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, api.NewAPIError(api.ErrInvalidParameter, name+" must be a boolean")
+	}
+
+	return value, nil
+}
+
+// QueryInt64 читает необязательный целочисленный query-параметр; отсутствие параметра означает nil.
+func QueryInt64(r *http.Request, name string) (*int64, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil, api.NewAPIError(api.ErrInvalidParameter, name+" must be an integer")
+	}
+
+	return &value, nil
+}
+
+// QueryUUID читает необязательный query-параметр с UUID; отсутствие параметра означает nil.
+func QueryUUID(r *http.Request, name string) (*uuid.UUID, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return nil, nil
+	}
+
+	value, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, api.NewAPIError(api.ErrInvalidParameter, "invalid "+name+" format")
+	}
+
+	return &value, nil
+}
+
+// QueryTime читает необязательный query-параметр в формате RFC 3339; отсутствие параметра означает nil.
+func QueryTime(r *http.Request, name string) (*time.Time, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return nil, nil
+	}
+
+	value, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, api.NewAPIError(api.ErrInvalidParameter, name+" must be an RFC 3339 timestamp")
+	}
+
+	return &value, nil
+}
+
 func RespondJSON(w http.ResponseWriter, status int, data any) {
 	w.Header().Set("Content-Type", ContentTypeJSON)
 	w.WriteHeader(status)
@@ -40,7 +103,9 @@ func RespondError(w http.ResponseWriter, err error) {
 			status = http.StatusBadRequest
 		case api.ErrNotFound:
 			status = http.StatusNotFound
-		case api.ErrPRExist, api.ErrPRMerged, api.ErrNotAssigned, api.ErrNoCandidate:
+		case api.ErrUnauthorized:
+			status = http.StatusUnauthorized
+		case api.ErrPRExist, api.ErrPRMerged, api.ErrNotAssigned, api.ErrNoCandidate, api.ErrHierarchyCycle:
 			status = http.StatusConflict
 		default:
 			status = http.StatusInternalServerError
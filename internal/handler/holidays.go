@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/calendar"
+	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"go.uber.org/zap"
+)
+
+// maxCalendarSize ограничивает размер загружаемого файла .ics.
+const maxCalendarSize = 1 << 20
+
+func HandlerHolidaysImport(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		holidaysImport(w, r, storage, logger)
+	}
+}
+
+// holidaysImport принимает тело запроса в формате iCalendar (text/calendar).
+func holidaysImport(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName := r.URL.Query().Get("team_name")
+	replace, err := QueryBool(r, "replace")
+	if err != nil {
+		RespondError(w, err)
+		return
+	}
+
+	holidays, err := calendar.ParseICS(http.MaxBytesReader(w, r.Body, maxCalendarSize))
+	if err != nil {
+		logger.Warn("cannot parse iCalendar", zap.Error(err))
+		apiErr := api.NewAPIError(api.ErrInvalidParameter, err.Error())
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	imported, err := storage.ImportHolidays(ctx, teamName, holidays, replace)
+	if err != nil {
+		logger.Warn("cannot import holidays", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.HolidayImportResponse{Imported: imported})
+}
+
+func HandlerHolidays(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		holidays(w, r, storage, logger)
+	}
+}
+
+func holidays(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName := r.URL.Query().Get("team_name")
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := storage.GetHolidays(ctx, teamName)
+	if err != nil {
+		logger.Warn("cannot get holidays", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"go.uber.org/zap"
+)
+
+const (
+	ContentTypeNDJSON = "application/x-ndjson"
+	maxImportItems    = 1000
+	// ImportTimeout — время на чтение тела импорта и на сам импорт: сотни PR с назначениями
+	// обрабатываются дольше обычного запроса. Дедлайн чтения продлевает middleware.WithReadDeadline
+	ImportTimeout = 30 * time.Second
+)
+
+func HandlerPullRequestImport(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pullRequestImport(w, r, storage, logger)
+	}
+}
+
+func pullRequestImport(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	partial, err := QueryBool(r, "partial")
+	if err != nil {
+		RespondError(w, err)
+		return
+	}
+
+	items, err := decodeImportItems(r)
+	if err != nil {
+		logger.Warn("cannot decode import", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+	if len(items) == 0 {
+		apiErr := api.NewAPIError(api.ErrInvalidParameter, "import must contain at least one pull request")
+		RespondError(w, apiErr)
+		return
+	}
+
+	// Ответ должен уйти и после импорта, который длится дольше WriteTimeout сервера
+	if err = http.NewResponseController(w).SetWriteDeadline(time.Now().Add(ImportTimeout + 5*time.Second)); err != nil {
+		logger.Warn("cannot extend write deadline", zap.Error(err))
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), ImportTimeout)
+	defer cancel()
+
+	resp, err := storage.ImportPullRequests(ctx, items, partial)
+	if err != nil {
+		logger.Warn("cannot import pull requests", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, resp)
+}
+
+// decodeImportItems читает JSON-массив или, при Content-Type application/x-ndjson, по PR на строку.
+// Тело читается потоково и бросается на первом PR сверх maxImportItems.
+func decodeImportItems(r *http.Request) ([]api.PullRequestImportItem, error) {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == ContentTypeNDJSON {
+		return decodeImportStream(dec, func() bool { return true })
+	}
+
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+		return nil, api.NewAPIError(api.ErrInvalidJSON, "import must be a JSON array of pull requests")
+	}
+	items, err := decodeImportStream(dec, dec.More)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = dec.Token(); err != nil {
+		return nil, api.NewAPIError(api.ErrInvalidJSON, "cannot decode request body")
+	}
+	return items, nil
+}
+
+// decodeImportStream декодирует PR, пока more возвращает true и в теле есть данные.
+func decodeImportStream(dec *json.Decoder, more func() bool) ([]api.PullRequestImportItem, error) {
+	var items []api.PullRequestImportItem
+	for more() {
+		var item api.PullRequestImportItem
+		err := dec.Decode(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, api.NewAPIError(api.ErrInvalidJSON, fmt.Sprintf("cannot decode pull request %d", len(items)))
+		}
+		if len(items) == maxImportItems {
+			return nil, api.NewAPIError(api.ErrInvalidParameter, fmt.Sprintf("import is limited to %d pull requests", maxImportItems))
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+)
+
+func TestDecodeImportItems(t *testing.T) {
+	item := `{"pull_request_id":"e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a54","pull_request_name":"Add retries","author_id":"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d","status":"OPEN"}`
+	items := func(n int) string { return strings.TrimSuffix(strings.Repeat(item+",", n), ",") }
+	array := func(n int) string { return "[" + items(n) + "]" }
+	lines := func(n int) string { return strings.Repeat(item+"\n", n) }
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        int
+		wantCode    api.ErrorCode
+	}{
+		{name: "array", contentType: "application/json", body: array(3), want: 3},
+		{name: "empty array", contentType: "application/json", body: "[]", want: 0},
+		{name: "array at limit", contentType: "application/json", body: array(maxImportItems), want: maxImportItems},
+		// Тело обрывается после лишнего PR: ошибка о лимите приходит, не дожидаясь конца массива
+		{name: "array over limit", contentType: "application/json", body: "[" + items(maxImportItems+1) + ",", wantCode: api.ErrInvalidParameter},
+		{name: "not an array", contentType: "application/json", body: item, wantCode: api.ErrInvalidJSON},
+		{name: "unterminated array", contentType: "application/json", body: "[" + item, wantCode: api.ErrInvalidJSON},
+		{name: "unknown field", contentType: "application/json", body: `[{"unknown":1}]`, wantCode: api.ErrInvalidJSON},
+		{name: "ndjson", contentType: ContentTypeNDJSON, body: lines(2), want: 2},
+		{name: "ndjson at limit", contentType: ContentTypeNDJSON, body: lines(maxImportItems), want: maxImportItems},
+		{name: "ndjson over limit", contentType: ContentTypeNDJSON, body: lines(maxImportItems + 1), wantCode: api.ErrInvalidParameter},
+		{name: "ndjson broken line", contentType: ContentTypeNDJSON, body: item + "\n{", wantCode: api.ErrInvalidJSON},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/pullRequest/import", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", tt.contentType)
+
+			got, err := decodeImportItems(r)
+			if tt.wantCode != "" {
+				var apiErr *api.APIError
+				if !errors.As(err, &apiErr) || apiErr.Code != tt.wantCode {
+					t.Fatalf("got %v, want %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != tt.want {
+				t.Fatalf("got %d pull requests, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
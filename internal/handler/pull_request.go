@@ -5,8 +5,8 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/F3dosik/PRS.git/internal/models/api"
 	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
@@ -18,7 +18,7 @@ func HandlerPullRequestCreate(storage *repository.Storage, logger *zap.SugaredLo
 }
 
 func pullRequestCreate(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
-	var pr api.PullRequestShort
+	var pr api.PullRequestCreateRequest
 	if err := DecodeJSON(r, &pr); err != nil {
 		logger.Warn("cannot decode JSON", zap.Error(err))
 		RespondError(w, err)
@@ -31,11 +31,18 @@ func pullRequestCreate(w http.ResponseWriter, r *http.Request, storage *reposito
 		RespondError(w, apiErr)
 		return
 	}
+	for _, file := range pr.ChangedFiles {
+		if file == "" {
+			apiErr := api.NewAPIError(api.ErrInvalidPR, "changed_files must not contain empty paths")
+			RespondError(w, apiErr)
+			return
+		}
+	}
 
 	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
 	defer cancel()
 
-	pullRequest, err := storage.PullRequestCreate(ctx, pr.PullRequestID, pr.AuthorID, pr.PullRequestName)
+	pullRequest, err := storage.PullRequestCreate(ctx, &pr)
 	if err != nil {
 		logger.Warn("cannot create pull request", zap.Error(err))
 		RespondError(w, err)
@@ -46,10 +53,6 @@ func pullRequestCreate(w http.ResponseWriter, r *http.Request, storage *reposito
 	RespondJSON(w, http.StatusCreated, api.PullRequestResponse{PullRequest: *pullRequest})
 }
 
-type mergeRequest struct {
-	PullRequestID uuid.UUID `json:"pull_request_id"`
-}
-
 func HandlerPullRequestMerge(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		pullRequestMerge(w, r, storage, logger)
@@ -57,7 +60,7 @@ func HandlerPullRequestMerge(storage *repository.Storage, logger *zap.SugaredLog
 }
 
 func pullRequestMerge(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
-	var req mergeRequest
+	var req api.PullRequestMergeRequest
 	if err := DecodeJSON(r, &req); err != nil {
 		logger.Warn("invalid JSON", zap.Error(err))
 		RespondError(w, err)
@@ -84,11 +87,6 @@ func pullRequestMerge(w http.ResponseWriter, r *http.Request, storage *repositor
 	RespondJSON(w, http.StatusOK, api.PullRequestResponse{PullRequest: *pr})
 }
 
-type reassignRequest struct {
-	PullRequestID uuid.UUID `json:"pull_request_id"`
-	OldUserID     uuid.UUID `json:"old_user_id"`
-}
-
 func HandlerPullRequestReassign(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		pullRequestReassign(w, r, storage, logger)
@@ -96,7 +94,7 @@ func HandlerPullRequestReassign(storage *repository.Storage, logger *zap.Sugared
 }
 
 func pullRequestReassign(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
-	var req reassignRequest
+	var req api.PullRequestReassignRequest
 	if err := DecodeJSON(r, &req); err != nil {
 		logger.Warn("invalid JSON", zap.Error(err))
 		RespondError(w, err)
@@ -127,3 +125,208 @@ func pullRequestReassign(w http.ResponseWriter, r *http.Request, storage *reposi
 	logger.Debug("sending HTTP 200 response")
 	RespondJSON(w, http.StatusOK, prReassignResponse)
 }
+
+func HandlerPullRequestFillReviewers(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pullRequestFillReviewers(w, r, storage, logger)
+	}
+}
+
+func pullRequestFillReviewers(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var req api.FillReviewersRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		logger.Warn("invalid JSON", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	prs, err := storage.FillReviewers(ctx, req.TeamName)
+	if err != nil {
+		logger.Warn("cannot fill reviewers", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.FillReviewersResponse{PullRequests: prs})
+}
+
+func HandlerPullRequestExplainAssignment(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pullRequestExplainAssignment(w, r, storage, logger)
+	}
+}
+
+func pullRequestExplainAssignment(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	prID, err := uuid.Parse(r.URL.Query().Get("pull_request_id"))
+	if err != nil {
+		logger.Warn("invalid pull_request_id format", zap.Error(err))
+		apiErr := api.NewAPIError(api.ErrInvalidPR, "invalid pull_request_id format")
+		RespondError(w, apiErr)
+		return
+	}
+
+	decisionID, err := QueryInt64(r, "decision_id")
+	if err != nil {
+		logger.Warn("invalid decision_id", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	explanation, err := storage.ExplainAssignment(ctx, prID, decisionID)
+	if err != nil {
+		logger.Warn("cannot explain assignment", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, explanation)
+}
+
+func HandlerPullRequestOverdue(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pullRequestOverdue(w, r, storage, logger)
+	}
+}
+
+func pullRequestOverdue(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName := r.URL.Query().Get("team_name")
+	includeDescendants, err := QueryBool(r, "include_descendants")
+	if err != nil {
+		RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	overdue, err := storage.GetOverdue(ctx, teamName, includeDescendants, time.Now())
+	if err != nil {
+		logger.Warn("cannot get overdue reviews", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, overdue)
+}
+
+func HandlerPullRequestGet(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pullRequestGet(w, r, storage, logger)
+	}
+}
+
+func pullRequestGet(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	prID, err := uuid.Parse(r.URL.Query().Get("pull_request_id"))
+	if err != nil {
+		logger.Warn("invalid pull_request_id format", zap.Error(err))
+		apiErr := api.NewAPIError(api.ErrInvalidPR, "invalid pull_request_id format")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	pr, err := storage.GetPullRequest(ctx, prID)
+	if err != nil {
+		logger.Warn("cannot get pull request", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.PullRequestResponse{PullRequest: *pr})
+}
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+)
+
+func HandlerPullRequestList(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pullRequestList(w, r, storage, logger)
+	}
+}
+
+func pullRequestList(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	filter, err := QueryPullRequestFilter(r)
+	if err != nil {
+		logger.Warn("invalid pull request filter", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := storage.ListPullRequests(ctx, filter)
+	if err != nil {
+		logger.Warn("cannot list pull requests", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, resp)
+}
+
+// QueryPullRequestFilter собирает фильтр списка PR из query-параметров.
+func QueryPullRequestFilter(r *http.Request) (*api.PullRequestFilter, error) {
+	query := r.URL.Query()
+	filter := &api.PullRequestFilter{
+		TeamName: query.Get("team_name"),
+		Status:   api.PRStatus(query.Get("status")),
+		Cursor:   query.Get("cursor"),
+		Limit:    defaultListLimit,
+	}
+
+	var err error
+	if filter.IncludeDescendants, err = QueryBool(r, "include_descendants"); err != nil {
+		return nil, err
+	}
+	if filter.Status != "" && filter.Status != api.StatusOpen && filter.Status != api.StatusMerged {
+		return nil, api.NewAPIError(api.ErrInvalidParameter, "status must be OPEN or MERGED")
+	}
+	if filter.AuthorID, err = QueryUUID(r, "author_id"); err != nil {
+		return nil, err
+	}
+	if filter.ReviewerID, err = QueryUUID(r, "reviewer_id"); err != nil {
+		return nil, err
+	}
+	if filter.CreatedFrom, err = QueryTime(r, "created_from"); err != nil {
+		return nil, err
+	}
+	if filter.CreatedTo, err = QueryTime(r, "created_to"); err != nil {
+		return nil, err
+	}
+	if query.Get("need_more_reviewers") != "" {
+		needMore, err := QueryBool(r, "need_more_reviewers")
+		if err != nil {
+			return nil, err
+		}
+		filter.NeedMoreReviewers = &needMore
+	}
+
+	limit, err := QueryInt64(r, "limit")
+	if err != nil {
+		return nil, err
+	}
+	if limit != nil {
+		if *limit < 1 || *limit > maxListLimit {
+			return nil, api.NewAPIError(api.ErrInvalidParameter, "limit must be between 1 and 200")
+		}
+		filter.Limit = int(*limit)
+	}
+
+	return filter, nil
+}
@@ -16,10 +16,17 @@ func HandlerStats(storage *repository.Storage, logger *zap.SugaredLogger) http.H
 }
 
 func stats(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName := r.URL.Query().Get("team_name")
+	includeDescendants, err := QueryBool(r, "include_descendants")
+	if err != nil {
+		RespondError(w, err)
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
 	defer cancel()
 
-	stats, err := storage.GetStats(ctx)
+	stats, err := storage.GetStats(ctx, teamName, includeDescendants)
 	if err != nil {
 		logger.Warn("cannot get stats", zap.Error(err))
 		RespondError(w, err)
@@ -29,3 +36,59 @@ func stats(w http.ResponseWriter, r *http.Request, storage *repository.Storage,
 	logger.Debug("sending HTTP 200 response")
 	RespondJSON(w, http.StatusOK, stats)
 }
+
+func HandlerPairStats(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pairStats(w, r, storage, logger)
+	}
+}
+
+func pairStats(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName := r.URL.Query().Get("team_name")
+	includeDescendants, err := QueryBool(r, "include_descendants")
+	if err != nil {
+		RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	stats, err := storage.GetPairStats(ctx, teamName, includeDescendants)
+	if err != nil {
+		logger.Warn("cannot get pair stats", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, stats)
+}
+
+func HandlerLatencyStats(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		latencyStats(w, r, storage, logger)
+	}
+}
+
+func latencyStats(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName := r.URL.Query().Get("team_name")
+	includeDescendants, err := QueryBool(r, "include_descendants")
+	if err != nil {
+		RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	stats, err := storage.GetLatencyStats(ctx, teamName, includeDescendants)
+	if err != nil {
+		logger.Warn("cannot get latency stats", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, stats)
+}
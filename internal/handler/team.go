@@ -5,8 +5,8 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/F3dosik/PRS.git/internal/models/api"
 	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
 	"go.uber.org/zap"
 )
 
@@ -57,9 +57,15 @@ func teamGet(w http.ResponseWriter, r *http.Request, storage *repository.Storage
 		return
 	}
 
+	includeDescendants, err := QueryBool(r, "include_descendants")
+	if err != nil {
+		RespondError(w, err)
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
 	defer cancel()
-	team, err := storage.GetTeam(ctx, teamName)
+	team, err := storage.GetTeam(ctx, teamName, includeDescendants)
 	if err != nil {
 		logger.Warn("cannot get team", zap.Error(err))
 		RespondError(w, err)
@@ -69,3 +75,395 @@ func teamGet(w http.ResponseWriter, r *http.Request, storage *repository.Storage
 	logger.Debug("sending HTTP 200 response")
 	RespondJSON(w, http.StatusOK, api.TeamResponse{Team: team})
 }
+
+func HandleTeamFallbackGet(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamFallbackGet(w, r, storage, logger)
+	}
+}
+
+func teamFallbackGet(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		apiErr := api.NewAPIError(api.ErrInvalidParameter, "team_name query parameter is required")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	fallback, err := storage.GetTeamFallback(ctx, teamName)
+	if err != nil {
+		logger.Warn("cannot get team fallback", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.TeamFallbackResponse{Fallback: fallback})
+}
+
+func HandleTeamFallbackSet(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamFallbackSet(w, r, storage, logger)
+	}
+}
+
+func teamFallbackSet(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var fallback api.TeamFallback
+	if err := DecodeJSON(r, &fallback); err != nil {
+		logger.Warn("cannot decode team fallback JSON", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	if fallback.TeamName == "" {
+		apiErr := api.NewAPIError(api.ErrInvalidTeam, "team_name is required")
+		RespondError(w, apiErr)
+		return
+	}
+	if fallback.Chain == nil {
+		fallback.Chain = []api.FallbackEntry{}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	if err := storage.SetTeamFallback(ctx, &fallback); err != nil {
+		logger.Warn("cannot set team fallback", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.TeamFallbackResponse{Fallback: &fallback})
+}
+
+func HandleTeamSubtree(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamSubtree(w, r, storage, logger)
+	}
+}
+
+func teamSubtree(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		apiErr := api.NewAPIError(api.ErrInvalidParameter, "team_name query parameter is required")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	subtree, err := storage.GetTeamSubtree(ctx, teamName)
+	if err != nil {
+		logger.Warn("cannot get team subtree", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.TeamSubtreeResponse{Subtree: subtree})
+}
+
+func HandleTeamSetParent(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamSetParent(w, r, storage, logger)
+	}
+}
+
+func teamSetParent(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var req api.TeamSetParentRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		logger.Warn("cannot decode JSON", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	if req.TeamName == "" || (req.ParentTeam != nil && *req.ParentTeam == "") {
+		apiErr := api.NewAPIError(api.ErrInvalidTeam, "team_name and parent_team must not be empty")
+		RespondError(w, apiErr)
+		return
+	}
+
+	var parentName string
+	if req.ParentTeam != nil {
+		parentName = *req.ParentTeam
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	team, err := storage.SetTeamParent(ctx, req.TeamName, parentName)
+	if err != nil {
+		logger.Warn("cannot set team parent", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.TeamResponse{Team: team})
+}
+
+func HandleTeamOwnersGet(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamOwnersGet(w, r, storage, logger)
+	}
+}
+
+func teamOwnersGet(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		apiErr := api.NewAPIError(api.ErrInvalidParameter, "team_name query parameter is required")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	owners, err := storage.GetTeamOwners(ctx, teamName)
+	if err != nil {
+		logger.Warn("cannot get team owners", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.TeamOwnersResponse{Owners: owners})
+}
+
+func HandleTeamOwnersSet(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamOwnersSet(w, r, storage, logger)
+	}
+}
+
+func teamOwnersSet(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var owners api.TeamOwners
+	if err := DecodeJSON(r, &owners); err != nil {
+		logger.Warn("cannot decode team owners JSON", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	if owners.TeamName == "" {
+		apiErr := api.NewAPIError(api.ErrInvalidTeam, "team_name is required")
+		RespondError(w, apiErr)
+		return
+	}
+	if owners.Rules == nil {
+		owners.Rules = []api.CodeOwnerRule{}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	if err := storage.SetTeamOwners(ctx, &owners); err != nil {
+		logger.Warn("cannot set team owners", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.TeamOwnersResponse{Owners: &owners})
+}
+
+func HandleTeamOwnersDelete(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamOwnersDelete(w, r, storage, logger)
+	}
+}
+
+func teamOwnersDelete(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		apiErr := api.NewAPIError(api.ErrInvalidParameter, "team_name query parameter is required")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	if err := storage.DeleteTeamOwners(ctx, teamName); err != nil {
+		logger.Warn("cannot delete team owners", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 204 response")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func HandleTeamReviewRulesGet(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamReviewRulesGet(w, r, storage, logger)
+	}
+}
+
+func teamReviewRulesGet(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		apiErr := api.NewAPIError(api.ErrInvalidParameter, "team_name query parameter is required")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	rules, err := storage.GetTeamReviewRules(ctx, teamName)
+	if err != nil {
+		logger.Warn("cannot get team review rules", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.TeamReviewRulesResponse{Rules: rules})
+}
+
+func HandleTeamReviewRulesSet(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamReviewRulesSet(w, r, storage, logger)
+	}
+}
+
+func teamReviewRulesSet(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var rules api.TeamReviewRules
+	if err := DecodeJSON(r, &rules); err != nil {
+		logger.Warn("cannot decode team review rules JSON", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	if rules.TeamName == "" {
+		apiErr := api.NewAPIError(api.ErrInvalidTeam, "team_name is required")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	if err := storage.SetTeamReviewRules(ctx, &rules); err != nil {
+		logger.Warn("cannot set team review rules", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.TeamReviewRulesResponse{Rules: &rules})
+}
+
+func HandleTeamSLAGet(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamSLAGet(w, r, storage, logger)
+	}
+}
+
+func teamSLAGet(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		apiErr := api.NewAPIError(api.ErrInvalidParameter, "team_name query parameter is required")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	teamSLA, err := storage.GetTeamSLA(ctx, teamName)
+	if err != nil {
+		logger.Warn("cannot get team sla", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.TeamSLAResponse{SLA: teamSLA})
+}
+
+func HandleTeamSLASet(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamSLASet(w, r, storage, logger)
+	}
+}
+
+func teamSLASet(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var teamSLA api.TeamSLA
+	if err := DecodeJSON(r, &teamSLA); err != nil {
+		logger.Warn("cannot decode team sla JSON", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	if teamSLA.TeamName == "" {
+		apiErr := api.NewAPIError(api.ErrInvalidTeam, "team_name is required")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	if err := storage.SetTeamSLA(ctx, &teamSLA); err != nil {
+		logger.Warn("cannot set team sla", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.TeamSLAResponse{SLA: &teamSLA})
+}
+
+func HandleTeamScheduleGet(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamScheduleGet(w, r, storage, logger)
+	}
+}
+
+func teamScheduleGet(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		apiErr := api.NewAPIError(api.ErrInvalidParameter, "team_name query parameter is required")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	schedule, err := storage.GetTeamSchedule(ctx, teamName)
+	if err != nil {
+		logger.Warn("cannot get team schedule", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.TeamScheduleResponse{Schedule: schedule})
+}
+
+func HandleTeamScheduleSet(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamScheduleSet(w, r, storage, logger)
+	}
+}
+
+func teamScheduleSet(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var schedule api.TeamSchedule
+	if err := DecodeJSON(r, &schedule); err != nil {
+		logger.Warn("cannot decode team schedule JSON", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	if schedule.TeamName == "" {
+		apiErr := api.NewAPIError(api.ErrInvalidTeam, "team_name is required")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	if err := storage.SetTeamSchedule(ctx, &schedule); err != nil {
+		logger.Warn("cannot set team schedule", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.TeamScheduleResponse{Schedule: &schedule})
+}
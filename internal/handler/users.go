@@ -3,19 +3,15 @@ package handler
 import (
 	"context"
 	"net/http"
+	"strings"
 	"time"
 
-	"github.com/F3dosik/PRS.git/internal/models/api"
 	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
-type SetIsActiveRequest struct {
-	UserID   uuid.UUID `json:"user_id"`
-	IsActive bool      `json:"is_active"`
-}
-
 func HandlerSetIsActive(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		setIsActive(w, r, storage, logger)
@@ -23,7 +19,7 @@ func HandlerSetIsActive(storage *repository.Storage, logger *zap.SugaredLogger)
 }
 
 func setIsActive(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
-	var req SetIsActiveRequest
+	var req api.SetIsActiveRequest
 	if err := DecodeJSON(r, &req); err != nil {
 		logger.Warn("cannot decode json", zap.Error(err))
 		RespondError(w, err)
@@ -39,7 +35,7 @@ func setIsActive(w http.ResponseWriter, r *http.Request, storage *repository.Sto
 
 	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
 	defer cancel()
-	user, err := storage.SetIsActive(ctx, req.UserID, req.IsActive)
+	user, err := storage.SetIsActive(ctx, req.UserID, req.TeamName, req.IsActive)
 	if err != nil {
 		logger.Warn("cannot set isActive", zap.Error(err))
 		RespondError(w, err)
@@ -50,6 +46,69 @@ func setIsActive(w http.ResponseWriter, r *http.Request, storage *repository.Sto
 	RespondJSON(w, http.StatusOK, api.UserResponse{User: *user})
 }
 
+func HandlerSetSeniority(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		setSeniority(w, r, storage, logger)
+	}
+}
+
+func setSeniority(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var req api.SetSeniorityRequest
+	if err := DecodeJSON(r, &req); err != nil {
+		logger.Warn("cannot decode json", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	if req.UserID == uuid.Nil {
+		logger.Warn("user_id is invalid")
+		apiErr := api.NewAPIError(api.ErrInvalidUser, "user_id is required")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	user, err := storage.SetUserSeniority(ctx, req.UserID, req.Seniority)
+	if err != nil {
+		logger.Warn("cannot set seniority", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.UserResponse{User: *user})
+}
+
+func HandlerGetUser(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		getUser(w, r, storage, logger)
+	}
+}
+
+func getUser(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	userID, err := uuid.Parse(r.URL.Query().Get("user_id"))
+	if err != nil {
+		logger.Warn("invalid user_id format", zap.Error(err))
+		apiErr := api.NewAPIError(api.ErrInvalidUser, "invalid user_id format")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	user, err := storage.GetUser(ctx, userID)
+	if err != nil {
+		logger.Warn("cannot get user", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.UserResponse{User: *user})
+}
+
 func HandlerGetReview(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		getReview(w, r, storage, logger)
@@ -85,3 +144,296 @@ func getReview(w http.ResponseWriter, r *http.Request, storage *repository.Stora
 	logger.Debug("sending HTTP 200 response")
 	RespondJSON(w, http.StatusOK, resp)
 }
+
+func HandlerGetSkills(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		getSkills(w, r, storage, logger)
+	}
+}
+
+func getSkills(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	userID, err := uuid.Parse(r.URL.Query().Get("user_id"))
+	if err != nil {
+		logger.Warn("invalid user_id format", zap.Error(err))
+		apiErr := api.NewAPIError(api.ErrInvalidUser, "invalid user_id format")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	skills, err := storage.GetUserSkills(ctx, userID)
+	if err != nil {
+		logger.Warn("cannot get user skills", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, skills)
+}
+
+func HandlerSetSkills(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		setSkills(w, r, storage, logger)
+	}
+}
+
+func setSkills(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var req api.UserSkills
+	if err := DecodeJSON(r, &req); err != nil {
+		logger.Warn("cannot decode json", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	if req.UserID == uuid.Nil {
+		apiErr := api.NewAPIError(api.ErrInvalidUser, "user_id is required")
+		RespondError(w, apiErr)
+		return
+	}
+	for _, skill := range req.Skills {
+		if strings.Contains(skill, ",") {
+			apiErr := api.NewAPIError(api.ErrInvalidParameter, "skills must not contain commas")
+			RespondError(w, apiErr)
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := storage.SetUserSkills(ctx, &req); err != nil {
+		logger.Warn("cannot set user skills", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, req)
+}
+
+func HandlerGetSchedule(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		getSchedule(w, r, storage, logger)
+	}
+}
+
+func getSchedule(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	userID, err := uuid.Parse(r.URL.Query().Get("user_id"))
+	if err != nil {
+		logger.Warn("invalid user_id format", zap.Error(err))
+		apiErr := api.NewAPIError(api.ErrInvalidUser, "invalid user_id format")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	schedule, err := storage.GetUserSchedule(ctx, userID)
+	if err != nil {
+		logger.Warn("cannot get user schedule", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.UserScheduleResponse{Schedule: schedule})
+}
+
+func HandlerSetSchedule(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		setSchedule(w, r, storage, logger)
+	}
+}
+
+func setSchedule(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var schedule api.UserSchedule
+	if err := DecodeJSON(r, &schedule); err != nil {
+		logger.Warn("cannot decode json", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	if schedule.UserID == uuid.Nil {
+		apiErr := api.NewAPIError(api.ErrInvalidUser, "user_id is required")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := storage.SetUserSchedule(ctx, &schedule); err != nil {
+		logger.Warn("cannot set user schedule", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, api.UserScheduleResponse{Schedule: &schedule})
+}
+
+func HandlerAvailability(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		availability(w, r, storage, logger)
+	}
+}
+
+func availability(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	userID, err := uuid.Parse(r.URL.Query().Get("user_id"))
+	if err != nil {
+		logger.Warn("invalid user_id format", zap.Error(err))
+		apiErr := api.NewAPIError(api.ErrInvalidUser, "invalid user_id format")
+		RespondError(w, apiErr)
+		return
+	}
+
+	at := time.Now()
+	if raw := r.URL.Query().Get("at"); raw != "" {
+		if at, err = time.Parse(time.RFC3339, raw); err != nil {
+			apiErr := api.NewAPIError(api.ErrInvalidParameter, "at must be an RFC 3339 timestamp")
+			RespondError(w, apiErr)
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := storage.GetAvailability(ctx, userID, at)
+	if err != nil {
+		logger.Warn("cannot get user availability", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, resp)
+}
+
+func HandlerGetSlackUser(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		getSlackUser(w, r, storage, logger)
+	}
+}
+
+func getSlackUser(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	userID, err := uuid.Parse(r.URL.Query().Get("user_id"))
+	if err != nil {
+		logger.Warn("invalid user_id format", zap.Error(err))
+		apiErr := api.NewAPIError(api.ErrInvalidUser, "invalid user_id format")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	user, err := storage.GetSlackUser(ctx, userID)
+	if err != nil {
+		logger.Warn("cannot get slack id", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, user)
+}
+
+func HandlerSetSlackUser(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		setSlackUser(w, r, storage, logger)
+	}
+}
+
+func setSlackUser(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var user api.SlackUser
+	if err := DecodeJSON(r, &user); err != nil {
+		logger.Warn("cannot decode json", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	if user.UserID == uuid.Nil {
+		apiErr := api.NewAPIError(api.ErrInvalidUser, "user_id is required")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := storage.SetSlackUser(ctx, &user); err != nil {
+		logger.Warn("cannot set slack id", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, user)
+}
+
+func HandlerGetDigest(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		getDigest(w, r, storage, logger)
+	}
+}
+
+func getDigest(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	userID, err := uuid.Parse(r.URL.Query().Get("user_id"))
+	if err != nil {
+		logger.Warn("invalid user_id format", zap.Error(err))
+		apiErr := api.NewAPIError(api.ErrInvalidUser, "invalid user_id format")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	sub, err := storage.GetDigestSubscription(ctx, userID)
+	if err != nil {
+		logger.Warn("cannot get digest subscription", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, sub)
+}
+
+func HandlerSetDigest(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		setDigest(w, r, storage, logger)
+	}
+}
+
+func setDigest(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var sub api.DigestSubscription
+	if err := DecodeJSON(r, &sub); err != nil {
+		logger.Warn("cannot decode json", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	if sub.UserID == uuid.Nil {
+		apiErr := api.NewAPIError(api.ErrInvalidUser, "user_id is required")
+		RespondError(w, apiErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := storage.SetDigestSubscription(ctx, &sub); err != nil {
+		logger.Warn("cannot set digest subscription", zap.Error(err))
+		RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	RespondJSON(w, http.StatusOK, sub)
+}
@@ -0,0 +1,61 @@
+// Package v2 — хендлеры API v2: ресурсы адресуются путём (/teams/{team_name}), ответы — сами
+// ресурсы без обёрток, все поля в snake_case, созданные ресурсы возвращают Location.
+package v2
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// Prefix — префикс, под которым смонтирован API v2; используется в заголовке Location.
+const Prefix = "/api/v2"
+
+// pathParam возвращает раскодированный параметр пути. chi берёт параметры из RawPath, если он
+// задан, поэтому раскодировать нужно только в этом случае.
+func pathParam(r *http.Request, name string) string {
+	value := chi.URLParam(r, name)
+	if r.URL.RawPath == "" {
+		return value
+	}
+	if unescaped, err := url.PathUnescape(value); err == nil {
+		return unescaped
+	}
+
+	return value
+}
+
+func teamNameParam(r *http.Request) (string, error) {
+	teamName := pathParam(r, "team_name")
+	if teamName == "" {
+		return "", api.NewAPIError(api.ErrInvalidTeam, "team_name is required")
+	}
+
+	return teamName, nil
+}
+
+func userIDParam(r *http.Request) (uuid.UUID, error) {
+	userID, err := uuid.Parse(pathParam(r, "user_id"))
+	if err != nil {
+		return uuid.Nil, api.NewAPIError(api.ErrInvalidUser, "invalid user_id format")
+	}
+
+	return userID, nil
+}
+
+func pullRequestIDParam(r *http.Request) (uuid.UUID, error) {
+	prID, err := uuid.Parse(pathParam(r, "pull_request_id"))
+	if err != nil {
+		return uuid.Nil, api.NewAPIError(api.ErrInvalidPR, "invalid pull_request_id format")
+	}
+
+	return prID, nil
+}
+
+// setLocation указывает адрес созданного ресурса.
+func setLocation(w http.ResponseWriter, collection, id string) {
+	w.Header().Set("Location", Prefix+"/"+collection+"/"+url.PathEscape(id))
+}
@@ -0,0 +1,254 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/handler"
+	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func HandlerPullRequestCreate(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pullRequestCreate(w, r, storage, logger)
+	}
+}
+
+func pullRequestCreate(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var req api.PullRequestCreateRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		logger.Warn("cannot decode JSON", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	if req.PullRequestID == uuid.Nil || req.PullRequestName == "" || req.AuthorID == uuid.Nil {
+		logger.Warn("pull request is invalid")
+		handler.RespondError(w, api.NewAPIError(api.ErrInvalidPR, "invalid pull request"))
+		return
+	}
+	for _, file := range req.ChangedFiles {
+		if file == "" {
+			handler.RespondError(w, api.NewAPIError(api.ErrInvalidPR, "changed_files must not contain empty paths"))
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	pr, err := storage.PullRequestCreate(ctx, &req)
+	if err != nil {
+		logger.Warn("cannot create pull request", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 201 response")
+	setLocation(w, "pull-requests", pr.PullRequestID.String())
+	handler.RespondJSON(w, http.StatusCreated, pr.V2())
+}
+
+func HandlerPullRequestGet(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pullRequestGet(w, r, storage, logger)
+	}
+}
+
+func pullRequestGet(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	prID, err := pullRequestIDParam(r)
+	if err != nil {
+		handler.RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	pr, err := storage.GetPullRequest(ctx, prID)
+	if err != nil {
+		logger.Warn("cannot get pull request", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	handler.RespondJSON(w, http.StatusOK, pr.V2())
+}
+
+func HandlerPullRequestList(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pullRequestList(w, r, storage, logger)
+	}
+}
+
+func pullRequestList(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	filter, err := handler.QueryPullRequestFilter(r)
+	if err != nil {
+		logger.Warn("invalid pull request filter", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	page, err := storage.ListPullRequests(ctx, filter)
+	if err != nil {
+		logger.Warn("cannot list pull requests", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	resp := api.PullRequestListResponseV2{
+		PullRequests: make([]api.PullRequestV2, len(page.PullRequests)),
+		NextCursor:   page.NextCursor,
+	}
+	for i, pr := range page.PullRequests {
+		resp.PullRequests[i] = pr.V2()
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	handler.RespondJSON(w, http.StatusOK, resp)
+}
+
+func HandlerPullRequestMerge(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pullRequestMerge(w, r, storage, logger)
+	}
+}
+
+func pullRequestMerge(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	prID, err := pullRequestIDParam(r)
+	if err != nil {
+		handler.RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	pr, err := storage.PullRequestMerge(ctx, prID)
+	if err != nil {
+		logger.Warn("cannot merge pull request", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	handler.RespondJSON(w, http.StatusOK, pr.V2())
+}
+
+type reassignRequest struct {
+	OldUserID uuid.UUID `json:"old_user_id"`
+}
+
+func HandlerPullRequestReassign(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pullRequestReassign(w, r, storage, logger)
+	}
+}
+
+func pullRequestReassign(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	prID, err := pullRequestIDParam(r)
+	if err != nil {
+		handler.RespondError(w, err)
+		return
+	}
+
+	var req reassignRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		logger.Warn("invalid JSON", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	if req.OldUserID == uuid.Nil {
+		handler.RespondError(w, api.NewAPIError(api.ErrInvalidUser, "old_user_id is required"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	resp, err := storage.PullRequestReassign(ctx, prID, req.OldUserID)
+	if err != nil {
+		logger.Warn("cannot reassign pull request", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	handler.RespondJSON(w, http.StatusOK, api.PullRequestReassignResponseV2{
+		PullRequest: resp.PullRequest.V2(),
+		ReplacedBy:  resp.ReplacedBy,
+	})
+}
+
+type fillReviewersRequest struct {
+	TeamName string `json:"team_name"`
+}
+
+func HandlerPullRequestFillReviewers(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pullRequestFillReviewers(w, r, storage, logger)
+	}
+}
+
+func pullRequestFillReviewers(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var req fillReviewersRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		logger.Warn("invalid JSON", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	prs, err := storage.FillReviewers(ctx, req.TeamName)
+	if err != nil {
+		logger.Warn("cannot fill reviewers", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	resp := api.FillReviewersResponseV2{PullRequests: make([]api.PullRequestV2, len(prs))}
+	for i, pr := range prs {
+		resp.PullRequests[i] = pr.V2()
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	handler.RespondJSON(w, http.StatusOK, resp)
+}
+
+func HandlerPullRequestExplanation(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pullRequestExplanation(w, r, storage, logger)
+	}
+}
+
+func pullRequestExplanation(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	prID, err := pullRequestIDParam(r)
+	if err != nil {
+		handler.RespondError(w, err)
+		return
+	}
+
+	decisionID, err := handler.QueryInt64(r, "decision_id")
+	if err != nil {
+		logger.Warn("invalid decision_id", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	explanation, err := storage.ExplainAssignment(ctx, prID, decisionID)
+	if err != nil {
+		logger.Warn("cannot explain assignment", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	handler.RespondJSON(w, http.StatusOK, explanation)
+}
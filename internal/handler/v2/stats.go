@@ -0,0 +1,40 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/handler"
+	"github.com/F3dosik/PRS.git/internal/repository"
+	"go.uber.org/zap"
+)
+
+// HandlerStats отдаёт статистику с назначениями по ревьюверам списком: в v1 это объект,
+// ключ которого — имя пользователя, а имена не уникальны.
+func HandlerStats(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		stats(w, r, storage, logger)
+	}
+}
+
+func stats(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName := r.URL.Query().Get("team_name")
+	includeDescendants, err := handler.QueryBool(r, "include_descendants")
+	if err != nil {
+		handler.RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	stats, err := storage.GetReviewerStats(ctx, teamName, includeDescendants)
+	if err != nil {
+		logger.Warn("cannot get stats", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	handler.RespondJSON(w, http.StatusOK, stats)
+}
@@ -0,0 +1,149 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/handler"
+	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"go.uber.org/zap"
+)
+
+func HandlerTeamCreate(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamCreate(w, r, storage, logger)
+	}
+}
+
+func teamCreate(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	var team api.Team
+	if err := handler.DecodeJSON(r, &team); err != nil {
+		logger.Warn("cannot decode team JSON", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	if team.TeamName == "" || len(team.Members) == 0 {
+		logger.Warn("team name or members are invalid")
+		handler.RespondError(w, api.NewAPIError(api.ErrInvalidTeam, "team name or members are invalid"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	if err := storage.UpdateTeam(ctx, &team); err != nil {
+		logger.Warn("cannot update team", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 201 response")
+	setLocation(w, "teams", team.TeamName)
+	handler.RespondJSON(w, http.StatusCreated, team)
+}
+
+func HandlerTeamGet(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamGet(w, r, storage, logger)
+	}
+}
+
+func teamGet(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName, err := teamNameParam(r)
+	if err != nil {
+		handler.RespondError(w, err)
+		return
+	}
+
+	includeDescendants, err := handler.QueryBool(r, "include_descendants")
+	if err != nil {
+		handler.RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	team, err := storage.GetTeam(ctx, teamName, includeDescendants)
+	if err != nil {
+		logger.Warn("cannot get team", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	handler.RespondJSON(w, http.StatusOK, team)
+}
+
+type setParentRequest struct {
+	ParentTeam *string `json:"parent_team"` // null делает команду корневой
+}
+
+func HandlerTeamSetParent(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamSetParent(w, r, storage, logger)
+	}
+}
+
+func teamSetParent(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName, err := teamNameParam(r)
+	if err != nil {
+		handler.RespondError(w, err)
+		return
+	}
+
+	var req setParentRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		logger.Warn("cannot decode JSON", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	var parentName string
+	if req.ParentTeam != nil {
+		if *req.ParentTeam == "" {
+			handler.RespondError(w, api.NewAPIError(api.ErrInvalidTeam, "parent_team must not be empty"))
+			return
+		}
+		parentName = *req.ParentTeam
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	team, err := storage.SetTeamParent(ctx, teamName, parentName)
+	if err != nil {
+		logger.Warn("cannot set team parent", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	handler.RespondJSON(w, http.StatusOK, team)
+}
+
+func HandlerTeamSubtree(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		teamSubtree(w, r, storage, logger)
+	}
+}
+
+func teamSubtree(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	teamName, err := teamNameParam(r)
+	if err != nil {
+		handler.RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	subtree, err := storage.GetTeamSubtree(ctx, teamName)
+	if err != nil {
+		logger.Warn("cannot get team subtree", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	handler.RespondJSON(w, http.StatusOK, subtree)
+}
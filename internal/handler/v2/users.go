@@ -0,0 +1,139 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/handler"
+	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"go.uber.org/zap"
+)
+
+func HandlerUserGet(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userGet(w, r, storage, logger)
+	}
+}
+
+func userGet(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	userID, err := userIDParam(r)
+	if err != nil {
+		handler.RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	user, err := storage.GetUser(ctx, userID)
+	if err != nil {
+		logger.Warn("cannot get user", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	handler.RespondJSON(w, http.StatusOK, user)
+}
+
+type setActiveRequest struct {
+	IsActive bool   `json:"is_active"`
+	TeamName string `json:"team_name,omitempty"` // Если задано — меняется только членство в команде
+}
+
+func HandlerUserSetActive(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userSetActive(w, r, storage, logger)
+	}
+}
+
+func userSetActive(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	userID, err := userIDParam(r)
+	if err != nil {
+		handler.RespondError(w, err)
+		return
+	}
+
+	var req setActiveRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		logger.Warn("cannot decode json", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	user, err := storage.SetIsActive(ctx, userID, req.TeamName, req.IsActive)
+	if err != nil {
+		logger.Warn("cannot set isActive", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	handler.RespondJSON(w, http.StatusOK, user)
+}
+
+type setSeniorityRequest struct {
+	Seniority api.Seniority `json:"seniority"`
+}
+
+func HandlerUserSetSeniority(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userSetSeniority(w, r, storage, logger)
+	}
+}
+
+func userSetSeniority(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	userID, err := userIDParam(r)
+	if err != nil {
+		handler.RespondError(w, err)
+		return
+	}
+
+	var req setSeniorityRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		logger.Warn("cannot decode json", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	user, err := storage.SetUserSeniority(ctx, userID, req.Seniority)
+	if err != nil {
+		logger.Warn("cannot set seniority", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	handler.RespondJSON(w, http.StatusOK, user)
+}
+
+func HandlerUserReviews(storage *repository.Storage, logger *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userReviews(w, r, storage, logger)
+	}
+}
+
+func userReviews(w http.ResponseWriter, r *http.Request, storage *repository.Storage, logger *zap.SugaredLogger) {
+	userID, err := userIDParam(r)
+	if err != nil {
+		handler.RespondError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	resp, err := storage.GetReview(ctx, userID)
+	if err != nil {
+		logger.Warn("cannot get review", zap.Error(err))
+		handler.RespondError(w, err)
+		return
+	}
+
+	logger.Debug("sending HTTP 200 response")
+	handler.RespondJSON(w, http.StatusOK, resp.V2())
+}
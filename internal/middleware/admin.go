@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/F3dosik/PRS.git/internal/handler"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+)
+
+// WithAdminToken пропускает только запросы с заголовком Authorization: Bearer <token>.
+// Пустой token закрывает маршруты целиком.
+func WithAdminToken(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if token == "" {
+				handler.RespondError(w, api.NewAPIError(api.ErrUnauthorized, "admin API is disabled, set ADMIN_TOKEN"))
+				return
+			}
+
+			got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				handler.RespondError(w, api.NewAPIError(api.ErrUnauthorized, "admin token is missing or invalid"))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WithReadDeadline продлевает дедлайн чтения тела до timeout для запросов к paths.
+// Подключается до проверки OpenAPI: она читает тело целиком раньше хендлера, и большое тело
+// не уложилось бы в ReadTimeout сервера.
+func WithReadDeadline(timeout time.Duration, logger *zap.SugaredLogger, paths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(paths, r.URL.Path) {
+				if err := http.NewResponseController(w).SetReadDeadline(time.Now().Add(timeout)); err != nil {
+					logger.Warnw("cannot extend read deadline", "path", r.URL.Path, "error", err)
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// slowBody отдаёт тело по байту с паузой, растягивая чтение дольше ReadTimeout сервера.
+type slowBody struct {
+	n     int
+	pause time.Duration
+}
+
+func (b *slowBody) Read(p []byte) (int, error) {
+	if b.n == 0 {
+		return 0, io.EOF
+	}
+	time.Sleep(b.pause)
+	b.n--
+	p[0] = 'x'
+	return 1, nil
+}
+
+func TestWithReadDeadline(t *testing.T) {
+	read := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			http.Error(w, err.Error(), http.StatusRequestTimeout)
+		}
+	})
+	ts := httptest.NewUnstartedServer(WithReadDeadline(time.Second, zap.NewNop().Sugar(), "/import")(read))
+	ts.Config.ReadTimeout = 100 * time.Millisecond
+	ts.Start()
+	defer ts.Close()
+
+	tests := []struct {
+		path string
+		ok   bool
+	}{
+		{"/import", true},
+		{"/other", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, ts.URL+tt.path, &slowBody{n: 5, pause: 50 * time.Millisecond})
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := ts.Client().Do(req)
+			ok := err == nil && resp.StatusCode == http.StatusOK
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+			if ok != tt.ok {
+				t.Fatalf("request to %s succeeded = %v (%v), want %v", tt.path, ok, err, tt.ok)
+			}
+		})
+	}
+}
@@ -64,3 +64,8 @@ func WithLogging(logger *zap.SugaredLogger) func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// Unwrap открывает исходный ResponseWriter для http.ResponseController (Flush, дедлайны записи).
+func (r *loggingResponseWriter) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,222 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/F3dosik/PRS.git/internal/handler"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"go.uber.org/zap"
+)
+
+// ValidationMode — что проверяется по спецификации OpenAPI.
+type ValidationMode string
+
+const (
+	ValidationOff      ValidationMode = "off"
+	ValidationRequests ValidationMode = "requests"
+	// ValidationTest дополнительно проверяет ответы: ответ, расходящийся со спецификацией,
+	// заменяется на 500, чтобы расхождение не прошло незамеченным в тестовом окружении.
+	ValidationTest ValidationMode = "test"
+)
+
+func init() {
+	// Тела /holidays/import (iCalendar) и потокового /pullRequest/import (NDJSON) разбирают
+	// хендлеры, по спецификации проверяется только их наличие; так же проверяется выгрузка /admin/export
+	openapi3filter.RegisterBodyDecoder("text/calendar", openapi3filter.FileBodyDecoder)
+	openapi3filter.RegisterBodyDecoder("application/x-ndjson", openapi3filter.FileBodyDecoder)
+	openapi3filter.RegisterBodyDecoder("application/zip", openapi3filter.FileBodyDecoder)
+}
+
+// LoadOpenAPI разбирает и проверяет спецификацию name из fsys. Ссылки на другие файлы
+// (openapi.yml#/components/...) разрешаются относительно name внутри fsys.
+func LoadOpenAPI(fsys fs.FS, name string) (*openapi3.T, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, fmt.Errorf("read openapi spec %s: %w", name, err)
+	}
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(_ *openapi3.Loader, location *url.URL) ([]byte, error) {
+		return fs.ReadFile(fsys, location.Path)
+	}
+
+	doc, err := loader.LoadFromDataWithPath(data, &url.URL{Path: name})
+	if err != nil {
+		return nil, fmt.Errorf("parse openapi spec %s: %w", name, err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("invalid openapi spec %s: %w", name, err)
+	}
+
+	return doc, nil
+}
+
+// WithOpenAPIValidation проверяет запросы (и в режиме ValidationTest — ответы) по спецификациям;
+// маршрут ищется во всех спецификациях по очереди с учётом их servers. Маршруты, которых нет ни
+// в одной спецификации, пропускаются без проверки.
+func WithOpenAPIValidation(docs []*openapi3.T, mode ValidationMode, logger *zap.SugaredLogger) (func(http.Handler) http.Handler, error) {
+	docRouters := make([]routers.Router, len(docs))
+	for i, doc := range docs {
+		router, err := gorillamux.NewRouter(doc)
+		if err != nil {
+			return nil, fmt.Errorf("build openapi router: %w", err)
+		}
+		docRouters[i] = router
+	}
+
+	options := &openapi3filter.Options{
+		AuthenticationFunc:    openapi3filter.NoopAuthenticationFunc,
+		IncludeResponseStatus: true,
+	}
+	options.WithCustomSchemaErrorFunc(schemaErrorMessage)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams := findRoute(docRouters, r)
+			if route == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Клиенты, не указавшие Content-Type, исторически отправляли JSON
+			if r.Header.Get("Content-Type") == "" && route.Operation.RequestBody != nil &&
+				route.Operation.RequestBody.Value.Content.Get("application/json") != nil {
+				r.Header.Set("Content-Type", "application/json")
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    options,
+			}
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				logger.Debugw("request does not match openapi spec", "err", err)
+				handler.RespondError(w, requestError(err))
+				return
+			}
+
+			if mode != ValidationTest {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			bw := &bufferedResponseWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(bw, r)
+			if bw.passthrough {
+				return
+			}
+
+			err := openapi3filter.ValidateResponse(r.Context(), (&openapi3filter.ResponseValidationInput{
+				RequestValidationInput: input,
+				Status:                 bw.status,
+				Header:                 w.Header(),
+				Options:                options,
+			}).SetBodyBytes(bw.body.Bytes()))
+			if err != nil {
+				logger.Errorw("response does not match openapi spec",
+					"method", r.Method,
+					"path", route.Path,
+					"status", bw.status,
+					"err", err,
+				)
+				handler.RespondError(w, api.NewAPIError(handler.ErrInternal, "response does not match openapi spec: "+err.Error()))
+				return
+			}
+
+			w.WriteHeader(bw.status)
+			_, _ = w.Write(bw.body.Bytes())
+		})
+	}, nil
+}
+
+func findRoute(docRouters []routers.Router, r *http.Request) (*routers.Route, map[string]string) {
+	for _, router := range docRouters {
+		if route, pathParams, err := router.FindRoute(r); err == nil {
+			return route, pathParams
+		}
+	}
+
+	return nil, nil
+}
+
+// requestError переводит ошибку проверки запроса в ошибку API: тело — INVALID_JSON, как при
+// разборе в хендлерах, параметры — INVALID_PARAMETER.
+func requestError(err error) error {
+	var reqErr *openapi3filter.RequestError
+	if errors.As(err, &reqErr) && reqErr.RequestBody != nil {
+		return api.NewAPIError(api.ErrInvalidJSON, reqErr.Error())
+	}
+
+	return api.NewAPIError(api.ErrInvalidParameter, err.Error())
+}
+
+// schemaErrorMessage сокращает ошибку схемы до пути и причины, без дампа схемы и значения.
+func schemaErrorMessage(err *openapi3.SchemaError) string {
+	if pointer := err.JSONPointer(); len(pointer) > 0 {
+		return fmt.Sprintf("%q: %s", "/"+strings.Join(pointer, "/"), err.Reason)
+	}
+
+	return err.Reason
+}
+
+// bufferedResponseWriter копит JSON-ответ для проверки. Остальные ответы (например, поток
+// text/event-stream) передаются клиенту сразу и не проверяются.
+type bufferedResponseWriter struct {
+	http.ResponseWriter
+	status      int
+	body        bytes.Buffer
+	wroteHeader bool
+	passthrough bool
+}
+
+func (w *bufferedResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = statusCode
+
+	mediaType, _, _ := mime.ParseMediaType(w.Header().Get("Content-Type"))
+	if mediaType != "application/json" {
+		w.passthrough = true
+		w.ResponseWriter.WriteHeader(statusCode)
+	}
+}
+
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.passthrough {
+		return w.ResponseWriter.Write(b)
+	}
+
+	return w.body.Write(b)
+}
+
+// FlushError нужен http.ResponseController: буферизованный ответ отправляется целиком после проверки.
+func (w *bufferedResponseWriter) FlushError() error {
+	if !w.passthrough {
+		return nil
+	}
+
+	return http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+func (w *bufferedResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
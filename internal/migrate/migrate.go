@@ -0,0 +1,404 @@
+// Package migrate применяет встроенные SQL-миграции. Версия схемы хранится в таблице
+// schema_migrations в том же виде, что у migrate/migrate, поэтому базы, размеченные
+// контейнером, подхватываются без преобразований. Каждая миграция выполняется в своей
+// транзакции вместе с записью новой версии; одновременный запуск нескольких экземпляров
+// сериализуется advisory-блокировкой.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+var (
+	// ErrSchemaTooNew — база размечена версией, которой нет среди миграций бинаря.
+	ErrSchemaTooNew = errors.New("database schema is newer than the binary supports")
+	// ErrDirty — прошлая миграция (например, контейнером migrate/migrate) не завершилась;
+	// схему нужно поправить вручную и отметить версию командой force.
+	ErrDirty = errors.New("database schema is dirty")
+)
+
+// lockID — ключ pg_advisory_lock, под которым выполняются миграции
+const lockID int64 = 0x70727320
+
+var fileName = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+	hasDown bool
+}
+
+// Step — выполненная миграция: Up применяет её, иначе откатывает.
+type Step struct {
+	Version uint64
+	Name    string
+	Up      bool
+}
+
+type Status struct {
+	Version uint64 // 0 — миграции не применялись
+	Dirty   bool
+	Latest  uint64
+	Pending []Migration // Ещё не применённые миграции, по возрастанию версии
+}
+
+// Load читает из корня fsys файлы вида 000001_name.up.sql и 000001_name.down.sql.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+
+	byVersion := make(map[uint64]*Migration)
+	hasUp := make(map[uint64]bool)
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("migration %s: invalid version", entry.Name())
+		}
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d: conflicting names %q and %q", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(data)
+			hasUp[version] = true
+		} else {
+			m.Down = string(data)
+			m.hasDown = true
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for version, m := range byVersion {
+		if !hasUp[version] {
+			return nil, fmt.Errorf("migration %d %s has no up file", version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// Open готовит миграции из fsys для базы dsn; соединение открывается при первом вызове.
+func Open(dsn string, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open db: %w", err)
+	}
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+func (m *Migrator) Close() error {
+	return m.db.Close()
+}
+
+func (m *Migrator) Migrations() []Migration {
+	return m.migrations
+}
+
+// Latest — версия последней встроенной миграции, 0, если миграций нет.
+func (m *Migrator) Latest() uint64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+func (m *Migrator) Status(ctx context.Context) (Status, error) {
+	version, dirty, err := readVersion(ctx, m.db)
+	if err != nil {
+		return Status{}, err
+	}
+
+	status := Status{Version: version, Dirty: dirty, Latest: m.Latest()}
+	for _, migration := range m.migrations {
+		if migration.Version > version {
+			status.Pending = append(status.Pending, migration)
+		}
+	}
+
+	return status, nil
+}
+
+// Check проверяет, что сервер может работать со схемой: она не помечена dirty
+// и не новее последней встроенной миграции. Отстающая схема ошибкой не считается.
+func (m *Migrator) Check(ctx context.Context) (Status, error) {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return status, err
+	}
+	if status.Dirty {
+		return status, fmt.Errorf("%w: version %d", ErrDirty, status.Version)
+	}
+	if status.Version > status.Latest {
+		return status, fmt.Errorf("%w: database is at version %d, latest known is %d", ErrSchemaTooNew, status.Version, status.Latest)
+	}
+
+	return status, nil
+}
+
+// Up применяет все ещё не применённые миграции.
+func (m *Migrator) Up(ctx context.Context) ([]Step, error) {
+	return m.Goto(ctx, m.Latest())
+}
+
+// Down откатывает одну последнюю применённую миграцию; на пустой схеме ничего не делает.
+func (m *Migrator) Down(ctx context.Context) ([]Step, error) {
+	var steps []Step
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		current, err := m.current(ctx, conn)
+		if err != nil || current == 0 {
+			return err
+		}
+		i := m.index(current)
+		target := uint64(0)
+		if i > 0 {
+			target = m.migrations[i-1].Version
+		}
+		steps, err = m.migrate(ctx, conn, current, target)
+		return err
+	})
+
+	return steps, err
+}
+
+// Goto применяет или откатывает миграции так, чтобы схема оказалась на версии target;
+// target 0 откатывает все миграции.
+func (m *Migrator) Goto(ctx context.Context, target uint64) ([]Step, error) {
+	if target != 0 && m.index(target) < 0 {
+		return nil, fmt.Errorf("unknown migration version %d", target)
+	}
+
+	var steps []Step
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		current, err := m.current(ctx, conn)
+		if err != nil {
+			return err
+		}
+		steps, err = m.migrate(ctx, conn, current, target)
+		return err
+	})
+
+	return steps, err
+}
+
+// Force записывает версию схемы и снимает отметку dirty, не выполняя миграций.
+func (m *Migrator) Force(ctx context.Context, version uint64) error {
+	if version != 0 && m.index(version) < 0 {
+		return fmt.Errorf("unknown migration version %d", version)
+	}
+
+	return m.locked(ctx, func(conn *sql.Conn) error {
+		if err := ensureTable(ctx, conn); err != nil {
+			return err
+		}
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("begin tx: %w", err)
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		if err := setVersion(ctx, tx, version); err != nil {
+			return err
+		}
+		return tx.Commit()
+	})
+}
+
+// current возвращает версию схемы, если с ней можно мигрировать дальше.
+func (m *Migrator) current(ctx context.Context, conn *sql.Conn) (uint64, error) {
+	if err := ensureTable(ctx, conn); err != nil {
+		return 0, err
+	}
+	version, dirty, err := readVersion(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("%w: version %d", ErrDirty, version)
+	}
+	if version != 0 && m.index(version) < 0 {
+		if version > m.Latest() {
+			return 0, fmt.Errorf("%w: database is at version %d, latest known is %d", ErrSchemaTooNew, version, m.Latest())
+		}
+		return 0, fmt.Errorf("database is at unknown migration version %d", version)
+	}
+
+	return version, nil
+}
+
+// migrate выполняет шаги от current к target и возвращает выполненные, в том числе при ошибке.
+func (m *Migrator) migrate(ctx context.Context, conn *sql.Conn, current, target uint64) ([]Step, error) {
+	var steps []Step
+	if target >= current {
+		for _, migration := range m.migrations {
+			if migration.Version <= current || migration.Version > target {
+				continue
+			}
+			if err := apply(ctx, conn, migration.Up, migration.Version); err != nil {
+				return steps, fmt.Errorf("apply migration %d %s: %w", migration.Version, migration.Name, err)
+			}
+			steps = append(steps, Step{Version: migration.Version, Name: migration.Name, Up: true})
+		}
+		return steps, nil
+	}
+
+	for i := m.index(current); i >= 0 && m.migrations[i].Version > target; i-- {
+		migration := m.migrations[i]
+		if !migration.hasDown {
+			return steps, fmt.Errorf("migration %d %s has no down file", migration.Version, migration.Name)
+		}
+		previous := uint64(0)
+		if i > 0 {
+			previous = m.migrations[i-1].Version
+		}
+		if err := apply(ctx, conn, migration.Down, previous); err != nil {
+			return steps, fmt.Errorf("revert migration %d %s: %w", migration.Version, migration.Name, err)
+		}
+		steps = append(steps, Step{Version: migration.Version, Name: migration.Name})
+	}
+
+	return steps, nil
+}
+
+func (m *Migrator) index(version uint64) int {
+	for i, migration := range m.migrations {
+		if migration.Version == version {
+			return i
+		}
+	}
+	return -1
+}
+
+// locked выполняет fn на выделенном соединении под advisory-блокировкой.
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockID); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	// Блокировка сессионная: без явного снятия она останется на соединении в пуле
+	defer func() {
+		_, _ = conn.ExecContext(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock($1)`, lockID)
+	}()
+
+	return fn(conn)
+}
+
+// apply выполняет SQL миграции и записывает новую версию в одной транзакции.
+// Запрос без аргументов уходит по простому протоколу, поэтому файл может содержать несколько команд.
+func apply(ctx context.Context, conn *sql.Conn, query string, version uint64) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return err
+	}
+	if err := setVersion(ctx, tx, version); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// ensureTable создаёт schema_migrations в формате migrate/migrate.
+func ensureTable(ctx context.Context, q queryer) error {
+	const query = `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version bigint NOT NULL PRIMARY KEY,
+		dirty boolean NOT NULL
+	)`
+	if _, err := q.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+	return nil
+}
+
+// readVersion возвращает версию 0, если таблицы schema_migrations ещё нет или она пуста.
+func readVersion(ctx context.Context, q queryer) (uint64, bool, error) {
+	var exists bool
+	if err := q.QueryRowContext(ctx, `SELECT to_regclass('schema_migrations') IS NOT NULL`).Scan(&exists); err != nil {
+		return 0, false, fmt.Errorf("check schema_migrations: %w", err)
+	}
+	if !exists {
+		return 0, false, nil
+	}
+
+	var (
+		version int64
+		dirty   bool
+	)
+	err := q.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("read schema version: %w", err)
+	}
+	if version < 0 {
+		return 0, dirty, nil
+	}
+
+	return uint64(version), dirty, nil
+}
+
+// setVersion оставляет в schema_migrations одну строку с версией, как migrate/migrate; версия 0 — пустая таблица.
+func setVersion(ctx context.Context, q queryer, version uint64) error {
+	if _, err := q.ExecContext(ctx, `DELETE FROM schema_migrations`); err != nil {
+		return fmt.Errorf("reset schema version: %w", err)
+	}
+	if version == 0 {
+		return nil
+	}
+	if _, err := q.ExecContext(ctx, `INSERT INTO schema_migrations (version, dirty) VALUES ($1, false)`, int64(version)); err != nil {
+		return fmt.Errorf("write schema version: %w", err)
+	}
+	return nil
+}
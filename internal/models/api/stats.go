@@ -1,7 +0,0 @@
-package api
-
-type StatsResponse struct {
-    TotalPR           int               `json:"total_pr"`
-    OpenPR            int               `json:"open_pr"`
-    ReviewAssignments map[string]int    `json:"review_assignments"` // user_id -> count
-}
\ No newline at end of file
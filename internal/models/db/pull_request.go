@@ -17,6 +17,7 @@ type PullRequest struct {
 	ID                uuid.UUID
 	Title             string
 	AuthorID          uuid.UUID // Не указатель, чтобы всегда иметь автора
+	TeamID            *uuid.UUID
 	Status            PrStatus
 	Reviewer1ID       *uuid.UUID
 	Reviewer2ID       *uuid.UUID
@@ -9,5 +9,6 @@ import (
 type Team struct {
 	ID        uuid.UUID
 	Name      string
+	ParentID  *uuid.UUID
 	CreatedAt time.Time
 }
@@ -0,0 +1,16 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TeamMembership struct {
+	UserID    uuid.UUID
+	TeamID    uuid.UUID
+	Role      string
+	IsActive  bool
+	IsPrimary bool
+	CreatedAt time.Time
+}
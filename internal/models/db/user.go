@@ -10,6 +10,5 @@ type User struct {
 	ID uuid.UUID
 	Name string
 	IsActive bool
-	TeamID *uuid.UUID
 	CreatedAt time.Time
 }
\ No newline at end of file
@@ -0,0 +1,159 @@
+// Package notify доставляет пользователям уведомления о событиях PRS.
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CursorName — имя потребителя журнала событий, под которым хранится позиция уведомлений.
+const CursorName = "notify.slack"
+
+const batchSize = 100
+
+// Store — журнал событий и привязки пользователей к Slack.
+type Store interface {
+	EventsAfter(ctx context.Context, afterID int64, limit int) ([]api.Event, error)
+	LatestEventID(ctx context.Context) (int64, error)
+	EventCursor(ctx context.Context, name string) (int64, bool, error)
+	SaveEventCursor(ctx context.Context, name string, eventID int64) error
+	SlackIDs(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]string, error)
+}
+
+// Message — сообщение в канал или личные сообщения пользователя Slack.
+type Message struct {
+	Channel string
+	Text    string
+}
+
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Notifier читает журнал событий и отправляет сообщения получателям события.
+type Notifier struct {
+	store     Store
+	sender    Sender
+	templates Templates
+	logger    *zap.SugaredLogger
+}
+
+func NewNotifier(store Store, sender Sender, templates Templates, logger *zap.SugaredLogger) *Notifier {
+	return &Notifier{store: store, sender: sender, templates: templates, logger: logger}
+}
+
+// Poll обрабатывает новые события и возвращает количество отправленных сообщений.
+// При первом запуске позиция ставится на конец журнала, чтобы не рассылать историю.
+// Сообщение, которое не удалось отправить после всех попыток, пропускается:
+// доставка не блокирует остальные уведомления.
+func (n *Notifier) Poll(ctx context.Context) (int, error) {
+	cursor, found, err := n.store.EventCursor(ctx, CursorName)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		if cursor, err = n.store.LatestEventID(ctx); err != nil {
+			return 0, err
+		}
+		return 0, n.store.SaveEventCursor(ctx, CursorName, cursor)
+	}
+
+	sent := 0
+	for {
+		events, err := n.store.EventsAfter(ctx, cursor, batchSize)
+		if err != nil {
+			return sent, err
+		}
+
+		for _, e := range events {
+			delivered, err := n.deliver(ctx, e)
+			sent += delivered
+			if err != nil {
+				return sent, err
+			}
+			cursor = e.ID
+			if err = n.store.SaveEventCursor(ctx, CursorName, cursor); err != nil {
+				return sent, err
+			}
+		}
+
+		if len(events) < batchSize {
+			return sent, nil
+		}
+	}
+}
+
+// deliver отправляет сообщения по событию. Ошибка возвращается, только если
+// прерван контекст или недоступно хранилище.
+func (n *Notifier) deliver(ctx context.Context, e api.Event) (int, error) {
+	if !n.templates.Has(e.Type) {
+		return 0, nil
+	}
+
+	var data map[string]any
+	if err := json.Unmarshal(e.Payload, &data); err != nil {
+		n.logger.Warnw("cannot decode event payload", "event_id", e.ID, "err", err)
+		return 0, nil
+	}
+
+	text, err := n.templates.Render(e.Type, data)
+	if err != nil {
+		n.logger.Warnw("cannot render notification", "event_id", e.ID, "err", err)
+		return 0, nil
+	}
+
+	recipients, err := recipients(e)
+	if err != nil {
+		n.logger.Warnw("cannot resolve notification recipients", "event_id", e.ID, "err", err)
+		return 0, nil
+	}
+
+	slackIDs, err := n.store.SlackIDs(ctx, recipients)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, userID := range recipients {
+		slackID, ok := slackIDs[userID]
+		if !ok {
+			continue
+		}
+		if err = n.sender.Send(ctx, Message{Channel: slackID, Text: text}); err != nil {
+			if ctx.Err() != nil {
+				return sent, ctx.Err()
+			}
+			n.logger.Errorw("notification delivery failed",
+				"event_id", e.ID,
+				"user_id", userID,
+				"err", err,
+			)
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// recipients — кому адресовано событие: о слиянии узнают ревьюверы PR, об остальных событиях —
+// пользователь события.
+func recipients(e api.Event) ([]uuid.UUID, error) {
+	if e.Type == api.EventPullRequestMerged {
+		var payload api.PullRequestMergedPayload
+		if err := json.Unmarshal(e.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("decode merged payload: %w", err)
+		}
+		return payload.Reviewers, nil
+	}
+
+	if e.UserID == nil {
+		return nil, nil
+	}
+	return []uuid.UUID{*e.UserID}, nil
+}
@@ -0,0 +1,190 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SlackConfig — параметры доставки в Slack. При заданном Token сообщения отправляются
+// методом chat.postMessage по адресу APIURL, иначе — в Incoming Webhook WebhookURL
+// с указанием канала.
+type SlackConfig struct {
+	APIURL       string
+	Token        string
+	WebhookURL   string
+	RateInterval time.Duration // Минимальный интервал между запросами к Slack
+	MaxAttempts  int
+	Client       *http.Client
+}
+
+func (c SlackConfig) Enabled() bool {
+	return c.Token != "" || c.WebhookURL != ""
+}
+
+// SlackSender отправляет сообщения в Slack с ограничением частоты и повторными попытками.
+type SlackSender struct {
+	cfg SlackConfig
+
+	mu   sync.Mutex
+	next time.Time // Раньше этого момента следующий запрос не отправляется
+}
+
+func NewSlackSender(cfg SlackConfig) *SlackSender {
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 1
+	}
+	return &SlackSender{cfg: cfg}
+}
+
+// slackError — ответ Slack. retryAfter > 0 означает, что запрос можно повторить не раньше чем через retryAfter.
+type slackError struct {
+	status     int
+	code       string
+	retryAfter time.Duration
+	temporary  bool
+}
+
+func (e *slackError) Error() string {
+	if e.code != "" {
+		return fmt.Sprintf("slack: %s (HTTP %d)", e.code, e.status)
+	}
+	return fmt.Sprintf("slack: HTTP %d", e.status)
+}
+
+const baseBackoff = time.Second
+
+// Send отправляет сообщение. Повторяются ответы 429 (с учётом Retry-After), ошибки 5xx и сетевые
+// ошибки; остальные ошибки Slack возвращаются сразу.
+func (s *SlackSender) Send(ctx context.Context, msg Message) error {
+	var err error
+	for attempt := 1; attempt <= s.cfg.MaxAttempts; attempt++ {
+		if err = s.wait(ctx, 0); err != nil {
+			return err
+		}
+
+		err = s.post(ctx, msg)
+		if err == nil {
+			return nil
+		}
+
+		var slackErr *slackError
+		delay := baseBackoff << (attempt - 1)
+		if errors.As(err, &slackErr) {
+			if !slackErr.temporary {
+				return err
+			}
+			if slackErr.retryAfter > 0 {
+				delay = slackErr.retryAfter
+			}
+		}
+		if attempt < s.cfg.MaxAttempts {
+			if err := s.wait(ctx, delay); err != nil {
+				return err
+			}
+		}
+	}
+
+	return fmt.Errorf("send after %d attempts: %w", s.cfg.MaxAttempts, err)
+}
+
+// wait ждёт своей очереди с учётом ограничения частоты и дополнительной паузы delay,
+// которая откладывает и остальные запросы.
+func (s *SlackSender) wait(ctx context.Context, delay time.Duration) error {
+	s.mu.Lock()
+	now := time.Now()
+	at := s.next
+	if at.Before(now) {
+		at = now
+	}
+	if delay > 0 {
+		at = now.Add(delay)
+		s.next = at
+	} else {
+		s.next = at.Add(s.cfg.RateInterval)
+	}
+	s.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(at))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (s *SlackSender) post(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(map[string]string{"channel": msg.Channel, "text": msg.Text})
+	if err != nil {
+		return fmt.Errorf("marshal slack message: %w", err)
+	}
+
+	url := s.cfg.WebhookURL
+	if s.cfg.Token != "" {
+		url = strings.TrimRight(s.cfg.APIURL, "/") + "/chat.postMessage"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if s.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.cfg.Token)
+	}
+
+	resp, err := s.cfg.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("slack request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return fmt.Errorf("read slack response: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		slackErr := &slackError{status: resp.StatusCode, code: "rate_limited", temporary: true}
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			slackErr.retryAfter = time.Duration(seconds) * time.Second
+		}
+		return slackErr
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return &slackError{status: resp.StatusCode, temporary: true}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &slackError{status: resp.StatusCode, code: strings.TrimSpace(string(raw))}
+	}
+
+	// Webhook отвечает текстом "ok", Web API — JSON с полем ok
+	if s.cfg.Token == "" {
+		return nil
+	}
+	var result struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err = json.Unmarshal(raw, &result); err != nil {
+		return fmt.Errorf("decode slack response: %w", err)
+	}
+	if !result.OK {
+		return &slackError{status: resp.StatusCode, code: result.Error, temporary: result.Error == "ratelimited"}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+)
+
+// DefaultTemplates — тексты уведомлений по типам событий. Данные шаблона — поля payload события.
+var DefaultTemplates = map[api.EventType]string{
+	api.EventReviewAssigned: `You have been assigned as {{if .shadow}}a shadow {{end}}reviewer of PR "{{.pull_request_name}}"` +
+		`{{if eq .reason "reassign"}} (reassigned from another reviewer){{else if eq .reason "sla"}} (the previous reviewer missed the review SLA){{end}}.`,
+	api.EventPullRequestMerged: `PR "{{.pull_request_name}}" you were reviewing has been merged.`,
+	api.EventSLABreached:       `Review of PR "{{.pull_request_name}}" is overdue: it was due at {{.due_at}}.`,
+}
+
+// Templates — разобранные шаблоны уведомлений по типам событий.
+type Templates map[api.EventType]*template.Template
+
+// ParseTemplates разбирает шаблоны; пустой текст отключает уведомления о событии.
+func ParseTemplates(texts map[api.EventType]string) (Templates, error) {
+	templates := make(Templates, len(texts))
+	for eventType, text := range texts {
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		tmpl, err := template.New(string(eventType)).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("parse template %s: %w", eventType, err)
+		}
+		templates[eventType] = tmpl
+	}
+
+	return templates, nil
+}
+
+// LoadTemplates возвращает шаблоны по умолчанию, заменённые шаблонами из JSON-файла path
+// вида {"review.assigned": "..."}. Пустой path означает шаблоны по умолчанию.
+func LoadTemplates(path string) (Templates, error) {
+	texts := make(map[api.EventType]string, len(DefaultTemplates))
+	for eventType, text := range DefaultTemplates {
+		texts[eventType] = text
+	}
+
+	if path != "" {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read templates: %w", err)
+		}
+		var overrides map[api.EventType]string
+		if err = json.Unmarshal(raw, &overrides); err != nil {
+			return nil, fmt.Errorf("decode templates: %w", err)
+		}
+		for eventType, text := range overrides {
+			texts[eventType] = text
+		}
+	}
+
+	return ParseTemplates(texts)
+}
+
+func (t Templates) Has(eventType api.EventType) bool {
+	_, ok := t[eventType]
+	return ok
+}
+
+func (t Templates) Render(eventType api.EventType, data map[string]any) (string, error) {
+	var b strings.Builder
+	if err := t[eventType].Execute(&b, data); err != nil {
+		return "", fmt.Errorf("render %s: %w", eventType, err)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+// Пакетные выборки для GraphQL: одна выборка на набор ключей вместо запроса на каждый объект.
+// Отсутствующие ключи в результат не попадают.
+
+// TeamsByNames возвращает команды с их прямыми участниками.
+func (s *Storage) TeamsByNames(ctx context.Context, names []string) (map[string]*api.Team, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT t.name, p.name
+		FROM teams t
+		LEFT JOIN teams p ON p.id = t.parent_id
+		WHERE t.name = ANY($1)
+	`, names)
+	if err != nil {
+		return nil, fmt.Errorf("query teams: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	teams := make(map[string]*api.Team, len(names))
+	for rows.Next() {
+		team := &api.Team{Members: []api.TeamMember{}}
+		if err = rows.Scan(&team.TeamName, &team.ParentTeam); err != nil {
+			return nil, fmt.Errorf("scan team: %w", err)
+		}
+		teams[team.TeamName] = team
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	memberRows, err := s.db.QueryContext(ctx, `
+		SELECT t.name, u.id, u.name, u.is_active AND m.is_active, m.role, u.seniority
+		FROM team_memberships m
+		JOIN users u ON u.id = m.user_id
+		JOIN teams t ON t.id = m.team_id
+		WHERE t.name = ANY($1)
+		ORDER BY m.created_at, u.name
+	`, names)
+	if err != nil {
+		return nil, fmt.Errorf("query team members: %w", err)
+	}
+
+	defer func() {
+		if closeErr := memberRows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	for memberRows.Next() {
+		var (
+			teamName string
+			member   api.TeamMember
+		)
+		err = memberRows.Scan(&teamName, &member.UserID, &member.Username, &member.IsActive, &member.Role, &member.Seniority)
+		if err != nil {
+			return nil, fmt.Errorf("scan member: %w", err)
+		}
+		if team, ok := teams[teamName]; ok {
+			team.Members = append(team.Members, member)
+		}
+	}
+
+	if err = memberRows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return teams, nil
+}
+
+// UsersByIDs возвращает пользователей с их членствами в командах, как loadUser.
+func (s *Storage) UsersByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*api.User, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, name, is_active, seniority
+		FROM users
+		WHERE id = ANY($1)
+	`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("query users: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	users := make(map[uuid.UUID]*api.User, len(ids))
+	for rows.Next() {
+		user := &api.User{Teams: []api.UserTeam{}}
+		if err = rows.Scan(&user.UserID, &user.Username, &user.IsActive, &user.Seniority); err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+		users[user.UserID] = user
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	membershipRows, err := s.db.QueryContext(ctx, `
+		SELECT m.user_id, t.name, m.role, m.is_active, m.is_primary
+		FROM team_memberships m
+		JOIN teams t ON t.id = m.team_id
+		WHERE m.user_id = ANY($1)
+		ORDER BY m.user_id, m.is_primary DESC, m.created_at
+	`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("query memberships: %w", err)
+	}
+
+	defer func() {
+		if closeErr := membershipRows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	for membershipRows.Next() {
+		var (
+			userID uuid.UUID
+			team   api.UserTeam
+		)
+		if err = membershipRows.Scan(&userID, &team.TeamName, &team.Role, &team.IsActive, &team.IsPrimary); err != nil {
+			return nil, fmt.Errorf("scan membership: %w", err)
+		}
+		user, ok := users[userID]
+		if !ok {
+			continue
+		}
+		if team.IsPrimary {
+			user.TeamName = &team.TeamName
+		}
+		user.Teams = append(user.Teams, team)
+	}
+
+	if err = membershipRows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return users, nil
+}
+
+// PullRequestsByIDs возвращает PR без списка назначений (см. AssignmentsByPullRequestIDs).
+func (s *Storage) PullRequestsByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*api.PullRequest, error) {
+	prs, err := s.queryPullRequests(ctx, `
+		SELECT pr.id, pr.title, pr.author_id, t.name, pr.status, pr.reviewer1_id, pr.reviewer2_id,
+			pr.shadow_reviewer_id, pr.created_at, pr.merged_at, NULL::uuid
+		FROM pull_request pr
+		LEFT JOIN teams t ON t.id = pr.team_id
+		WHERE pr.id = ANY($1)
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uuid.UUID]*api.PullRequest, len(prs))
+	for i := range prs {
+		result[prs[i].pr.PullRequestID] = &prs[i].pr
+	}
+
+	return result, nil
+}
+
+// ReviewsByUserIDs возвращает PR, где пользователь сейчас обязательный или теневой ревьювер,
+// от новых к старым.
+func (s *Storage) ReviewsByUserIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]api.PullRequest, error) {
+	prs, err := s.queryPullRequests(ctx, `
+		SELECT pr.id, pr.title, pr.author_id, t.name, pr.status, pr.reviewer1_id, pr.reviewer2_id,
+			pr.shadow_reviewer_id, pr.created_at, pr.merged_at, r.reviewer_id
+		FROM pull_request pr
+		CROSS JOIN LATERAL unnest(ARRAY[pr.reviewer1_id, pr.reviewer2_id, pr.shadow_reviewer_id]) AS r(reviewer_id)
+		LEFT JOIN teams t ON t.id = pr.team_id
+		WHERE r.reviewer_id = ANY($1)
+		ORDER BY pr.created_at DESC, pr.id
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uuid.UUID][]api.PullRequest, len(ids))
+	for _, row := range prs {
+		result[*row.key] = append(result[*row.key], row.pr)
+	}
+
+	return result, nil
+}
+
+type pullRequestRow struct {
+	pr  api.PullRequest
+	key *uuid.UUID
+}
+
+// queryPullRequests сканирует строки PR; последний столбец — ключ группировки (может быть NULL).
+func (s *Storage) queryPullRequests(ctx context.Context, query string, args ...any) ([]pullRequestRow, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query pull requests: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	var prs []pullRequestRow
+	for rows.Next() {
+		var (
+			row                      pullRequestRow
+			teamName                 *string
+			reviewer1ID, reviewer2ID *uuid.UUID
+		)
+		err = rows.Scan(&row.pr.PullRequestID, &row.pr.PullRequestName, &row.pr.AuthorID, &teamName, &row.pr.Status,
+			&reviewer1ID, &reviewer2ID, &row.pr.ShadowReviewer, &row.pr.CreatedAt, &row.pr.MergedAt, &row.key)
+		if err != nil {
+			return nil, fmt.Errorf("scan pull request: %w", err)
+		}
+		row.pr.TeamName = derefString(teamName)
+		row.pr.AssignedReviewers = makeReviewers(reviewer1ID, reviewer2ID)
+		prs = append(prs, row)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return prs, nil
+}
+
+// AssignmentsByPullRequestIDs возвращает действующие назначения PR, как loadAssignments.
+func (s *Storage) AssignmentsByPullRequestIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]api.ReviewAssignment, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT a.pull_request_id, a.reviewer_id, a.source, t.name, a.decision_id, a.shadow
+		FROM review_assignments a
+		JOIN pull_request pr ON pr.id = a.pull_request_id
+		LEFT JOIN teams t ON t.id = a.source_team_id
+		WHERE a.pull_request_id = ANY($1)
+			AND (a.unassigned_at IS NULL OR a.unassigned_at = pr.merged_at)
+		ORDER BY a.id
+	`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("query review assignments: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	assignments := make(map[uuid.UUID][]api.ReviewAssignment, len(ids))
+	for rows.Next() {
+		var (
+			prID uuid.UUID
+			a    api.ReviewAssignment
+		)
+		if err = rows.Scan(&prID, &a.ReviewerID, &a.Source, &a.SourceTeam, &a.DecisionID, &a.Shadow); err != nil {
+			return nil, fmt.Errorf("scan review assignment: %w", err)
+		}
+		assignments[prID] = append(assignments[prID], a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return assignments, nil
+}
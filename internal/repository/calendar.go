@@ -0,0 +1,489 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/calendar"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+// storedSchedule — строка team_schedules или user_schedules.
+type storedSchedule struct {
+	timezone    string
+	workDays    int
+	startMinute int
+	endMinute   int
+}
+
+func (st storedSchedule) schedule() (calendar.Schedule, error) {
+	loc, err := time.LoadLocation(st.timezone)
+	if err != nil {
+		return calendar.Schedule{}, fmt.Errorf("load time zone %q: %w", st.timezone, err)
+	}
+
+	s := calendar.Schedule{Location: loc, Start: st.startMinute, End: st.endMinute}
+	for day := range s.Days {
+		s.Days[day] = st.workDays&(1<<day) != 0
+	}
+	return s, nil
+}
+
+func (st storedSchedule) api() api.WorkSchedule {
+	ws := api.WorkSchedule{
+		TimeZone:  st.timezone,
+		WorkDays:  []string{},
+		WorkStart: calendar.FormatClock(st.startMinute),
+		WorkEnd:   calendar.FormatClock(st.endMinute),
+	}
+	// Дни недели начиная с понедельника
+	for i := 1; i <= 7; i++ {
+		day := time.Weekday(i % 7)
+		if st.workDays&(1<<day) != 0 {
+			ws.WorkDays = append(ws.WorkDays, calendar.FormatWeekday(day))
+		}
+	}
+	return ws
+}
+
+func parseWorkSchedule(ws api.WorkSchedule) (storedSchedule, error) {
+	st := storedSchedule{timezone: ws.TimeZone}
+	if _, err := time.LoadLocation(ws.TimeZone); err != nil {
+		return storedSchedule{}, api.NewAPIError(api.ErrInvalidParameter, "unknown time_zone: "+ws.TimeZone)
+	}
+
+	for _, name := range ws.WorkDays {
+		day, err := calendar.ParseWeekday(name)
+		if err != nil {
+			return storedSchedule{}, api.NewAPIError(api.ErrInvalidParameter, err.Error())
+		}
+		st.workDays |= 1 << day
+	}
+
+	var err error
+	if st.startMinute, err = calendar.ParseClock(ws.WorkStart); err != nil {
+		return storedSchedule{}, api.NewAPIError(api.ErrInvalidParameter, err.Error())
+	}
+	if st.endMinute, err = calendar.ParseClock(ws.WorkEnd); err != nil {
+		return storedSchedule{}, api.NewAPIError(api.ErrInvalidParameter, err.Error())
+	}
+
+	s, err := st.schedule()
+	if err != nil {
+		return storedSchedule{}, err
+	}
+	if err = s.Validate(); err != nil {
+		return storedSchedule{}, api.NewAPIError(api.ErrInvalidParameter, err.Error())
+	}
+
+	return st, nil
+}
+
+func (s *Storage) GetTeamSchedule(ctx context.Context, teamName string) (*api.TeamSchedule, error) {
+	teamID, err := teamIDByName(ctx, s.db, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &api.TeamSchedule{TeamName: teamName, WorkSchedule: api.WorkSchedule{WorkDays: []string{}}}
+	st, found, err := loadTeamSchedule(ctx, s.db, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		schedule.WorkSchedule = st.api()
+	}
+
+	return schedule, nil
+}
+
+// SetTeamSchedule заменяет расписание команды; пустой часовой пояс удаляет расписание.
+func (s *Storage) SetTeamSchedule(ctx context.Context, schedule *api.TeamSchedule) error {
+	teamID, err := teamIDByName(ctx, s.db, schedule.TeamName)
+	if err != nil {
+		return err
+	}
+
+	if schedule.TimeZone == "" {
+		schedule.WorkSchedule = api.WorkSchedule{WorkDays: []string{}}
+		_, err = s.db.ExecContext(ctx, `
+			DELETE FROM team_schedules
+			WHERE team_id = $1
+		`, teamID)
+		if err != nil {
+			return fmt.Errorf("delete team schedule: %w", err)
+		}
+		return nil
+	}
+
+	st, err := parseWorkSchedule(schedule.WorkSchedule)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO team_schedules (team_id, timezone, work_days, start_minute, end_minute)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (team_id) DO UPDATE
+		SET timezone = EXCLUDED.timezone,
+			work_days = EXCLUDED.work_days,
+			start_minute = EXCLUDED.start_minute,
+			end_minute = EXCLUDED.end_minute
+	`, teamID, st.timezone, st.workDays, st.startMinute, st.endMinute)
+	if err != nil {
+		return fmt.Errorf("upsert team schedule: %w", err)
+	}
+	schedule.WorkSchedule = st.api()
+
+	return nil
+}
+
+func (s *Storage) GetUserSchedule(ctx context.Context, userID uuid.UUID) (*api.UserSchedule, error) {
+	if err := checkUserExists(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+
+	schedule := &api.UserSchedule{UserID: userID, WorkSchedule: api.WorkSchedule{WorkDays: []string{}}}
+	st, found, err := loadUserSchedule(ctx, s.db, userID)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		schedule.WorkSchedule = st.api()
+	}
+
+	return schedule, nil
+}
+
+// SetUserSchedule заменяет расписание пользователя; пустой часовой пояс удаляет расписание.
+func (s *Storage) SetUserSchedule(ctx context.Context, schedule *api.UserSchedule) error {
+	if err := checkUserExists(ctx, s.db, schedule.UserID); err != nil {
+		return err
+	}
+
+	if schedule.TimeZone == "" {
+		schedule.WorkSchedule = api.WorkSchedule{WorkDays: []string{}}
+		_, err := s.db.ExecContext(ctx, `
+			DELETE FROM user_schedules
+			WHERE user_id = $1
+		`, schedule.UserID)
+		if err != nil {
+			return fmt.Errorf("delete user schedule: %w", err)
+		}
+		return nil
+	}
+
+	st, err := parseWorkSchedule(schedule.WorkSchedule)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO user_schedules (user_id, timezone, work_days, start_minute, end_minute)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE
+		SET timezone = EXCLUDED.timezone,
+			work_days = EXCLUDED.work_days,
+			start_minute = EXCLUDED.start_minute,
+			end_minute = EXCLUDED.end_minute
+	`, schedule.UserID, st.timezone, st.workDays, st.startMinute, st.endMinute)
+	if err != nil {
+		return fmt.Errorf("upsert user schedule: %w", err)
+	}
+	schedule.WorkSchedule = st.api()
+
+	return nil
+}
+
+// ImportHolidays сохраняет праздники команды (или общие при пустом teamName).
+// replace удаляет ранее загруженные праздники того же владельца; повторный импорт
+// тех же дат обновляет их названия.
+func (s *Storage) ImportHolidays(ctx context.Context, teamName string, holidays []calendar.Holiday, replace bool) (int, error) {
+	var teamID *uuid.UUID
+	if teamName != "" {
+		id, err := teamIDByName(ctx, s.db, teamName)
+		if err != nil {
+			return 0, err
+		}
+		teamID = &id
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if replace {
+		_, err = tx.ExecContext(ctx, `
+			DELETE FROM holidays
+			WHERE team_id IS NOT DISTINCT FROM $1
+		`, teamID)
+		if err != nil {
+			return 0, fmt.Errorf("delete holidays: %w", err)
+		}
+	}
+
+	for _, h := range holidays {
+		var year *int
+		if h.Year != 0 {
+			year = &h.Year
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO holidays (team_id, year, month, day, name)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (team_id, year, month, day) DO UPDATE
+			SET name = EXCLUDED.name
+		`, teamID, year, int(h.Month), h.Day, h.Name)
+		if err != nil {
+			return 0, fmt.Errorf("upsert holiday: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return len(holidays), nil
+}
+
+// GetHolidays возвращает общие праздники и праздники команды teamName;
+// пустой teamName означает праздники всех команд.
+func (s *Storage) GetHolidays(ctx context.Context, teamName string) (*api.HolidaysResponse, error) {
+	var teamID *uuid.UUID
+	if teamName != "" {
+		id, err := teamIDByName(ctx, s.db, teamName)
+		if err != nil {
+			return nil, err
+		}
+		teamID = &id
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT h.year, h.month, h.day, h.name, COALESCE(t.name, '')
+		FROM holidays h
+		LEFT JOIN teams t ON t.id = h.team_id
+		WHERE $1::uuid IS NULL OR h.team_id IS NULL OR h.team_id = $1
+		ORDER BY h.month, h.day, h.year NULLS FIRST, t.name NULLS FIRST
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("query holidays: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	resp := &api.HolidaysResponse{Holidays: []api.Holiday{}}
+	for rows.Next() {
+		var (
+			h          api.Holiday
+			year       *int
+			month, day int
+		)
+		if err = rows.Scan(&year, &month, &day, &h.Name, &h.TeamName); err != nil {
+			return nil, fmt.Errorf("scan holiday: %w", err)
+		}
+		if year == nil {
+			h.Recurring = true
+			h.Date = fmt.Sprintf("%02d-%02d", month, day)
+		} else {
+			h.Date = fmt.Sprintf("%04d-%02d-%02d", *year, month, day)
+		}
+		resp.Holidays = append(resp.Holidays, h)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetAvailability сообщает, находится ли пользователь в рабочем времени в момент at.
+// Без личного расписания действует расписание основной команды.
+func (s *Storage) GetAvailability(ctx context.Context, userID uuid.UUID, at time.Time) (*api.Availability, error) {
+	if err := checkUserExists(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+
+	var teamID uuid.UUID
+	err := s.db.QueryRowContext(ctx, `
+		SELECT team_id FROM team_memberships
+		WHERE user_id = $1
+			AND is_primary
+	`, userID).Scan(&teamID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("query primary team: %w", err)
+	}
+
+	schedule, err := newScheduleCache(s.db).get(ctx, userID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	local := at.In(schedule.Location)
+	return &api.Availability{
+		UserID:          userID,
+		TimeZone:        schedule.Location.String(),
+		At:              at,
+		LocalTime:       local.Format(time.RFC3339),
+		Available:       schedule.IsWorking(at),
+		NextAvailableAt: schedule.NextOpen(at),
+	}, nil
+}
+
+func checkUserExists(ctx context.Context, q querier, userID uuid.UUID) error {
+	var exist bool
+	err := q.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)
+	`, userID).Scan(&exist)
+	if err != nil {
+		return fmt.Errorf("query exist user_id: %w", err)
+	}
+	if !exist {
+		return api.NewAPIError(api.ErrNotFound, "user not found")
+	}
+	return nil
+}
+
+func loadTeamSchedule(ctx context.Context, q querier, teamID uuid.UUID) (storedSchedule, bool, error) {
+	var st storedSchedule
+	err := q.QueryRowContext(ctx, `
+		SELECT timezone, work_days, start_minute, end_minute
+		FROM team_schedules
+		WHERE team_id = $1
+	`, teamID).Scan(&st.timezone, &st.workDays, &st.startMinute, &st.endMinute)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storedSchedule{}, false, nil
+		}
+		return storedSchedule{}, false, fmt.Errorf("query team schedule: %w", err)
+	}
+	return st, true, nil
+}
+
+func loadUserSchedule(ctx context.Context, q querier, userID uuid.UUID) (storedSchedule, bool, error) {
+	var st storedSchedule
+	err := q.QueryRowContext(ctx, `
+		SELECT timezone, work_days, start_minute, end_minute
+		FROM user_schedules
+		WHERE user_id = $1
+	`, userID).Scan(&st.timezone, &st.workDays, &st.startMinute, &st.endMinute)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storedSchedule{}, false, nil
+		}
+		return storedSchedule{}, false, fmt.Errorf("query user schedule: %w", err)
+	}
+	return st, true, nil
+}
+
+// loadHolidays возвращает общие праздники и праздники команды teamID.
+func loadHolidays(ctx context.Context, q querier, teamID uuid.UUID) ([]calendar.Holiday, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT COALESCE(year, 0), month, day, name
+		FROM holidays
+		WHERE team_id IS NULL OR team_id = $1
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("query holidays: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	var holidays []calendar.Holiday
+	for rows.Next() {
+		var (
+			h     calendar.Holiday
+			month int
+		)
+		if err = rows.Scan(&h.Year, &month, &h.Day, &h.Name); err != nil {
+			return nil, fmt.Errorf("scan holiday: %w", err)
+		}
+		h.Month = time.Month(month)
+		holidays = append(holidays, h)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return holidays, nil
+}
+
+type scheduleKey struct {
+	userID uuid.UUID
+	teamID uuid.UUID
+}
+
+// scheduleCache запоминает расписания в пределах одного прохода, чтобы не читать их
+// повторно для каждого назначения.
+type scheduleCache struct {
+	q         querier
+	schedules map[scheduleKey]calendar.Schedule
+	holidays  map[uuid.UUID][]calendar.Holiday
+}
+
+func newScheduleCache(q querier) *scheduleCache {
+	return &scheduleCache{
+		q:         q,
+		schedules: make(map[scheduleKey]calendar.Schedule),
+		holidays:  make(map[uuid.UUID][]calendar.Holiday),
+	}
+}
+
+// get возвращает расписание пользователя userID, а без него — расписание команды teamID.
+// Без обоих расписаний рабочим считается всё время. uuid.Nil пропускает соответствующий уровень.
+// Праздники берутся общие и команды teamID.
+func (c *scheduleCache) get(ctx context.Context, userID, teamID uuid.UUID) (calendar.Schedule, error) {
+	key := scheduleKey{userID: userID, teamID: teamID}
+	if schedule, ok := c.schedules[key]; ok {
+		return schedule, nil
+	}
+
+	var (
+		st    storedSchedule
+		found bool
+		err   error
+	)
+	if userID != uuid.Nil {
+		if st, found, err = loadUserSchedule(ctx, c.q, userID); err != nil {
+			return calendar.Schedule{}, err
+		}
+	}
+	if !found && teamID != uuid.Nil {
+		if st, found, err = loadTeamSchedule(ctx, c.q, teamID); err != nil {
+			return calendar.Schedule{}, err
+		}
+	}
+
+	schedule := calendar.AlwaysOpen()
+	if found {
+		if schedule, err = st.schedule(); err != nil {
+			return calendar.Schedule{}, err
+		}
+	}
+
+	holidays, ok := c.holidays[teamID]
+	if !ok {
+		if holidays, err = loadHolidays(ctx, c.q, teamID); err != nil {
+			return calendar.Schedule{}, err
+		}
+		c.holidays[teamID] = holidays
+	}
+	schedule.Holidays = holidays
+
+	c.schedules[key] = schedule
+	return schedule, nil
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/assignment"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+type storedDecision struct {
+	id        int64
+	reason    api.DecisionReason
+	seed      uint64
+	version   int
+	input     assignment.Input
+	createdAt time.Time
+}
+
+func saveDecision(ctx context.Context, tx *sql.Tx, prID uuid.UUID, reason api.DecisionReason, in assignment.Input, decision assignment.Decision) (int64, error) {
+	input, err := json.Marshal(in)
+	if err != nil {
+		return 0, fmt.Errorf("marshal decision input: %w", err)
+	}
+
+	// seed хранится в BIGINT с сохранением битового представления
+	var id int64
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO assignment_decisions (pull_request_id, reason, seed, algorithm_version, input)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`, prID, reason, int64(decision.Seed), decision.Version, input).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("insert assignment decision: %w", err)
+	}
+
+	return id, nil
+}
+
+// ExplainAssignment повторяет сохранённые решения о назначении ревьюверов PR и показывает,
+// как был рассмотрен каждый кандидат. nil decisionID означает все решения PR.
+func (s *Storage) ExplainAssignment(ctx context.Context, prID uuid.UUID, decisionID *int64) (*api.ExplainAssignmentResponse, error) {
+	var exist bool
+	err := s.db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM pull_request WHERE id = $1)
+	`, prID).Scan(&exist)
+	if err != nil {
+		return nil, fmt.Errorf("query exist pull_request_id: %w", err)
+	}
+	if !exist {
+		return nil, api.NewAPIError(api.ErrNotFound, "pull request not found")
+	}
+
+	decisions, err := loadDecisions(ctx, s.db, prID, decisionID)
+	if err != nil {
+		return nil, err
+	}
+	if decisionID != nil && len(decisions) == 0 {
+		return nil, api.NewAPIError(api.ErrNotFound, "decision not found")
+	}
+
+	resp := &api.ExplainAssignmentResponse{
+		PullRequestID: prID,
+		Decisions:     make([]api.AssignmentDecision, 0, len(decisions)),
+	}
+	for _, stored := range decisions {
+		recorded, err := decisionReviewers(ctx, s.db, stored.id)
+		if err != nil {
+			return nil, err
+		}
+		resp.Decisions = append(resp.Decisions, explainDecision(stored, recorded))
+	}
+
+	return resp, nil
+}
+
+func explainDecision(stored storedDecision, recorded []uuid.UUID) api.AssignmentDecision {
+	explained := api.AssignmentDecision{
+		DecisionID:       stored.id,
+		Reason:           stored.reason,
+		Seed:             stored.seed,
+		AlgorithmVersion: stored.version,
+		CreatedAt:        stored.createdAt,
+		Labels:           stored.input.Labels,
+		Limit:            stored.input.Limit,
+		RequireSenior:    stored.input.RequireSenior,
+		Shadow:           stored.input.Shadow,
+		Recorded:         recorded,
+		Picked:           []uuid.UUID{},
+		Candidates:       []api.CandidateExplanation{},
+	}
+
+	// Текущий Decide воспроизводит только решения своей версии: повтор старого решения
+	// расходился бы с назначением из-за смены алгоритма, а не из-за ошибки
+	if stored.version != assignment.AlgorithmVersion {
+		explained.ReplayError = fmt.Sprintf("algorithm version %d can't be replayed by version %d",
+			stored.version, assignment.AlgorithmVersion)
+		return explained
+	}
+
+	replayed := assignment.Decide(stored.input, stored.seed)
+	for _, pick := range replayed.Picks {
+		explained.Picked = append(explained.Picked, pick.UserID)
+	}
+	if replayed.Shadow != nil {
+		explained.Picked = append(explained.Picked, replayed.Shadow.UserID)
+	}
+	explained.Reproduced = slices.Equal(explained.Picked, recorded)
+
+	// Оценки идут в том же порядке, что и кандидаты в пулах снимка
+	i := 0
+	for _, pool := range stored.input.Pools {
+		for _, c := range pool.Candidates {
+			eval := replayed.Evaluations[i]
+			i++
+
+			candidate := api.CandidateExplanation{
+				UserID:     c.UserID,
+				Source:     api.ReviewerSource(pool.Source),
+				SourceTeam: pool.TeamName,
+				Eligible:   eval.Eligible,
+				Reason:     eval.Reason,
+				Seniority:  api.Seniority(c.Seniority),
+				Skills:     c.Skills,
+				Load:       c.Load,
+				Recent:     c.Recent,
+				Rank:       eval.Rank,
+				Picked:     eval.Picked,
+				Shadow:     eval.Shadow,
+			}
+			if eval.Eligible {
+				score := eval.Score
+				candidate.Score = &score
+			}
+			explained.Candidates = append(explained.Candidates, candidate)
+		}
+	}
+
+	return explained
+}
+
+func loadDecisions(ctx context.Context, q querier, prID uuid.UUID, decisionID *int64) ([]storedDecision, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT id, reason, seed, algorithm_version, input, created_at
+		FROM assignment_decisions
+		WHERE pull_request_id = $1
+			AND ($2::bigint IS NULL OR id = $2)
+		ORDER BY id
+	`, prID, decisionID)
+	if err != nil {
+		return nil, fmt.Errorf("query assignment decisions: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	var decisions []storedDecision
+	for rows.Next() {
+		var (
+			d     storedDecision
+			seed  int64
+			input []byte
+		)
+		if err = rows.Scan(&d.id, &d.reason, &seed, &d.version, &input, &d.createdAt); err != nil {
+			return nil, fmt.Errorf("scan assignment decision: %w", err)
+		}
+		if err = json.Unmarshal(input, &d.input); err != nil {
+			return nil, fmt.Errorf("unmarshal decision input: %w", err)
+		}
+		d.seed = uint64(seed)
+		decisions = append(decisions, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return decisions, nil
+}
+
+// decisionReviewers возвращает ревьюверов, назначенных решением, включая уже снятых.
+func decisionReviewers(ctx context.Context, q querier, decisionID int64) ([]uuid.UUID, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT reviewer_id FROM review_assignments
+		WHERE decision_id = $1
+		ORDER BY id
+	`, decisionID)
+	if err != nil {
+		return nil, fmt.Errorf("query decision reviewers: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	reviewers := []uuid.UUID{}
+	for rows.Next() {
+		var reviewer uuid.UUID
+		if err = rows.Scan(&reviewer); err != nil {
+			return nil, fmt.Errorf("scan decision reviewer: %w", err)
+		}
+		reviewers = append(reviewers, reviewer)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return reviewers, nil
+}
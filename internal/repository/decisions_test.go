@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/F3dosik/PRS.git/internal/assignment"
+	"github.com/google/uuid"
+)
+
+func TestExplainDecision(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	input := assignment.Input{
+		Pools: []assignment.Pool{{Candidates: []assignment.Candidate{
+			{UserID: first, Active: true, Seniority: "senior", Skills: []string{"go", "sql"}},
+			{UserID: second, Active: true, Seniority: "middle", Skills: []string{"go"}},
+		}}},
+		Labels: []string{"go", "sql"},
+		Limit:  1,
+	}
+
+	tests := []struct {
+		name           string
+		version        int
+		recorded       []uuid.UUID
+		wantReproduced bool
+		wantReplayed   bool
+	}{
+		{
+			name:           "current version reproduced",
+			version:        assignment.AlgorithmVersion,
+			recorded:       []uuid.UUID{first},
+			wantReproduced: true,
+			wantReplayed:   true,
+		},
+		{
+			name:         "current version mismatch",
+			version:      assignment.AlgorithmVersion,
+			recorded:     []uuid.UUID{second},
+			wantReplayed: true,
+		},
+		{
+			name:     "older version is not replayed",
+			version:  assignment.AlgorithmVersion - 1,
+			recorded: []uuid.UUID{second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := storedDecision{id: 1, seed: 42, version: tt.version, input: input}
+			got := explainDecision(stored, tt.recorded)
+
+			if got.Reproduced != tt.wantReproduced {
+				t.Errorf("Reproduced = %v, want %v", got.Reproduced, tt.wantReproduced)
+			}
+			if replayed := got.ReplayError == ""; replayed != tt.wantReplayed {
+				t.Fatalf("ReplayError = %q, want replayed = %v", got.ReplayError, tt.wantReplayed)
+			}
+			if !tt.wantReplayed {
+				if len(got.Picked) != 0 || len(got.Candidates) != 0 {
+					t.Errorf("not replayed decision has picked %v and %d candidates", got.Picked, len(got.Candidates))
+				}
+				return
+			}
+			if !slices.Equal(got.Picked, []uuid.UUID{first}) {
+				t.Errorf("Picked = %v, want %v", got.Picked, []uuid.UUID{first})
+			}
+			if len(got.Candidates) != 2 {
+				t.Errorf("got %d candidates, want 2", len(got.Candidates))
+			}
+		})
+	}
+}
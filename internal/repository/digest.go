@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/calendar"
+	"github.com/F3dosik/PRS.git/internal/digest"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+const defaultDigestSendAt = 9 * 60
+
+func (s *Storage) GetDigestSubscription(ctx context.Context, userID uuid.UUID) (*api.DigestSubscription, error) {
+	if err := checkUserExists(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+
+	var (
+		sub     = &api.DigestSubscription{UserID: userID}
+		sendAt  int
+		weekday int
+	)
+	err := s.db.QueryRowContext(ctx, `
+		SELECT email, frequency, send_minute, weekday, last_sent_at
+		FROM digest_subscriptions
+		WHERE user_id = $1
+	`, userID).Scan(&sub.Email, &sub.Frequency, &sendAt, &weekday, &sub.LastSentAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return sub, nil
+		}
+		return nil, fmt.Errorf("query digest subscription: %w", err)
+	}
+	sub.SendAt = calendar.FormatClock(sendAt)
+	if sub.Frequency == api.DigestWeekly {
+		sub.Weekday = calendar.FormatWeekday(time.Weekday(weekday))
+	}
+
+	return sub, nil
+}
+
+// SetDigestSubscription оформляет или меняет подписку; пустой email отменяет её.
+// По умолчанию дайджест ежедневный, в 09:00, еженедельный — по понедельникам.
+func (s *Storage) SetDigestSubscription(ctx context.Context, sub *api.DigestSubscription) error {
+	if err := checkUserExists(ctx, s.db, sub.UserID); err != nil {
+		return err
+	}
+
+	if sub.Email == "" {
+		*sub = api.DigestSubscription{UserID: sub.UserID}
+		_, err := s.db.ExecContext(ctx, `
+			DELETE FROM digest_subscriptions
+			WHERE user_id = $1
+		`, sub.UserID)
+		if err != nil {
+			return fmt.Errorf("delete digest subscription: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := mail.ParseAddress(sub.Email); err != nil {
+		return api.NewAPIError(api.ErrInvalidParameter, "invalid email")
+	}
+
+	switch sub.Frequency {
+	case "":
+		sub.Frequency = api.DigestDaily
+	case api.DigestDaily, api.DigestWeekly:
+	default:
+		return api.NewAPIError(api.ErrInvalidParameter, "frequency must be daily or weekly")
+	}
+
+	sendAt := defaultDigestSendAt
+	if sub.SendAt != "" {
+		var err error
+		if sendAt, err = calendar.ParseClock(sub.SendAt); err != nil || sendAt >= 24*60 {
+			return api.NewAPIError(api.ErrInvalidParameter, "send_at must be HH:MM")
+		}
+	}
+	sub.SendAt = calendar.FormatClock(sendAt)
+
+	weekday := time.Monday
+	if sub.Weekday != "" {
+		var err error
+		if weekday, err = calendar.ParseWeekday(sub.Weekday); err != nil {
+			return api.NewAPIError(api.ErrInvalidParameter, err.Error())
+		}
+	}
+	sub.Weekday = ""
+	if sub.Frequency == api.DigestWeekly {
+		sub.Weekday = calendar.FormatWeekday(weekday)
+	}
+
+	err := s.db.QueryRowContext(ctx, `
+		INSERT INTO digest_subscriptions (user_id, email, frequency, send_minute, weekday)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE
+		SET email = EXCLUDED.email,
+			frequency = EXCLUDED.frequency,
+			send_minute = EXCLUDED.send_minute,
+			weekday = EXCLUDED.weekday
+		RETURNING last_sent_at
+	`, sub.UserID, sub.Email, sub.Frequency, sendAt, int(weekday)).Scan(&sub.LastSentAt)
+	if err != nil {
+		return fmt.Errorf("upsert digest subscription: %w", err)
+	}
+
+	return nil
+}
+
+// DigestSubscriptions возвращает подписки активных пользователей. Часовой пояс берётся
+// из расписания пользователя, а без него — из расписания основной команды.
+func (s *Storage) DigestSubscriptions(ctx context.Context) ([]digest.Subscription, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT d.user_id, u.name, d.email, d.frequency, d.send_minute, d.weekday,
+			d.created_at, d.last_sent_at, m.team_id
+		FROM digest_subscriptions d
+		JOIN users u ON u.id = d.user_id
+		LEFT JOIN team_memberships m ON m.user_id = d.user_id AND m.is_primary
+		WHERE u.is_active
+		ORDER BY d.user_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query digest subscriptions: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	type subscriptionRow struct {
+		sub    digest.Subscription
+		teamID *uuid.UUID
+	}
+	var subscriptionRows []subscriptionRow
+	for rows.Next() {
+		var (
+			row     subscriptionRow
+			weekday int
+		)
+		err = rows.Scan(&row.sub.UserID, &row.sub.Username, &row.sub.Email, &row.sub.Frequency,
+			&row.sub.SendAt, &weekday, &row.sub.CreatedAt, &row.sub.LastSentAt, &row.teamID)
+		if err != nil {
+			return nil, fmt.Errorf("scan digest subscription: %w", err)
+		}
+		row.sub.Weekday = time.Weekday(weekday)
+		subscriptionRows = append(subscriptionRows, row)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	schedules := newScheduleCache(s.db)
+	subscriptions := make([]digest.Subscription, 0, len(subscriptionRows))
+	for _, row := range subscriptionRows {
+		teamID := uuid.Nil
+		if row.teamID != nil {
+			teamID = *row.teamID
+		}
+		schedule, err := schedules.get(ctx, row.sub.UserID, teamID)
+		if err != nil {
+			return nil, err
+		}
+		row.sub.Location = schedule.Location
+		subscriptions = append(subscriptions, row.sub)
+	}
+
+	return subscriptions, nil
+}
+
+// DigestReviews возвращает открытые PR, где пользователь сейчас ревьювер, начиная с самых давних назначений.
+func (s *Storage) DigestReviews(ctx context.Context, userID uuid.UUID) ([]api.DigestReview, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT pr.id, pr.title, u.name, COALESCE(t.name, ''), a.assigned_at, a.shadow
+		FROM review_assignments a
+		JOIN pull_request pr ON pr.id = a.pull_request_id
+		JOIN users u ON u.id = pr.author_id
+		LEFT JOIN teams t ON t.id = pr.team_id
+		WHERE a.reviewer_id = $1
+			AND a.unassigned_at IS NULL
+			AND pr.status = 'OPEN'
+		ORDER BY a.assigned_at, pr.id
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query digest reviews: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	var reviews []api.DigestReview
+	for rows.Next() {
+		var r api.DigestReview
+		if err = rows.Scan(&r.PullRequestID, &r.PullRequestName, &r.AuthorName, &r.TeamName, &r.AssignedAt, &r.Shadow); err != nil {
+			return nil, fmt.Errorf("scan digest review: %w", err)
+		}
+		reviews = append(reviews, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return reviews, nil
+}
+
+func (s *Storage) MarkDigestSent(ctx context.Context, userID uuid.UUID, sentAt time.Time) error {
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE digest_subscriptions
+		SET last_sent_at = $2
+		WHERE user_id = $1
+	`, userID, sentAt)
+	if err != nil {
+		return fmt.Errorf("update digest subscription: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,347 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+)
+
+// DumpTable — таблица, входящая в выгрузку; Columns задают порядок полей в CSV.
+type DumpTable struct {
+	Name    string
+	Columns []string
+	// JSONColumns хранят JSON-документ; в CSV они записываются текстом
+	JSONColumns []string
+	// TextColumns — NOT NULL текст, который бывает пустым: пустое поле CSV в них — пустая строка, а не NULL
+	TextColumns []string
+
+	order string
+	// deferred — ссылка на строку той же таблицы; заполняется после вставки всех строк
+	deferred string
+	serial   bool
+}
+
+// DumpTables перечислены в порядке восстановления: каждая таблица ссылается только на предыдущие.
+var DumpTables = []DumpTable{
+	{
+		Name:     "teams",
+		Columns:  []string{"id", "name", "parent_id", "created_at"},
+		order:    "id",
+		deferred: "parent_id",
+	},
+	{
+		Name:    "users",
+		Columns: []string{"id", "name", "is_active", "seniority", "created_at"},
+		order:   "id",
+	},
+	{
+		Name:    "team_memberships",
+		Columns: []string{"user_id", "team_id", "role", "is_active", "is_primary", "created_at"},
+		order:   "user_id, team_id",
+	},
+	{
+		Name:    "team_fallbacks",
+		Columns: []string{"team_id", "position", "kind", "fallback_team_id"},
+		order:   "team_id, position",
+	},
+	{
+		Name:    "code_owner_rules",
+		Columns: []string{"id", "team_id", "position", "pattern"},
+		order:   "id",
+		serial:  true,
+	},
+	{
+		Name:    "code_owner_rule_owners",
+		Columns: []string{"rule_id", "user_id", "owner_team_id"},
+		order:   "rule_id, user_id, owner_team_id",
+	},
+	{
+		Name:    "team_review_rules",
+		Columns: []string{"team_id", "require_senior", "shadow_junior", "rotation_window", "rotation_penalty"},
+		order:   "team_id",
+	},
+	{
+		Name:    "team_sla",
+		Columns: []string{"team_id", "first_review_hours", "reassign_after_hours"},
+		order:   "team_id",
+	},
+	{
+		Name:    "team_schedules",
+		Columns: []string{"team_id", "timezone", "work_days", "start_minute", "end_minute"},
+		order:   "team_id",
+	},
+	{
+		Name:    "user_schedules",
+		Columns: []string{"user_id", "timezone", "work_days", "start_minute", "end_minute"},
+		order:   "user_id",
+	},
+	{
+		Name:        "holidays",
+		Columns:     []string{"id", "team_id", "year", "month", "day", "name"},
+		TextColumns: []string{"name"},
+		order:       "id",
+		serial:      true,
+	},
+	{
+		Name:    "user_skills",
+		Columns: []string{"user_id", "skill"},
+		order:   "user_id, skill",
+	},
+	{
+		Name: "pull_request",
+		Columns: []string{
+			"id", "title", "author_id", "team_id", "status", "reviewer1_id", "reviewer2_id",
+			"shadow_reviewer_id", "need_more_reviewers", "created_at", "merged_at",
+		},
+		order: "id",
+	},
+	{
+		Name:    "pull_request_files",
+		Columns: []string{"pull_request_id", "path"},
+		order:   "pull_request_id, path",
+	},
+	{
+		Name:    "pull_request_labels",
+		Columns: []string{"pull_request_id", "label"},
+		order:   "pull_request_id, label",
+	},
+	{
+		Name:        "assignment_decisions",
+		Columns:     []string{"id", "pull_request_id", "reason", "seed", "algorithm_version", "input", "created_at"},
+		JSONColumns: []string{"input"},
+		order:       "id",
+		serial:      true,
+	},
+	{
+		Name: "review_assignments",
+		Columns: []string{
+			"id", "pull_request_id", "reviewer_id", "source", "source_team_id", "decision_id", "shadow",
+			"assigned_at", "unassigned_at", "sla_breached_at", "sla_escalated_at",
+		},
+		order:  "id",
+		serial: true,
+	},
+}
+
+// DumpWriter принимает выгрузку: Table открывает очередную таблицу, Row пишет её строку
+// JSON-объектом с полями из Columns.
+type DumpWriter interface {
+	Table(table DumpTable) error
+	Row(row json.RawMessage) error
+}
+
+// DumpReader отдаёт строки выгрузки по порядку таблиц; конец выгрузки — io.EOF.
+type DumpReader interface {
+	Next() (table string, row json.RawMessage, err error)
+}
+
+const restoreBatchSize = 1000
+
+// Export выгружает команды с их настройками, пользователей, PR и историю назначений из одного снимка базы.
+func (s *Storage) Export(ctx context.Context, w DumpWriter) error {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, table := range DumpTables {
+		if err := w.Table(table); err != nil {
+			return err
+		}
+		if err := exportTable(ctx, tx, table, w); err != nil {
+			return fmt.Errorf("export %s: %w", table.Name, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func exportTable(ctx context.Context, tx *sql.Tx, table DumpTable, w DumpWriter) error {
+	// Имена таблиц и колонок берутся только из DumpTables
+	query := fmt.Sprintf(`
+		SELECT row_to_json(t)::text
+		FROM (SELECT %s FROM %s ORDER BY %s) t
+		`, strings.Join(table.Columns, ", "), table.Name, table.order)
+
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var row string
+		if err := rows.Scan(&row); err != nil {
+			return err
+		}
+		if err := w.Row(json.RawMessage(row)); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Restore загружает выгрузку в пустую базу одной транзакцией, сохраняя идентификаторы и время.
+func (s *Storage) Restore(ctx context.Context, r DumpReader) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var empty bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT NOT EXISTS (SELECT 1 FROM teams)
+			AND NOT EXISTS (SELECT 1 FROM users)
+			AND NOT EXISTS (SELECT 1 FROM pull_request)
+			AND NOT EXISTS (SELECT 1 FROM holidays)
+		`).Scan(&empty)
+	if err != nil {
+		return fmt.Errorf("check database is empty: %w", err)
+	}
+	if !empty {
+		return api.NewAPIError(api.ErrInvalidParameter, "restore requires an empty database")
+	}
+
+	res := &restorer{tx: tx, current: -1}
+	for {
+		name, row, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := res.add(ctx, name, row); err != nil {
+			return err
+		}
+	}
+
+	// Таблицы, которых нет в выгрузке, тоже закрываются, чтобы выставить их последовательности
+	if err := res.advance(ctx, len(DumpTables)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+type restorer struct {
+	tx       *sql.Tx
+	current  int
+	batch    []json.RawMessage
+	deferred []json.RawMessage
+}
+
+func (r *restorer) add(ctx context.Context, name string, row json.RawMessage) error {
+	index := slices.IndexFunc(DumpTables, func(t DumpTable) bool { return t.Name == name })
+	if index < 0 {
+		return api.NewAPIError(api.ErrInvalidParameter, "unknown table in dump: "+name)
+	}
+	if index < r.current {
+		return api.NewAPIError(api.ErrInvalidParameter, "table "+name+" is out of order in dump")
+	}
+	if err := r.advance(ctx, index); err != nil {
+		return err
+	}
+
+	r.batch = append(r.batch, row)
+	if len(r.batch) == restoreBatchSize {
+		return r.flush(ctx)
+	}
+
+	return nil
+}
+
+// advance дописывает текущую таблицу и все таблицы до index.
+func (r *restorer) advance(ctx context.Context, index int) error {
+	for r.current < index {
+		if r.current >= 0 {
+			if err := r.finish(ctx); err != nil {
+				return err
+			}
+		}
+		r.current++
+	}
+
+	return nil
+}
+
+func (r *restorer) flush(ctx context.Context) error {
+	if len(r.batch) == 0 {
+		return nil
+	}
+
+	table := DumpTables[r.current]
+	columns := table.Columns
+	if table.deferred != "" {
+		columns = slices.DeleteFunc(slices.Clone(columns), func(c string) bool { return c == table.deferred })
+		r.deferred = append(r.deferred, r.batch...)
+	}
+
+	batch, err := json.Marshal(r.batch)
+	if err != nil {
+		return err
+	}
+	r.batch = r.batch[:0]
+
+	list := strings.Join(columns, ", ")
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s)
+		SELECT %s FROM json_populate_recordset(NULL::%s, $1::json)
+		`, table.Name, list, list, table.Name)
+
+	if _, err := r.tx.ExecContext(ctx, query, string(batch)); err != nil {
+		return fmt.Errorf("restore %s: %w", table.Name, err)
+	}
+
+	return nil
+}
+
+func (r *restorer) finish(ctx context.Context) error {
+	if err := r.flush(ctx); err != nil {
+		return err
+	}
+
+	table := DumpTables[r.current]
+	if table.deferred != "" && len(r.deferred) > 0 {
+		rows, err := json.Marshal(r.deferred)
+		if err != nil {
+			return err
+		}
+		r.deferred = nil
+
+		query := fmt.Sprintf(`
+			UPDATE %s t
+			SET %s = d.%s
+			FROM json_populate_recordset(NULL::%s, $1::json) d
+			WHERE t.id = d.id AND d.%s IS NOT NULL
+			`, table.Name, table.deferred, table.deferred, table.Name, table.deferred)
+
+		if _, err := r.tx.ExecContext(ctx, query, string(rows)); err != nil {
+			return fmt.Errorf("restore %s.%s: %w", table.Name, table.deferred, err)
+		}
+	}
+
+	if table.serial {
+		// Следующие записи не должны столкнуться с восстановленными id
+		query := fmt.Sprintf(`
+			SELECT setval(pg_get_serial_sequence('%s', 'id'), COALESCE(MAX(id), 0) + 1, false)
+			FROM %s
+			`, table.Name, table.Name)
+
+		if _, err := r.tx.ExecContext(ctx, query); err != nil {
+			return fmt.Errorf("reset %s sequence: %w", table.Name, err)
+		}
+	}
+
+	return nil
+}
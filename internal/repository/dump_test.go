@@ -0,0 +1,288 @@
+package repository_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/calendar"
+	"github.com/F3dosik/PRS.git/internal/dump"
+	"github.com/F3dosik/PRS.git/internal/migrate"
+	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/F3dosik/PRS.git/migrations"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// newTestStorage открывает хранилище в отдельной схеме с применёнными миграциями, чтобы тест
+// не зависел от данных в TEST_DATABASE_URL и от других тестов; схема удаляется по окончании.
+func newTestStorage(t *testing.T, ctx context.Context) *repository.Storage {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	schema := "prs_test_" + uuid.NewString()[:8]
+	if _, err = db.ExecContext(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := db.Exec("DROP SCHEMA " + schema + " CASCADE"); err != nil {
+			t.Errorf("drop schema: %v", err)
+		}
+	})
+
+	u, err := url.Parse(dsn)
+	if err != nil {
+		t.Fatalf("TEST_DATABASE_URL must be a URL: %v", err)
+	}
+	query := u.Query()
+	query.Set("search_path", schema+",public")
+	u.RawQuery = query.Encode()
+	dsn = u.String()
+
+	m, err := migrate.Open(dsn, migrations.FS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = m.Close() }()
+	if _, err = m.Up(ctx); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	storage, err := repository.NewStorage(dsn, repository.PoolConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(storage.Close)
+
+	return storage
+}
+
+type dumpLine struct {
+	Table string
+	Row   json.RawMessage
+}
+
+func export(t *testing.T, ctx context.Context, storage *repository.Storage) []dumpLine {
+	t.Helper()
+
+	w := &memoryDump{}
+	if err := storage.Export(ctx, w); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	return w.rows
+}
+
+// memoryDump запоминает строки выгрузки вместе с таблицей, без кодирования.
+type memoryDump struct {
+	table string
+	rows  []dumpLine
+}
+
+func (d *memoryDump) Table(table repository.DumpTable) error {
+	d.table = table.Name
+	return nil
+}
+
+func (d *memoryDump) Row(row json.RawMessage) error {
+	d.rows = append(d.rows, dumpLine{Table: d.table, Row: row})
+	return nil
+}
+
+func seed(t *testing.T, ctx context.Context, storage *repository.Storage) {
+	t.Helper()
+
+	parent := "backend"
+	teams := []*api.Team{
+		{
+			TeamName: parent,
+			Members: []api.TeamMember{
+				{UserID: uuid.New(), Username: "Ann", IsActive: true, Seniority: api.SenioritySenior},
+				{UserID: uuid.New(), Username: "Bob", IsActive: true},
+				{UserID: uuid.New(), Username: "Eve", IsActive: false},
+			},
+		},
+		{
+			TeamName:   "payments",
+			ParentTeam: &parent,
+			Members: []api.TeamMember{
+				{UserID: uuid.New(), Username: "Dan", IsActive: true},
+				{UserID: uuid.New(), Username: "Kim", IsActive: true},
+			},
+		},
+	}
+	for _, team := range teams {
+		if err := storage.UpdateTeam(ctx, team); err != nil {
+			t.Fatalf("UpdateTeam %s: %v", team.TeamName, err)
+		}
+	}
+
+	seedSettings(t, ctx, storage, teams[0], teams[1])
+
+	for i, team := range teams {
+		pr, err := storage.PullRequestCreate(ctx, &api.PullRequestCreateRequest{
+			PullRequestShort: api.PullRequestShort{
+				PullRequestID:   uuid.New(),
+				PullRequestName: fmt.Sprintf("PR %d", i),
+				AuthorID:        team.Members[0].UserID,
+			},
+			TeamName:     team.TeamName,
+			ChangedFiles: []string{"cmd/app/main.go", "README.md"},
+			Labels:       []string{"backend"},
+		})
+		if err != nil {
+			t.Fatalf("PullRequestCreate: %v", err)
+		}
+		if i == 0 {
+			if _, err = storage.PullRequestMerge(ctx, pr.PullRequestID); err != nil {
+				t.Fatalf("PullRequestMerge: %v", err)
+			}
+		}
+	}
+}
+
+// seedSettings заполняет все таблицы настроек, входящие в выгрузку.
+func seedSettings(t *testing.T, ctx context.Context, storage *repository.Storage, parent, child *api.Team) {
+	t.Helper()
+
+	reassignAfter := 8
+	schedule := api.WorkSchedule{TimeZone: "Europe/Berlin", WorkDays: []string{"mon", "tue", "wed", "thu", "fri"}, WorkStart: "09:00", WorkEnd: "18:00"}
+	steps := []struct {
+		name string
+		do   func() error
+	}{
+		{"SetTeamFallback", func() error {
+			return storage.SetTeamFallback(ctx, &api.TeamFallback{TeamName: child.TeamName, Chain: []api.FallbackEntry{
+				{Kind: api.FallbackTeam, TeamName: parent.TeamName},
+				{Kind: api.FallbackAnyActive},
+			}})
+		}},
+		{"SetTeamOwners", func() error {
+			return storage.SetTeamOwners(ctx, &api.TeamOwners{TeamName: parent.TeamName, Rules: []api.CodeOwnerRule{
+				{Pattern: "*.go", Users: []uuid.UUID{parent.Members[0].UserID}},
+				{Pattern: "/docs/", Teams: []string{child.TeamName}},
+			}})
+		}},
+		{"SetTeamReviewRules", func() error {
+			return storage.SetTeamReviewRules(ctx, &api.TeamReviewRules{
+				TeamName: parent.TeamName, RequireSenior: true, RotationWindow: 5, RotationPenalty: 0.25,
+			})
+		}},
+		{"SetTeamSLA", func() error {
+			return storage.SetTeamSLA(ctx, &api.TeamSLA{TeamName: parent.TeamName, FirstReviewHours: 4, ReassignAfterHours: &reassignAfter})
+		}},
+		{"SetTeamSchedule", func() error {
+			return storage.SetTeamSchedule(ctx, &api.TeamSchedule{TeamName: parent.TeamName, WorkSchedule: schedule})
+		}},
+		{"SetUserSchedule", func() error {
+			return storage.SetUserSchedule(ctx, &api.UserSchedule{UserID: child.Members[0].UserID, WorkSchedule: schedule})
+		}},
+		{"ImportHolidays", func() error {
+			_, err := storage.ImportHolidays(ctx, parent.TeamName, []calendar.Holiday{
+				{Month: time.May, Day: 1, Name: "Labour Day"},
+				{Year: 2025, Month: time.December, Day: 31},
+			}, false)
+			return err
+		}},
+		{"SetUserSkills", func() error {
+			return storage.SetUserSkills(ctx, &api.UserSkills{UserID: parent.Members[1].UserID, Skills: []string{"go", "sql"}})
+		}},
+	}
+	for _, step := range steps {
+		if err := step.do(); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+	}
+}
+
+func TestExportRestore(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	source := newTestStorage(t, ctx)
+	seed(t, ctx, source)
+	want := export(t, ctx, source)
+	if len(want) == 0 {
+		t.Fatal("export is empty")
+	}
+	// Каждая таблица выгрузки, включая настройки команд, должна попасть в проверку
+	for _, table := range repository.DumpTables {
+		if !slices.ContainsFunc(want, func(l dumpLine) bool { return l.Table == table.Name }) {
+			t.Fatalf("seed leaves table %s empty", table.Name)
+		}
+	}
+
+	for _, format := range []dump.Format{dump.FormatNDJSON, dump.FormatZip} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := dump.NewWriter(format, &buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = source.Export(ctx, w); err != nil {
+				t.Fatalf("Export: %v", err)
+			}
+			if err = w.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			var r repository.DumpReader
+			if format == dump.FormatZip {
+				if r, err = dump.NewZipReader(bytes.NewReader(buf.Bytes()), int64(buf.Len())); err != nil {
+					t.Fatal(err)
+				}
+			} else {
+				r = dump.NewNDJSONReader(&buf)
+			}
+
+			target := newTestStorage(t, ctx)
+			if err = target.Restore(ctx, r); err != nil {
+				t.Fatalf("Restore: %v", err)
+			}
+
+			got := export(t, ctx, target)
+			if len(got) != len(want) {
+				t.Fatalf("restored %d rows, want %d", len(got), len(want))
+			}
+			for i := range want {
+				if got[i].Table != want[i].Table || !jsonEqual(t, got[i].Row, want[i].Row) {
+					t.Errorf("row %d:\n got %s %s\nwant %s %s", i, got[i].Table, got[i].Row, want[i].Table, want[i].Row)
+				}
+			}
+		})
+	}
+}
+
+// jsonEqual сравнивает строки без учёта порядка ключей в JSON-колонках.
+func jsonEqual(t *testing.T, a, b json.RawMessage) bool {
+	t.Helper()
+
+	decode := func(raw json.RawMessage) any {
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.UseNumber()
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	return reflect.DeepEqual(decode(a), decode(b))
+}
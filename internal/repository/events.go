@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+type event struct {
+	eventType     api.EventType
+	pullRequestID *uuid.UUID
+	teamID        *uuid.UUID
+	userID        *uuid.UUID
+	payload       any
+}
+
+// recordEvent добавляет событие в журнал в рамках транзакции, изменившей состояние.
+func recordEvent(ctx context.Context, q querier, e event) error {
+	payload, err := json.Marshal(e.payload)
+	if err != nil {
+		return fmt.Errorf("marshal event payload: %w", err)
+	}
+
+	_, err = q.ExecContext(ctx, `
+		INSERT INTO events (type, pull_request_id, team_id, user_id, payload)
+		VALUES ($1, $2, $3, $4, $5)
+	`, e.eventType, e.pullRequestID, e.teamID, e.userID, payload)
+	if err != nil {
+		return fmt.Errorf("insert event: %w", err)
+	}
+
+	return nil
+}
+
+// EventFilter ограничивает выборку событий. Пустые поля не фильтруют.
+type EventFilter struct {
+	teamIDs []uuid.UUID
+	userID  *uuid.UUID
+	types   []string
+}
+
+// NewEventFilter отбирает события команды teamName (при includeDescendants — вместе с подкомандами),
+// события, касающиеся пользователя userID (он автор, ревьювер или снятый ревьювер), и события типов types.
+func (s *Storage) NewEventFilter(ctx context.Context, teamName string, includeDescendants bool, userID *uuid.UUID, types []api.EventType) (*EventFilter, error) {
+	teamIDs, err := statsTeamIDs(ctx, s.db, teamName, includeDescendants)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &EventFilter{teamIDs: teamIDs, userID: userID}
+	for _, t := range types {
+		filter.types = append(filter.types, string(t))
+	}
+
+	return filter, nil
+}
+
+// Позиция в журнале — событие, после которого продолжается чтение. События отдаются в порядке (xid, id),
+// где xid — транзакция, записавшая событие, и только когда завершены все транзакции с меньшим xid.
+// Поэтому событие транзакции, которая взяла id раньше, а зафиксировалась позже соседей, не пропускается,
+// а id в выдаче не обязательно возрастают. Позиция, которой нет в журнале, сравнивается только по id.
+const eventsVisible = `e.xid < pg_snapshot_xmin(pg_current_snapshot())`
+
+// EventsAfter возвращает до limit событий, следующих за событием afterID, в порядке публикации.
+func (s *Storage) EventsAfter(ctx context.Context, afterID int64, limit int) ([]api.Event, error) {
+	return s.FilterEvents(ctx, afterID, &EventFilter{}, limit)
+}
+
+// FilterEvents возвращает до limit подходящих под filter событий, следующих за событием afterID,
+// в порядке публикации.
+func (s *Storage) FilterEvents(ctx context.Context, afterID int64, filter *EventFilter, limit int) ([]api.Event, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		WITH position AS (
+			SELECT xid, id FROM events
+			WHERE id = $1
+		)
+		SELECT e.id, e.type, e.pull_request_id, e.team_id, e.user_id, e.payload, e.created_at
+		FROM events e
+		LEFT JOIN position p ON TRUE
+		WHERE `+eventsVisible+`
+			AND CASE WHEN p.id IS NULL THEN e.id > $1 ELSE (e.xid, e.id) > (p.xid, p.id) END
+			AND ($2::uuid[] IS NULL OR e.team_id = ANY($2))
+			AND ($3::uuid IS NULL
+				OR e.user_id = $3
+				OR e.payload->>'author_id' = $3::text
+				OR e.payload->>'reviewer_id' = $3::text
+				OR e.payload->>'replaced_reviewer_id' = $3::text
+				OR e.payload->'reviewers' ? $3::text)
+			AND ($4::text[] IS NULL OR e.type = ANY($4))
+		ORDER BY e.xid, e.id
+		LIMIT $5
+	`, afterID, filter.teamIDs, filter.userID, filter.types, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query events: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	events := []api.Event{}
+	for rows.Next() {
+		var (
+			e       api.Event
+			payload []byte
+		)
+		err = rows.Scan(&e.ID, &e.Type, &e.PullRequestID, &e.TeamID, &e.UserID, &payload, &e.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scan event: %w", err)
+		}
+		e.Payload = payload
+		events = append(events, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return events, nil
+}
+
+// LatestEventID возвращает последнее событие журнала, которое уже можно прочитать, или 0, если таких нет.
+// События незавершённых транзакций окажутся после него и будут прочитаны позже.
+func (s *Storage) LatestEventID(ctx context.Context) (int64, error) {
+	var id int64
+	err := s.db.QueryRowContext(ctx, `
+		SELECT COALESCE((
+			SELECT e.id FROM events e
+			WHERE `+eventsVisible+`
+			ORDER BY e.xid DESC, e.id DESC
+			LIMIT 1
+		), 0)
+	`).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("query latest event: %w", err)
+	}
+
+	return id, nil
+}
+
+// EventCursor возвращает id последнего события, обработанного потребителем name.
+func (s *Storage) EventCursor(ctx context.Context, name string) (int64, bool, error) {
+	var id int64
+	err := s.db.QueryRowContext(ctx, `
+		SELECT last_event_id FROM event_cursors
+		WHERE name = $1
+	`, name).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("query event cursor: %w", err)
+	}
+
+	return id, true, nil
+}
+
+// SaveEventCursor сдвигает позицию потребителя name на событие eventID. Позиция не откатывается назад
+// в порядке публикации, если её уже продвинул другой экземпляр.
+func (s *Storage) SaveEventCursor(ctx context.Context, name string, eventID int64) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO event_cursors (name, last_event_id)
+		VALUES ($1, $2)
+		ON CONFLICT (name) DO UPDATE
+		SET last_event_id = EXCLUDED.last_event_id
+		WHERE NOT EXISTS (
+			SELECT 1 FROM events n, events o
+			WHERE n.id = EXCLUDED.last_event_id
+				AND o.id = event_cursors.last_event_id
+				AND (n.xid, n.id) <= (o.xid, o.id)
+		)
+	`, name, eventID)
+	if err != nil {
+		return fmt.Errorf("upsert event cursor: %w", err)
+	}
+
+	return nil
+}
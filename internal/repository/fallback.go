@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+func (s *Storage) GetTeamFallback(ctx context.Context, teamName string) (*api.TeamFallback, error) {
+	teamID, err := teamIDByName(ctx, s.db, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, err := loadFallbackChain(ctx, s.db, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	fallback := &api.TeamFallback{
+		TeamName: teamName,
+		Chain:    make([]api.FallbackEntry, 0, len(chain)),
+	}
+	for _, link := range chain {
+		entry := api.FallbackEntry{Kind: link.kind}
+		if link.teamName != nil {
+			entry.TeamName = *link.teamName
+		}
+		fallback.Chain = append(fallback.Chain, entry)
+	}
+
+	return fallback, nil
+}
+
+// SetTeamFallback полностью заменяет цепочку резервных пулов команды.
+func (s *Storage) SetTeamFallback(ctx context.Context, fallback *api.TeamFallback) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	teamID, err := teamIDByName(ctx, tx, fallback.TeamName)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM team_fallbacks
+		WHERE team_id = $1
+	`, teamID)
+	if err != nil {
+		return fmt.Errorf("delete team fallbacks: %w", err)
+	}
+
+	for i := range fallback.Chain {
+		entry := &fallback.Chain[i]
+		var fallbackTeamID *uuid.UUID
+		switch entry.Kind {
+		case api.FallbackTeam:
+			if entry.TeamName == fallback.TeamName {
+				return api.NewAPIError(api.ErrInvalidParameter, "team cannot be its own fallback")
+			}
+			id, err := teamIDByName(ctx, tx, entry.TeamName)
+			if err != nil {
+				return err
+			}
+			fallbackTeamID = &id
+		case api.FallbackAncestors, api.FallbackAnyActive:
+			entry.TeamName = ""
+		default:
+			return api.NewAPIError(api.ErrInvalidParameter, "unknown fallback kind: "+string(entry.Kind))
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO team_fallbacks (team_id, position, kind, fallback_team_id)
+			VALUES ($1, $2, $3, $4)
+		`, teamID, i, entry.Kind, fallbackTeamID)
+		if err != nil {
+			return fmt.Errorf("insert team fallback: %w", err)
+		}
+	}
+
+	// Новая цепочка может дать кандидатов PR, ожидающим добора
+	if _, err = s.fillReviewers(ctx, tx, []uuid.UUID{teamID}); err != nil {
+		return fmt.Errorf("fill reviewers: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+type fallbackLink struct {
+	kind     api.FallbackKind
+	teamID   *uuid.UUID
+	teamName *string
+}
+
+// loadFallbackChain возвращает настроенную цепочку резервных пулов команды в порядке обхода.
+func loadFallbackChain(ctx context.Context, q querier, teamID uuid.UUID) ([]fallbackLink, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT f.kind, f.fallback_team_id, t.name
+		FROM team_fallbacks f
+		LEFT JOIN teams t ON t.id = f.fallback_team_id
+		WHERE f.team_id = $1
+		ORDER BY f.position
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("query team fallbacks: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	var chain []fallbackLink
+	for rows.Next() {
+		var link fallbackLink
+		if err = rows.Scan(&link.kind, &link.teamID, &link.teamName); err != nil {
+			return nil, fmt.Errorf("scan team fallback: %w", err)
+		}
+		chain = append(chain, link)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return chain, nil
+}
+
+func teamIDByName(ctx context.Context, q querier, teamName string) (uuid.UUID, error) {
+	var teamID uuid.UUID
+	err := q.QueryRowContext(ctx, `
+		SELECT id FROM teams
+		WHERE name = $1
+	`, teamName).Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, api.NewAPIError(api.ErrNotFound, "team not found")
+		}
+		return uuid.Nil, fmt.Errorf("query team: %w", err)
+	}
+
+	return teamID, nil
+}
@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+// SetTeamParent переносит команду под parentName; пустой parentName делает её корневой.
+// Перенос команды в собственное поддерево отклоняется с ErrHierarchyCycle.
+func (s *Storage) SetTeamParent(ctx context.Context, teamName, parentName string) (*api.Team, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Сериализуем изменения иерархии, чтобы два встречных переноса не образовали цикл
+	if _, err = tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('teams_hierarchy'))`); err != nil {
+		return nil, fmt.Errorf("lock team hierarchy: %w", err)
+	}
+
+	teamID, err := teamIDByName(ctx, tx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentID *uuid.UUID
+	if parentName != "" {
+		id, err := teamIDByName(ctx, tx, parentName)
+		if err != nil {
+			return nil, err
+		}
+
+		subtree, err := teamSubtreeIDs(ctx, tx, teamID)
+		if err != nil {
+			return nil, err
+		}
+		for _, descendant := range subtree {
+			if descendant == id {
+				return nil, api.NewAPIError(api.ErrHierarchyCycle, "parent team is inside the team subtree")
+			}
+		}
+		parentID = &id
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE teams
+		SET parent_id = $1
+		WHERE id = $2
+	`, parentID, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("update team parent: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.GetTeam(ctx, teamName, false)
+}
+
+// GetTeamSubtree возвращает дерево команд с корнем в teamName.
+func (s *Storage) GetTeamSubtree(ctx context.Context, teamName string) (*api.TeamNode, error) {
+	teamID, err := teamIDByName(ctx, s.db, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		WITH RECURSIVE subtree AS (
+			SELECT id, name, parent_id, 0 AS depth FROM teams WHERE id = $1
+			UNION
+			SELECT t.id, t.name, t.parent_id, s.depth + 1
+			FROM teams t
+			JOIN subtree s ON t.parent_id = s.id
+		)
+		SELECT s.id, s.name, s.parent_id, p.name
+		FROM subtree s
+		LEFT JOIN teams p ON p.id = s.parent_id
+		ORDER BY s.depth, s.name
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("query team subtree: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	type subtreeRow struct {
+		id       uuid.UUID
+		parentID *uuid.UUID
+		node     api.TeamNode
+	}
+
+	var nodes []subtreeRow
+	for rows.Next() {
+		var row subtreeRow
+		if err = rows.Scan(&row.id, &row.node.TeamName, &row.parentID, &row.node.ParentTeam); err != nil {
+			return nil, fmt.Errorf("scan team: %w", err)
+		}
+		row.node.Children = []api.TeamNode{}
+		nodes = append(nodes, row)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	// Строки отсортированы по глубине, поэтому дети собираются, начиная с самых глубоких
+	index := make(map[uuid.UUID]int, len(nodes))
+	for i, row := range nodes {
+		index[row.id] = i
+	}
+	for i := len(nodes) - 1; i > 0; i-- {
+		parent := index[*nodes[i].parentID]
+		nodes[parent].node.Children = append([]api.TeamNode{nodes[i].node}, nodes[parent].node.Children...)
+	}
+
+	return &nodes[0].node, nil
+}
+
+// teamSubtreeIDs возвращает идентификаторы команды и всех её потомков.
+func teamSubtreeIDs(ctx context.Context, q querier, teamID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.QueryContext(ctx, `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM teams WHERE id = $1
+			UNION
+			SELECT t.id FROM teams t
+			JOIN subtree s ON t.parent_id = s.id
+		)
+		SELECT id FROM subtree
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("query team subtree: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan team id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return ids, nil
+}
+
+type teamRef struct {
+	id   uuid.UUID
+	name string
+}
+
+// teamAncestors возвращает предков команды от ближайшего к корню.
+func teamAncestors(ctx context.Context, q querier, teamID uuid.UUID) ([]teamRef, error) {
+	rows, err := q.QueryContext(ctx, `
+		WITH RECURSIVE ancestors AS (
+			SELECT parent_id AS id, 1 AS depth FROM teams
+			WHERE id = $1 AND parent_id IS NOT NULL
+			UNION
+			SELECT t.parent_id, a.depth + 1
+			FROM teams t
+			JOIN ancestors a ON t.id = a.id
+			WHERE t.parent_id IS NOT NULL
+		)
+		SELECT a.id, t.name
+		FROM ancestors a
+		JOIN teams t ON t.id = a.id
+		ORDER BY a.depth
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("query team ancestors: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	var ancestors []teamRef
+	for rows.Next() {
+		var ref teamRef
+		if err = rows.Scan(&ref.id, &ref.name); err != nil {
+			return nil, fmt.Errorf("scan team ancestor: %w", err)
+		}
+		ancestors = append(ancestors, ref)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return ancestors, nil
+}
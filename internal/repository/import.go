@@ -0,0 +1,427 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+// importRow — проверенный элемент импорта с разрешённой командой.
+type importRow struct {
+	item   api.PullRequestImportItem
+	teamID uuid.UUID
+}
+
+// ImportPullRequests переносит PR с уже назначенными ревьюверами одной транзакцией. Каждый элемент
+// проверяется отдельно, результат возвращается по каждому. Если хотя бы один элемент некорректен,
+// ничего не импортируется, а остальные помечаются skipped; при partial корректные элементы
+// импортируются несмотря на ошибки в других.
+//
+// Импорт не публикует события: по перенесённым PR не должны уходить уведомления о назначении.
+// Сроки SLA назначений отсчитываются от момента импорта.
+func (s *Storage) ImportPullRequests(ctx context.Context, items []api.PullRequestImportItem, partial bool) (*api.PullRequestImportResponse, error) {
+	now := time.Now()
+	resp := &api.PullRequestImportResponse{Results: make([]api.PullRequestImportResult, len(items))}
+	seen := make(map[uuid.UUID]bool, len(items))
+	for i := range items {
+		resp.Results[i] = api.PullRequestImportResult{Index: i, PullRequestID: items[i].PullRequestID}
+		if apiErr := normalizeImportItem(&items[i], now, seen); apiErr != nil {
+			resp.Results[i].Status, resp.Results[i].Error = api.ImportFailed, apiErr
+		}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	lookup, err := loadImportLookup(ctx, tx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]importRow, 0, len(items))
+	for i, item := range items {
+		if resp.Results[i].Status == api.ImportFailed {
+			continue
+		}
+		teamID, apiErr := lookup.check(&item)
+		if apiErr != nil {
+			resp.Results[i].Status, resp.Results[i].Error = api.ImportFailed, apiErr
+			continue
+		}
+		rows = append(rows, importRow{item: item, teamID: teamID})
+	}
+
+	for _, result := range resp.Results {
+		if result.Status == api.ImportFailed {
+			resp.Failed++
+		}
+	}
+	if resp.Failed > 0 && !partial {
+		for i := range resp.Results {
+			if resp.Results[i].Status != api.ImportFailed {
+				resp.Results[i].Status = api.ImportSkipped
+			}
+		}
+		return resp, nil
+	}
+
+	// PR с тем же id мог быть создан параллельно после loadImportLookup
+	conflicts, err := insertImportRows(ctx, tx, rows, now)
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 {
+		for i := range resp.Results {
+			if resp.Results[i].Status != api.ImportFailed && conflicts[items[i].PullRequestID] {
+				resp.Results[i].Status = api.ImportFailed
+				resp.Results[i].Error = api.NewAPIError(api.ErrPRExist, "PR id already exist")
+				resp.Failed++
+			}
+		}
+		if !partial {
+			for i := range resp.Results {
+				if resp.Results[i].Status != api.ImportFailed {
+					resp.Results[i].Status = api.ImportSkipped
+				}
+			}
+			return resp, nil
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	for i := range resp.Results {
+		if resp.Results[i].Status != api.ImportFailed {
+			resp.Results[i].Status = api.ImportImported
+			resp.Imported++
+		}
+	}
+
+	return resp, nil
+}
+
+// normalizeImportItem проверяет элемент без обращения к базе и заполняет значения по умолчанию.
+func normalizeImportItem(item *api.PullRequestImportItem, now time.Time, seen map[uuid.UUID]bool) *api.APIError {
+	switch {
+	case item.PullRequestID == uuid.Nil || item.PullRequestName == "" || item.AuthorID == uuid.Nil:
+		return api.NewAPIError(api.ErrInvalidPR, "pull_request_id, pull_request_name and author_id are required")
+	case seen[item.PullRequestID]:
+		return api.NewAPIError(api.ErrPRExist, "duplicate pull_request_id in import")
+	}
+	seen[item.PullRequestID] = true
+
+	if item.Status == "" {
+		item.Status = api.StatusOpen
+	}
+	if item.Status != api.StatusOpen && item.Status != api.StatusMerged {
+		return api.NewAPIError(api.ErrInvalidPR, "status must be OPEN or MERGED")
+	}
+
+	if item.CreatedAt == nil {
+		item.CreatedAt = &now
+	}
+	switch {
+	case item.Status == api.StatusOpen && item.MergedAt != nil:
+		return api.NewAPIError(api.ErrInvalidPR, "merged_at is set for an open pull request")
+	case item.Status == api.StatusMerged && item.MergedAt == nil:
+		item.MergedAt = &now
+	}
+	if item.MergedAt != nil && item.MergedAt.Before(*item.CreatedAt) {
+		return api.NewAPIError(api.ErrInvalidPR, "merged_at is before created_at")
+	}
+
+	reviewers := item.AssignedReviewers
+	if item.ShadowReviewer != nil {
+		reviewers = append(slices.Clone(reviewers), *item.ShadowReviewer)
+	}
+	if len(item.AssignedReviewers) > reviewersPerPR {
+		return api.NewAPIError(api.ErrInvalidPR, fmt.Sprintf("at most %d reviewers can be assigned", reviewersPerPR))
+	}
+	for i, reviewerID := range reviewers {
+		if reviewerID == item.AuthorID {
+			return api.NewAPIError(api.ErrInvalidPR, "author cannot review own pull request")
+		}
+		if slices.Contains(reviewers[:i], reviewerID) {
+			return api.NewAPIError(api.ErrInvalidPR, "reviewer is assigned twice")
+		}
+	}
+
+	for _, file := range item.ChangedFiles {
+		if file == "" {
+			return api.NewAPIError(api.ErrInvalidPR, "changed_files must not contain empty paths")
+		}
+	}
+	item.Labels = normalizeTags(item.Labels)
+
+	return nil
+}
+
+type importMembership struct {
+	teamID   uuid.UUID
+	teamName string
+}
+
+// importLookup — всё, что нужно для проверки элементов, загруженное пакетно.
+type importLookup struct {
+	existingPRs map[uuid.UUID]bool
+	users       map[uuid.UUID]bool
+	memberships map[uuid.UUID][]importMembership // Основная команда первой
+}
+
+func loadImportLookup(ctx context.Context, q querier, items []api.PullRequestImportItem) (*importLookup, error) {
+	var prIDs, userIDs []uuid.UUID
+	for _, item := range items {
+		prIDs = append(prIDs, item.PullRequestID)
+		userIDs = append(userIDs, item.AuthorID)
+		userIDs = append(userIDs, item.AssignedReviewers...)
+		if item.ShadowReviewer != nil {
+			userIDs = append(userIDs, *item.ShadowReviewer)
+		}
+	}
+
+	lookup := &importLookup{
+		existingPRs: make(map[uuid.UUID]bool),
+		users:       make(map[uuid.UUID]bool),
+		memberships: make(map[uuid.UUID][]importMembership),
+	}
+
+	existing, err := queryIDs(ctx, q, `SELECT id FROM pull_request WHERE id = ANY($1)`, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query existing pull requests: %w", err)
+	}
+	for _, id := range existing {
+		lookup.existingPRs[id] = true
+	}
+
+	users, err := queryIDs(ctx, q, `SELECT id FROM users WHERE id = ANY($1)`, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query users: %w", err)
+	}
+	for _, id := range users {
+		lookup.users[id] = true
+	}
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT m.user_id, t.id, t.name
+		FROM team_memberships m
+		JOIN teams t ON t.id = m.team_id
+		WHERE m.user_id = ANY($1)
+		ORDER BY m.is_primary DESC, m.created_at
+	`, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query team memberships: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	for rows.Next() {
+		var (
+			userID     uuid.UUID
+			membership importMembership
+		)
+		if err = rows.Scan(&userID, &membership.teamID, &membership.teamName); err != nil {
+			return nil, fmt.Errorf("scan team membership: %w", err)
+		}
+		lookup.memberships[userID] = append(lookup.memberships[userID], membership)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return lookup, nil
+}
+
+// check проверяет ссылки элемента и возвращает команду PR.
+func (l *importLookup) check(item *api.PullRequestImportItem) (uuid.UUID, *api.APIError) {
+	if l.existingPRs[item.PullRequestID] {
+		return uuid.Nil, api.NewAPIError(api.ErrPRExist, "PR id already exist")
+	}
+	if !l.users[item.AuthorID] {
+		return uuid.Nil, api.NewAPIError(api.ErrNotFound, "author not found")
+	}
+	for _, reviewerID := range item.AssignedReviewers {
+		if !l.users[reviewerID] {
+			return uuid.Nil, api.NewAPIError(api.ErrNotFound, "reviewer "+reviewerID.String()+" not found")
+		}
+	}
+	if item.ShadowReviewer != nil && !l.users[*item.ShadowReviewer] {
+		return uuid.Nil, api.NewAPIError(api.ErrNotFound, "shadow reviewer not found")
+	}
+
+	memberships := l.memberships[item.AuthorID]
+	if item.TeamName == "" {
+		if len(memberships) == 0 {
+			return uuid.Nil, api.NewAPIError(api.ErrNotFound, "author has no team")
+		}
+		item.TeamName = memberships[0].teamName
+		return memberships[0].teamID, nil
+	}
+	for _, m := range memberships {
+		if m.teamName == item.TeamName {
+			return m.teamID, nil
+		}
+	}
+
+	return uuid.Nil, api.NewAPIError(api.ErrNotFound, "author is not a member of team")
+}
+
+// insertImportRows вставляет PR, назначения, файлы и метки пакетными INSERT ... SELECT FROM unnest.
+// PR, id которых уже заняты, пропускаются вместе с назначениями, файлами и метками и возвращаются
+// в conflicts.
+func insertImportRows(ctx context.Context, q querier, rows []importRow, now time.Time) (conflicts map[uuid.UUID]bool, err error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	var (
+		ids, authorIDs, teamIDs               []uuid.UUID
+		titles, statuses                      []string
+		reviewer1IDs, reviewer2IDs, shadowIDs []uuid.NullUUID
+		needMore                              []bool
+		createdAt                             []time.Time
+		mergedAt                              []*time.Time
+	)
+	for _, row := range rows {
+		item := row.item
+		reviewers := make([]uuid.NullUUID, reviewersPerPR)
+		for i, reviewerID := range item.AssignedReviewers {
+			reviewers[i] = uuid.NullUUID{UUID: reviewerID, Valid: true}
+		}
+		var shadow uuid.NullUUID
+		if item.ShadowReviewer != nil {
+			shadow = uuid.NullUUID{UUID: *item.ShadowReviewer, Valid: true}
+		}
+
+		ids = append(ids, item.PullRequestID)
+		titles = append(titles, item.PullRequestName)
+		authorIDs = append(authorIDs, item.AuthorID)
+		teamIDs = append(teamIDs, row.teamID)
+		statuses = append(statuses, string(item.Status))
+		reviewer1IDs = append(reviewer1IDs, reviewers[0])
+		reviewer2IDs = append(reviewer2IDs, reviewers[1])
+		shadowIDs = append(shadowIDs, shadow)
+		needMore = append(needMore, item.Status == api.StatusOpen && len(item.AssignedReviewers) < reviewersPerPR)
+		createdAt = append(createdAt, *item.CreatedAt)
+		mergedAt = append(mergedAt, item.MergedAt)
+	}
+
+	inserted, err := queryIDs(ctx, q, `
+		INSERT INTO pull_request (id, title, author_id, team_id, status, reviewer1_id, reviewer2_id,
+			shadow_reviewer_id, need_more_reviewers, created_at, merged_at)
+		SELECT * FROM unnest($1::uuid[], $2::text[], $3::uuid[], $4::uuid[], $5::text[]::pr_status[],
+			$6::uuid[], $7::uuid[], $8::uuid[], $9::boolean[], $10::timestamptz[], $11::timestamptz[])
+		ON CONFLICT (id) DO NOTHING
+		RETURNING id
+	`, ids, titles, authorIDs, teamIDs, statuses, reviewer1IDs, reviewer2IDs, shadowIDs, needMore, createdAt, mergedAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert pull requests: %w", err)
+	}
+
+	conflicts = make(map[uuid.UUID]bool)
+	for _, id := range ids {
+		conflicts[id] = true
+	}
+	for _, id := range inserted {
+		delete(conflicts, id)
+	}
+
+	var (
+		assignPRs, assignReviewers, assignTeam []uuid.UUID
+		assignShadow                           []bool
+		filePRs, labelPRs                      []uuid.UUID
+		files, labels                          []string
+	)
+	for _, row := range rows {
+		item := row.item
+		if conflicts[item.PullRequestID] {
+			continue
+		}
+
+		for _, reviewerID := range item.AssignedReviewers {
+			assignPRs = append(assignPRs, item.PullRequestID)
+			assignReviewers = append(assignReviewers, reviewerID)
+			assignTeam = append(assignTeam, row.teamID)
+			assignShadow = append(assignShadow, false)
+		}
+		if item.ShadowReviewer != nil {
+			assignPRs = append(assignPRs, item.PullRequestID)
+			assignReviewers = append(assignReviewers, *item.ShadowReviewer)
+			assignTeam = append(assignTeam, row.teamID)
+			assignShadow = append(assignShadow, true)
+		}
+		for _, file := range item.ChangedFiles {
+			filePRs = append(filePRs, item.PullRequestID)
+			files = append(files, file)
+		}
+		for _, label := range item.Labels {
+			labelPRs = append(labelPRs, item.PullRequestID)
+			labels = append(labels, label)
+		}
+	}
+
+	_, err = q.ExecContext(ctx, `
+		INSERT INTO review_assignments (pull_request_id, reviewer_id, source, source_team_id, shadow, assigned_at)
+		SELECT pr_id, reviewer_id, 'team', team_id, shadow, $5
+		FROM unnest($1::uuid[], $2::uuid[], $3::uuid[], $4::boolean[]) AS a(pr_id, reviewer_id, team_id, shadow)
+	`, assignPRs, assignReviewers, assignTeam, assignShadow, now)
+	if err != nil {
+		return nil, fmt.Errorf("insert review assignments: %w", err)
+	}
+
+	_, err = q.ExecContext(ctx, `
+		INSERT INTO pull_request_files (pull_request_id, path)
+		SELECT * FROM unnest($1::uuid[], $2::text[])
+		ON CONFLICT DO NOTHING
+	`, filePRs, files)
+	if err != nil {
+		return nil, fmt.Errorf("insert changed files: %w", err)
+	}
+
+	_, err = q.ExecContext(ctx, `
+		INSERT INTO pull_request_labels (pull_request_id, label)
+		SELECT * FROM unnest($1::uuid[], $2::text[])
+		ON CONFLICT DO NOTHING
+	`, labelPRs, labels)
+	if err != nil {
+		return nil, fmt.Errorf("insert pull request labels: %w", err)
+	}
+
+	return conflicts, nil
+}
+
+func queryIDs(ctx context.Context, q querier, query string, args ...any) (ids []uuid.UUID, err error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
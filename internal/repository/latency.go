@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+// GetLatencyStats считает задержки по слитым PR в рабочих часах: время от создания PR
+// до слияния и время от назначения каждого ревьювера, дожившего до слияния.
+func (s *Storage) GetLatencyStats(ctx context.Context, teamName string, includeDescendants bool) (*api.LatencyStatsResponse, error) {
+	teamIDs, err := statsTeamIDs(ctx, s.db, teamName, includeDescendants)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT pr.id, pr.team_id, pr.created_at, pr.merged_at, a.reviewer_id, a.assigned_at
+		FROM pull_request pr
+		LEFT JOIN review_assignments a ON a.pull_request_id = pr.id
+			AND NOT a.shadow
+			AND (a.unassigned_at IS NULL OR a.unassigned_at >= pr.merged_at)
+		WHERE pr.status = 'MERGED'
+			AND pr.merged_at IS NOT NULL
+			AND ($1::uuid[] IS NULL OR pr.team_id = ANY($1))
+		ORDER BY pr.merged_at, pr.id
+	`, teamIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query merged pull requests: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	type mergedReview struct {
+		prID       uuid.UUID
+		teamID     *uuid.UUID
+		createdAt  time.Time
+		mergedAt   time.Time
+		reviewerID *uuid.UUID
+		assignedAt *time.Time
+	}
+	var reviews []mergedReview
+	for rows.Next() {
+		var r mergedReview
+		if err = rows.Scan(&r.prID, &r.teamID, &r.createdAt, &r.mergedAt, &r.reviewerID, &r.assignedAt); err != nil {
+			return nil, fmt.Errorf("scan merged pull request: %w", err)
+		}
+		reviews = append(reviews, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	var (
+		schedules = newScheduleCache(s.db)
+		merge     []float64
+		seen      = make(map[uuid.UUID]bool)
+		reviewers = make(map[uuid.UUID][]float64)
+	)
+	for _, r := range reviews {
+		// PR без команды считается по расписанию ревьювера или круглосуточно
+		teamID := uuid.Nil
+		if r.teamID != nil {
+			teamID = *r.teamID
+		}
+
+		if !seen[r.prID] {
+			seen[r.prID] = true
+			schedule, err := schedules.get(ctx, uuid.Nil, teamID)
+			if err != nil {
+				return nil, err
+			}
+			merge = append(merge, schedule.Between(r.createdAt, r.mergedAt).Hours())
+		}
+
+		if r.reviewerID == nil {
+			continue
+		}
+		schedule, err := schedules.get(ctx, *r.reviewerID, teamID)
+		if err != nil {
+			return nil, err
+		}
+		reviewers[*r.reviewerID] = append(reviewers[*r.reviewerID], schedule.Between(*r.assignedAt, r.mergedAt).Hours())
+	}
+
+	resp := &api.LatencyStatsResponse{
+		TeamName:  teamName,
+		Merge:     summarizeLatency(merge),
+		Reviewers: make([]api.ReviewerLatency, 0, len(reviewers)),
+	}
+	for userID, hours := range reviewers {
+		resp.Reviewers = append(resp.Reviewers, api.ReviewerLatency{UserID: userID, LatencySummary: summarizeLatency(hours)})
+	}
+	slices.SortFunc(resp.Reviewers, func(a, b api.ReviewerLatency) int {
+		return slices.Compare(a.UserID[:], b.UserID[:])
+	})
+
+	return resp, nil
+}
+
+// summarizeLatency считает среднее, медиану и 90-й перцентиль (по ближайшему рангу).
+func summarizeLatency(hours []float64) api.LatencySummary {
+	if len(hours) == 0 {
+		return api.LatencySummary{}
+	}
+
+	sorted := slices.Clone(hours)
+	slices.Sort(sorted)
+
+	var total float64
+	for _, h := range sorted {
+		total += h
+	}
+
+	percentile := func(p int) float64 {
+		rank := (p*len(sorted) + 99) / 100
+		return sorted[max(rank-1, 0)]
+	}
+
+	return api.LatencySummary{
+		Count:       len(sorted),
+		MeanHours:   total / float64(len(sorted)),
+		MedianHours: percentile(50),
+		P90Hours:    percentile(90),
+	}
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+// ListPullRequests возвращает страницу PR по фильтру от новых к старым. Курсор — позиция
+// (created_at, id) последнего PR страницы, поэтому вставка новых PR не сдвигает следующие страницы.
+func (s *Storage) ListPullRequests(ctx context.Context, filter *api.PullRequestFilter) (*api.PullRequestListResponse, error) {
+	var (
+		afterCreated *time.Time
+		afterID      *uuid.UUID
+	)
+	if filter.Cursor != "" {
+		createdAt, id, err := decodeListCursor(filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		afterCreated, afterID = &createdAt, &id
+	}
+
+	teamIDs, err := statsTeamIDs(ctx, s.db, filter.TeamName, filter.IncludeDescendants)
+	if err != nil {
+		return nil, err
+	}
+
+	var status *string
+	if filter.Status != "" {
+		value := string(filter.Status)
+		status = &value
+	}
+
+	// Запрашивается на одну строку больше, чтобы узнать, есть ли следующая страница
+	rows, err := s.queryPullRequests(ctx, `
+		SELECT pr.id, pr.title, pr.author_id, t.name, pr.status, pr.reviewer1_id, pr.reviewer2_id,
+			pr.shadow_reviewer_id, pr.created_at, pr.merged_at, NULL::uuid
+		FROM pull_request pr
+		LEFT JOIN teams t ON t.id = pr.team_id
+		WHERE ($1::uuid[] IS NULL OR pr.team_id = ANY($1))
+			AND ($2::pr_status IS NULL OR pr.status = $2)
+			AND ($3::uuid IS NULL OR pr.author_id = $3)
+			AND ($4::uuid IS NULL OR $4 IN (pr.reviewer1_id, pr.reviewer2_id, pr.shadow_reviewer_id))
+			AND ($5::timestamptz IS NULL OR pr.created_at >= $5)
+			AND ($6::timestamptz IS NULL OR pr.created_at < $6)
+			AND ($7::boolean IS NULL OR pr.need_more_reviewers = $7)
+			AND ($8::timestamptz IS NULL OR (pr.created_at, pr.id) < ($8, $9::uuid))
+		ORDER BY pr.created_at DESC, pr.id DESC
+		LIMIT $10
+	`, teamIDs, status, filter.AuthorID, filter.ReviewerID, filter.CreatedFrom, filter.CreatedTo,
+		filter.NeedMoreReviewers, afterCreated, afterID, filter.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.PullRequestListResponse{PullRequests: make([]api.PullRequest, 0, len(rows))}
+	for _, row := range rows {
+		resp.PullRequests = append(resp.PullRequests, row.pr)
+	}
+	if len(resp.PullRequests) > filter.Limit {
+		resp.PullRequests = resp.PullRequests[:filter.Limit]
+		last := resp.PullRequests[len(resp.PullRequests)-1]
+		resp.NextCursor = encodeListCursor(last.CreatedAt, last.PullRequestID)
+	}
+
+	ids := make([]uuid.UUID, len(resp.PullRequests))
+	for i, pr := range resp.PullRequests {
+		ids[i] = pr.PullRequestID
+	}
+	assignments, err := s.AssignmentsByPullRequestIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range resp.PullRequests {
+		resp.PullRequests[i].Assignments = assignments[resp.PullRequests[i].PullRequestID]
+	}
+
+	return resp, nil
+}
+
+func encodeListCursor(createdAt time.Time, id uuid.UUID) string {
+	raw := createdAt.UTC().Format(time.RFC3339Nano) + "|" + id.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeListCursor(cursor string) (time.Time, uuid.UUID, error) {
+	invalid := api.NewAPIError(api.ErrInvalidParameter, "invalid cursor")
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, uuid.Nil, invalid
+	}
+	createdPart, idPart, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return time.Time{}, uuid.Nil, invalid
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, createdPart)
+	if err != nil {
+		return time.Time{}, uuid.Nil, invalid
+	}
+	id, err := uuid.Parse(idPart)
+	if err != nil {
+		return time.Time{}, uuid.Nil, invalid
+	}
+
+	return createdAt, id, nil
+}
@@ -0,0 +1,98 @@
+package repository_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+func TestPullRequestMergeConcurrent(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	storage := newTestStorage(t, ctx)
+
+	team := &api.Team{
+		TeamName: "backend",
+		Members: []api.TeamMember{
+			{UserID: uuid.New(), Username: "Ann", IsActive: true},
+			{UserID: uuid.New(), Username: "Bob", IsActive: true},
+			{UserID: uuid.New(), Username: "Dan", IsActive: true},
+		},
+	}
+	if err := storage.UpdateTeam(ctx, team); err != nil {
+		t.Fatalf("UpdateTeam: %v", err)
+	}
+	if err := storage.SetTeamSLA(ctx, &api.TeamSLA{TeamName: team.TeamName, FirstReviewHours: 1}); err != nil {
+		t.Fatalf("SetTeamSLA: %v", err)
+	}
+
+	created, err := storage.PullRequestCreate(ctx, &api.PullRequestCreateRequest{
+		PullRequestShort: api.PullRequestShort{
+			PullRequestID:   uuid.New(),
+			PullRequestName: "Add retries",
+			AuthorID:        team.Members[0].UserID,
+		},
+		TeamName: team.TeamName,
+	})
+	if err != nil {
+		t.Fatalf("PullRequestCreate: %v", err)
+	}
+
+	const merges = 8
+	var (
+		wg      sync.WaitGroup
+		results [merges]*api.PullRequest
+		errs    [merges]error
+	)
+	for i := range merges {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = storage.PullRequestMerge(ctx, created.PullRequestID)
+		}()
+	}
+	wg.Wait()
+
+	for i := range merges {
+		if errs[i] != nil {
+			t.Fatalf("PullRequestMerge: %v", errs[i])
+		}
+		if results[i].Status != api.StatusMerged || results[i].MergedAt == nil {
+			t.Fatalf("got %+v, want a merged pull request", results[i])
+		}
+		if !results[i].MergedAt.Equal(*results[0].MergedAt) {
+			t.Fatalf("merged_at differs between merges: %v and %v", results[i].MergedAt, results[0].MergedAt)
+		}
+		if len(results[i].Assignments) != len(created.Assignments) {
+			t.Fatalf("got %d assignments after merge, want %d", len(results[i].Assignments), len(created.Assignments))
+		}
+	}
+
+	events, err := storage.EventsAfter(ctx, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var merged int
+	for _, e := range events {
+		if e.Type == api.EventPullRequestMerged {
+			merged++
+		}
+	}
+	if merged != 1 {
+		t.Fatalf("got %d %s events, want 1", merged, api.EventPullRequestMerged)
+	}
+
+	// Назначения закрыты вместе с merge: SLA по ним больше не срабатывает
+	report, err := storage.CheckSLA(ctx, time.Now().Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("CheckSLA: %v", err)
+	}
+	if report.Breached != 0 {
+		t.Fatalf("got %d SLA breaches after merge, want 0", report.Breached)
+	}
+}
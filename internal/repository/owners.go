@@ -0,0 +1,286 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/F3dosik/PRS.git/internal/codeowners"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+type ownerRule struct {
+	pattern string
+	users   []uuid.UUID
+	teamIDs []uuid.UUID
+	teams   []string
+}
+
+func (s *Storage) GetTeamOwners(ctx context.Context, teamName string) (*api.TeamOwners, error) {
+	teamID, err := teamIDByName(ctx, s.db, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := loadOwnerRules(ctx, s.db, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := &api.TeamOwners{
+		TeamName: teamName,
+		Rules:    make([]api.CodeOwnerRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		owners.Rules = append(owners.Rules, api.CodeOwnerRule{
+			Pattern: rule.pattern,
+			Users:   rule.users,
+			Teams:   rule.teams,
+		})
+	}
+
+	return owners, nil
+}
+
+// SetTeamOwners полностью заменяет правила владения кодом команды; порядок правил значим.
+func (s *Storage) SetTeamOwners(ctx context.Context, owners *api.TeamOwners) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	teamID, err := teamIDByName(ctx, tx, owners.TeamName)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM code_owner_rules
+		WHERE team_id = $1
+	`, teamID)
+	if err != nil {
+		return fmt.Errorf("delete code owner rules: %w", err)
+	}
+
+	for i, rule := range owners.Rules {
+		if err = codeowners.Validate(rule.Pattern); err != nil {
+			return api.NewAPIError(api.ErrInvalidParameter, "invalid pattern: "+rule.Pattern)
+		}
+
+		var ruleID int64
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO code_owner_rules (team_id, position, pattern)
+			VALUES ($1, $2, $3)
+			RETURNING id
+		`, teamID, i, rule.Pattern).Scan(&ruleID)
+		if err != nil {
+			return fmt.Errorf("insert code owner rule: %w", err)
+		}
+
+		for _, userID := range rule.Users {
+			var exist bool
+			err = tx.QueryRowContext(ctx, `
+				SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)
+			`, userID).Scan(&exist)
+			if err != nil {
+				return fmt.Errorf("query exist user_id: %w", err)
+			}
+			if !exist {
+				return api.NewAPIError(api.ErrNotFound, "owner user not found: "+userID.String())
+			}
+
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO code_owner_rule_owners (rule_id, user_id)
+				VALUES ($1, $2)
+			`, ruleID, userID)
+			if err != nil {
+				return fmt.Errorf("insert code owner: %w", err)
+			}
+		}
+
+		for _, ownerTeam := range rule.Teams {
+			ownerTeamID, err := teamIDByName(ctx, tx, ownerTeam)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO code_owner_rule_owners (rule_id, owner_team_id)
+				VALUES ($1, $2)
+			`, ruleID, ownerTeamID)
+			if err != nil {
+				return fmt.Errorf("insert code owner: %w", err)
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *Storage) DeleteTeamOwners(ctx context.Context, teamName string) error {
+	teamID, err := teamIDByName(ctx, s.db, teamName)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		DELETE FROM code_owner_rules
+		WHERE team_id = $1
+	`, teamID)
+	if err != nil {
+		return fmt.Errorf("delete code owner rules: %w", err)
+	}
+
+	return nil
+}
+
+func loadOwnerRules(ctx context.Context, q querier, teamID uuid.UUID) ([]ownerRule, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT r.id, r.pattern, o.user_id, o.owner_team_id, t.name
+		FROM code_owner_rules r
+		LEFT JOIN code_owner_rule_owners o ON o.rule_id = r.id
+		LEFT JOIN teams t ON t.id = o.owner_team_id
+		WHERE r.team_id = $1
+		ORDER BY r.position
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("query code owner rules: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	var (
+		rules  []ownerRule
+		lastID int64
+	)
+	for rows.Next() {
+		var (
+			ruleID      int64
+			pattern     string
+			userID      *uuid.UUID
+			ownerTeamID *uuid.UUID
+			ownerTeam   *string
+		)
+		if err = rows.Scan(&ruleID, &pattern, &userID, &ownerTeamID, &ownerTeam); err != nil {
+			return nil, fmt.Errorf("scan code owner rule: %w", err)
+		}
+
+		if len(rules) == 0 || ruleID != lastID {
+			rules = append(rules, ownerRule{pattern: pattern})
+			lastID = ruleID
+		}
+		rule := &rules[len(rules)-1]
+		if userID != nil {
+			rule.users = append(rule.users, *userID)
+		}
+		if ownerTeamID != nil {
+			rule.teamIDs = append(rule.teamIDs, *ownerTeamID)
+			rule.teams = append(rule.teams, *ownerTeam)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return rules, nil
+}
+
+func loadChangedFiles(ctx context.Context, q querier, prID uuid.UUID) ([]string, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT path FROM pull_request_files
+		WHERE pull_request_id = $1
+		ORDER BY path
+	`, prID)
+	if err != nil {
+		return nil, fmt.Errorf("query changed files: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	var files []string
+	for rows.Next() {
+		var file string
+		if err = rows.Scan(&file); err != nil {
+			return nil, fmt.Errorf("scan changed file: %w", err)
+		}
+		files = append(files, file)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return files, nil
+}
+
+// ownersPool собирает владельцев изменённых файлов PR по правилам команды PR.
+// Возвращает nil, если у PR нет файлов или ни одно правило не назначило владельцев.
+func ownersPool(ctx context.Context, q querier, prID, teamID uuid.UUID) (*reviewerPool, error) {
+	files, err := loadChangedFiles(ctx, q, prID)
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+
+	rules, err := loadOwnerRules(ctx, q, teamID)
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+
+	patterns := make([]string, len(rules))
+	for i, rule := range rules {
+		patterns[i] = rule.pattern
+	}
+
+	pool := &reviewerPool{source: api.SourceCodeOwner, userIDs: []uuid.UUID{}, teamIDs: []uuid.UUID{}}
+	seenUsers := make(map[uuid.UUID]bool)
+	seenTeams := make(map[uuid.UUID]bool)
+	for _, file := range files {
+		i := codeowners.LastMatch(patterns, file)
+		if i < 0 {
+			continue
+		}
+		for _, userID := range rules[i].users {
+			if !seenUsers[userID] {
+				seenUsers[userID] = true
+				pool.userIDs = append(pool.userIDs, userID)
+			}
+		}
+		for _, ownerTeamID := range rules[i].teamIDs {
+			if !seenTeams[ownerTeamID] {
+				seenTeams[ownerTeamID] = true
+				pool.teamIDs = append(pool.teamIDs, ownerTeamID)
+			}
+		}
+	}
+
+	if len(pool.userIDs) == 0 && len(pool.teamIDs) == 0 {
+		return nil, nil
+	}
+
+	return pool, nil
+}
+
+func saveChangedFiles(ctx context.Context, q querier, prID uuid.UUID, files []string) error {
+	for _, file := range files {
+		_, err := q.ExecContext(ctx, `
+			INSERT INTO pull_request_files (pull_request_id, path)
+			VALUES ($1, $2)
+			ON CONFLICT DO NOTHING
+		`, prID, file)
+		if err != nil {
+			return fmt.Errorf("insert changed file: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+// GetPairStats возвращает матрицу «автор × ревьювер»: сколько PR автора ревьюил каждый
+// пользователь по истории назначений, включая снятые. Теневые назначения не учитываются.
+func (s *Storage) GetPairStats(ctx context.Context, teamName string, includeDescendants bool) (*api.PairStatsResponse, error) {
+	teamIDs, err := statsTeamIDs(ctx, s.db, teamName, includeDescendants)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT pr.author_id, a.reviewer_id, COUNT(DISTINCT pr.id)
+		FROM review_assignments a
+		JOIN pull_request pr ON pr.id = a.pull_request_id
+		WHERE NOT a.shadow
+			AND ($1::uuid[] IS NULL OR pr.team_id = ANY($1))
+		GROUP BY pr.author_id, a.reviewer_id
+	`, teamIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query review pairs: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	type pair struct {
+		author   uuid.UUID
+		reviewer uuid.UUID
+		count    int
+	}
+	var pairs []pair
+	for rows.Next() {
+		var p pair
+		if err = rows.Scan(&p.author, &p.reviewer, &p.count); err != nil {
+			return nil, fmt.Errorf("scan review pair: %w", err)
+		}
+		pairs = append(pairs, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	stats := &api.PairStatsResponse{
+		TeamName:  teamName,
+		Authors:   []uuid.UUID{},
+		Reviewers: []uuid.UUID{},
+	}
+	for _, p := range pairs {
+		if !slices.Contains(stats.Authors, p.author) {
+			stats.Authors = append(stats.Authors, p.author)
+		}
+		if !slices.Contains(stats.Reviewers, p.reviewer) {
+			stats.Reviewers = append(stats.Reviewers, p.reviewer)
+		}
+	}
+	compare := func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) }
+	slices.SortFunc(stats.Authors, compare)
+	slices.SortFunc(stats.Reviewers, compare)
+
+	stats.Matrix = make([][]int, len(stats.Authors))
+	for i := range stats.Matrix {
+		stats.Matrix[i] = make([]int, len(stats.Reviewers))
+	}
+	for _, p := range pairs {
+		i, _ := slices.BinarySearchFunc(stats.Authors, p.author, compare)
+		j, _ := slices.BinarySearchFunc(stats.Reviewers, p.reviewer, compare)
+		stats.Matrix[i][j] = p.count
+	}
+
+	return stats, nil
+}
+
+// statsTeamIDs возвращает команды, которыми ограничивается статистика; nil означает все команды.
+func statsTeamIDs(ctx context.Context, q querier, teamName string, includeDescendants bool) ([]uuid.UUID, error) {
+	if teamName == "" {
+		return nil, nil
+	}
+
+	teamID, err := teamIDByName(ctx, q, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if includeDescendants {
+		return teamSubtreeIDs(ctx, q, teamID)
+	}
+
+	return []uuid.UUID{teamID}, nil
+}
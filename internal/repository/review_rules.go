@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+type reviewRules struct {
+	requireSenior   bool
+	shadowJunior    bool
+	rotationWindow  int
+	rotationPenalty float64
+}
+
+func (s *Storage) GetTeamReviewRules(ctx context.Context, teamName string) (*api.TeamReviewRules, error) {
+	teamID, err := teamIDByName(ctx, s.db, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := loadReviewRules(ctx, s.db, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.TeamReviewRules{
+		TeamName:        teamName,
+		RequireSenior:   rules.requireSenior,
+		ShadowJunior:    rules.shadowJunior,
+		RotationWindow:  rules.rotationWindow,
+		RotationPenalty: rules.rotationPenalty,
+	}, nil
+}
+
+// SetTeamReviewRules заменяет правила команды. Правила применяются к новым назначениям;
+// уже назначенные ревьюверы не пересматриваются.
+func (s *Storage) SetTeamReviewRules(ctx context.Context, rules *api.TeamReviewRules) error {
+	if rules.RotationWindow < 0 || rules.RotationPenalty < 0 {
+		return api.NewAPIError(api.ErrInvalidParameter, "rotation_window and rotation_penalty must not be negative")
+	}
+
+	teamID, err := teamIDByName(ctx, s.db, rules.TeamName)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO team_review_rules (team_id, require_senior, shadow_junior, rotation_window, rotation_penalty)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (team_id) DO UPDATE
+		SET require_senior = EXCLUDED.require_senior,
+			shadow_junior = EXCLUDED.shadow_junior,
+			rotation_window = EXCLUDED.rotation_window,
+			rotation_penalty = EXCLUDED.rotation_penalty
+	`, teamID, rules.RequireSenior, rules.ShadowJunior, rules.RotationWindow, rules.RotationPenalty)
+	if err != nil {
+		return fmt.Errorf("upsert team review rules: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) SetUserSeniority(ctx context.Context, userID uuid.UUID, seniority api.Seniority) (*api.User, error) {
+	if !seniority.Valid() {
+		return nil, api.NewAPIError(api.ErrInvalidParameter, "unknown seniority: "+string(seniority))
+	}
+
+	res, err := s.db.ExecContext(ctx, `
+		UPDATE users
+		SET seniority = $1
+		WHERE id = $2
+	`, seniority, userID)
+	if err != nil {
+		return nil, fmt.Errorf("update user seniority: %w", err)
+	}
+	if affected, err := res.RowsAffected(); err != nil {
+		return nil, fmt.Errorf("rows affected: %w", err)
+	} else if affected == 0 {
+		return nil, api.NewAPIError(api.ErrNotFound, "user not found")
+	}
+
+	return loadUser(ctx, s.db, userID)
+}
+
+// loadReviewRules возвращает правила команды; отсутствие записи означает правила по умолчанию.
+func loadReviewRules(ctx context.Context, q querier, teamID uuid.UUID) (reviewRules, error) {
+	var rules reviewRules
+	err := q.QueryRowContext(ctx, `
+		SELECT require_senior, shadow_junior, rotation_window, rotation_penalty
+		FROM team_review_rules
+		WHERE team_id = $1
+	`, teamID).Scan(&rules.requireSenior, &rules.shadowJunior, &rules.rotationWindow, &rules.rotationPenalty)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return reviewRules{}, fmt.Errorf("query team review rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// hasSenior сообщает, есть ли среди пользователей senior.
+func hasSenior(ctx context.Context, q querier, userIDs []uuid.UUID) (bool, error) {
+	if len(userIDs) == 0 {
+		return false, nil
+	}
+
+	var exist bool
+	err := q.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM users
+			WHERE id = ANY($1)
+				AND seniority = 'senior'
+		)
+	`, userIDs).Scan(&exist)
+	if err != nil {
+		return false, fmt.Errorf("query senior reviewers: %w", err)
+	}
+
+	return exist, nil
+}
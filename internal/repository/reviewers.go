@@ -0,0 +1,595 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/assignment"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+const reviewersPerPR = 2
+
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// reviewerPool — один источник кандидатов: владельцы кода, команда PR, резервная команда (вместе
+// с подкомандами для уровней иерархии) или все активные пользователи (teamIDs и userIDs равны nil).
+type reviewerPool struct {
+	source   api.ReviewerSource
+	teamIDs  []uuid.UUID
+	userIDs  []uuid.UUID
+	teamName *string
+}
+
+// loadReviewerPools возвращает пулы в порядке предпочтения: владельцы изменённых файлов,
+// команда PR и развёрнутая для неё цепочка резервных пулов.
+func loadReviewerPools(ctx context.Context, q querier, prID, teamID uuid.UUID) ([]reviewerPool, error) {
+	var teamName string
+	err := q.QueryRowContext(ctx, `
+		SELECT name FROM teams
+		WHERE id = $1
+	`, teamID).Scan(&teamName)
+	if err != nil {
+		return nil, fmt.Errorf("query team: %w", err)
+	}
+
+	var pools []reviewerPool
+	owners, err := ownersPool(ctx, q, prID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if owners != nil {
+		pools = append(pools, *owners)
+	}
+	pools = append(pools, reviewerPool{source: api.SourceTeam, teamIDs: []uuid.UUID{teamID}, teamName: &teamName})
+
+	chain, err := loadFallbackChain(ctx, q, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, link := range chain {
+		switch link.kind {
+		case api.FallbackTeam:
+			pools = append(pools, reviewerPool{
+				source:   api.SourceFallbackTeam,
+				teamIDs:  []uuid.UUID{*link.teamID},
+				teamName: link.teamName,
+			})
+		case api.FallbackAncestors:
+			ancestors, err := teamAncestors(ctx, q, teamID)
+			if err != nil {
+				return nil, err
+			}
+			// Пул уровня иерархии — все участники поддерева предка, от ближайшего к корню
+			for _, ancestor := range ancestors {
+				subtree, err := teamSubtreeIDs(ctx, q, ancestor.id)
+				if err != nil {
+					return nil, err
+				}
+				name := ancestor.name
+				pools = append(pools, reviewerPool{
+					source:   api.SourceFallbackTeam,
+					teamIDs:  subtree,
+					teamName: &name,
+				})
+			}
+		case api.FallbackAnyActive:
+			pools = append(pools, reviewerPool{source: api.SourceAnyActive})
+		}
+	}
+
+	return pools, nil
+}
+
+// pickRequest описывает один выбор ревьюверов для PR.
+type pickRequest struct {
+	prID     uuid.UUID
+	teamID   uuid.UUID
+	authorID uuid.UUID
+	exclude  []uuid.UUID // Автор, текущие и теневой ревьюверы
+	keep     []uuid.UUID // Ревьюверы, остающиеся на PR: по ним проверяется правило о senior
+	limit    int
+	shadow   bool // Нужен теневой ревьювер-junior
+	reason   api.DecisionReason
+}
+
+// pickReviewers выбирает до req.limit ревьюверов, не входящих в exclude: сначала среди владельцев
+// изменённых файлов, затем из команды PR и по цепочке резервных пулов, пока не наберётся нужное количество.
+// Выбор делает assignment.Decide по снимку пулов и seed'у с учётом правил команды,
+// и решение сохраняется для последующего разбора. Теневой ревьювер, если выбран, идёт последним.
+// exclude всегда должен содержать хотя бы автора PR.
+func (s *Storage) pickReviewers(ctx context.Context, tx *sql.Tx, req pickRequest) ([]api.ReviewAssignment, error) {
+	pools, err := loadReviewerPools(ctx, tx, req.prID, req.teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := loadLabels(ctx, tx, req.prID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := loadReviewRules(ctx, tx, req.teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	in := assignment.Input{
+		Labels:        labels,
+		Exclude:       req.exclude,
+		Limit:         req.limit,
+		Weights:       assignment.DefaultWeights,
+		RequireSenior: rules.requireSenior,
+		Shadow:        req.shadow,
+	}
+	if rules.rotationWindow > 0 {
+		in.Weights.Rotation = rules.rotationPenalty
+	}
+	if in.RequireSenior {
+		if in.HasSenior, err = hasSenior(ctx, tx, req.keep); err != nil {
+			return nil, err
+		}
+	}
+
+	seed := s.seeds()
+	decision := assignment.Decide(in, seed)
+
+	// Пулы загружаются, пока не будут заняты все слоты: решение по префиксу пулов
+	// совпадает с решением по всем пулам, а снимок остаётся компактным
+	for _, pool := range pools {
+		if decision.Complete(in) {
+			break
+		}
+
+		candidates, err := poolCandidates(ctx, tx, pool, req, rules.rotationWindow)
+		if err != nil {
+			return nil, err
+		}
+		in.Pools = append(in.Pools, assignment.Pool{
+			Source:     string(pool.source),
+			TeamName:   pool.teamName,
+			Candidates: candidates,
+		})
+		decision = assignment.Decide(in, seed)
+	}
+
+	// Безуспешные попытки фонового добора повторяются каждый проход, их не сохраняем
+	if len(decision.Picks) == 0 && decision.Shadow == nil && req.reason == api.DecisionBackfill {
+		return nil, nil
+	}
+
+	decisionID, err := saveDecision(ctx, tx, req.prID, req.reason, in, decision)
+	if err != nil {
+		return nil, err
+	}
+
+	picks := decision.Picks
+	if decision.Shadow != nil {
+		picks = append(picks, *decision.Shadow)
+	}
+	picked := make([]api.ReviewAssignment, 0, len(picks))
+	for i, pick := range picks {
+		pool := in.Pools[pick.Pool]
+		picked = append(picked, api.ReviewAssignment{
+			ReviewerID: pick.UserID,
+			Source:     api.ReviewerSource(pool.Source),
+			SourceTeam: pool.TeamName,
+			DecisionID: &decisionID,
+			Shadow:     i >= len(decision.Picks),
+		})
+	}
+
+	return picked, nil
+}
+
+// splitShadow отделяет теневого ревьювера от обязательных.
+func splitShadow(picked []api.ReviewAssignment) ([]api.ReviewAssignment, *uuid.UUID) {
+	if n := len(picked); n > 0 && picked[n-1].Shadow {
+		shadow := picked[n-1].ReviewerID
+		return picked[:n-1], &shadow
+	}
+	return picked, nil
+}
+
+// poolCandidates возвращает пользователей пула с их навыками и числом открытых ревью: явно
+// перечисленных и участников команд пула, в том числе неактивных; пул без команд и пользователей
+// означает всех активных. Кандидат активен, если активны и он сам, и хотя бы одно его членство в командах пула.
+// Для ротации считается, в скольких из последних window PR автора (кроме текущего) кандидат был ревьювером.
+func poolCandidates(ctx context.Context, tx *sql.Tx, pool reviewerPool, req pickRequest, window int) ([]assignment.Candidate, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT u.id, u.seniority,
+			u.is_active AND (
+				($1::uuid[] IS NULL AND $2::uuid[] IS NULL)
+				OR u.id = ANY($2)
+				OR EXISTS (
+					SELECT 1 FROM team_memberships m
+					WHERE m.user_id = u.id
+						AND m.team_id = ANY($1)
+						AND m.is_active
+				)
+			),
+			COALESCE((
+				SELECT string_agg(s.skill, ',' ORDER BY s.skill)
+				FROM user_skills s
+				WHERE s.user_id = u.id
+			), ''),
+			(
+				SELECT COUNT(*) FROM pull_request p
+				WHERE p.status = 'OPEN'
+					AND (p.reviewer1_id = u.id OR p.reviewer2_id = u.id OR p.shadow_reviewer_id = u.id)
+			),
+			(
+				SELECT COUNT(DISTINCT a.pull_request_id) FROM review_assignments a
+				WHERE a.reviewer_id = u.id
+					AND NOT a.shadow
+					AND a.pull_request_id IN (
+						SELECT p.id FROM pull_request p
+						WHERE p.author_id = $3
+							AND p.id <> $4
+						ORDER BY p.created_at DESC
+						LIMIT $5
+					)
+			)
+		FROM users u
+		WHERE ($1::uuid[] IS NULL AND $2::uuid[] IS NULL AND u.is_active)
+			OR u.id = ANY($2)
+			OR EXISTS (
+				SELECT 1 FROM team_memberships m
+				WHERE m.user_id = u.id
+					AND m.team_id = ANY($1)
+			)
+		ORDER BY u.id
+	`, pool.teamIDs, pool.userIDs, req.authorID, req.prID, window)
+	if err != nil {
+		return nil, fmt.Errorf("query reviewers: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	candidates := []assignment.Candidate{}
+	for rows.Next() {
+		var (
+			c      assignment.Candidate
+			skills string
+		)
+		if err = rows.Scan(&c.UserID, &c.Seniority, &c.Active, &skills, &c.Load, &c.Recent); err != nil {
+			return nil, fmt.Errorf("scan reviewer: %w", err)
+		}
+		if skills != "" {
+			c.Skills = strings.Split(skills, ",")
+		}
+		candidates = append(candidates, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return candidates, nil
+}
+
+// recordAssignments сохраняет назначения и публикует по событию review.assigned на каждое.
+// replaced — снятый ревьювер, если назначение его заменяет.
+func recordAssignments(ctx context.Context, tx *sql.Tx, prID uuid.UUID, assignments []api.ReviewAssignment, reason api.DecisionReason, replaced *uuid.UUID) error {
+	if len(assignments) == 0 {
+		return nil
+	}
+
+	var (
+		title    string
+		authorID uuid.UUID
+		teamID   *uuid.UUID
+	)
+	err := tx.QueryRowContext(ctx, `
+		SELECT title, author_id, team_id FROM pull_request
+		WHERE id = $1
+	`, prID).Scan(&title, &authorID, &teamID)
+	if err != nil {
+		return fmt.Errorf("query pull request: %w", err)
+	}
+
+	for _, a := range assignments {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO review_assignments (pull_request_id, reviewer_id, source, source_team_id, decision_id, shadow)
+			VALUES ($1, $2, $3, (SELECT id FROM teams WHERE name = $4), $5, $6)
+		`, prID, a.ReviewerID, a.Source, a.SourceTeam, a.DecisionID, a.Shadow)
+		if err != nil {
+			return fmt.Errorf("insert review assignment: %w", err)
+		}
+
+		reviewerID := a.ReviewerID
+		err = recordEvent(ctx, tx, event{
+			eventType:     api.EventReviewAssigned,
+			pullRequestID: &prID,
+			teamID:        teamID,
+			userID:        &reviewerID,
+			payload: api.ReviewAssignedPayload{
+				PullRequestID:      prID,
+				PullRequestName:    title,
+				AuthorID:           authorID,
+				ReviewerID:         reviewerID,
+				Reason:             reason,
+				Shadow:             a.Shadow,
+				ReplacedReviewerID: replaced,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func closeAssignment(ctx context.Context, tx *sql.Tx, prID, reviewerID uuid.UUID) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE review_assignments
+		SET unassigned_at = now()
+		WHERE pull_request_id = $1
+			AND reviewer_id = $2
+			AND unassigned_at IS NULL
+	`, prID, reviewerID)
+	if err != nil {
+		return fmt.Errorf("close review assignment: %w", err)
+	}
+
+	return nil
+}
+
+// loadAssignments возвращает действующие назначения PR в порядке их создания.
+// У смерженного PR действующими считаются назначения, закрытые самим merge.
+func loadAssignments(ctx context.Context, q querier, prID uuid.UUID) ([]api.ReviewAssignment, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT a.reviewer_id, a.source, t.name, a.decision_id, a.shadow
+		FROM review_assignments a
+		JOIN pull_request pr ON pr.id = a.pull_request_id
+		LEFT JOIN teams t ON t.id = a.source_team_id
+		WHERE a.pull_request_id = $1
+			AND (a.unassigned_at IS NULL OR a.unassigned_at = pr.merged_at)
+		ORDER BY a.id
+	`, prID)
+	if err != nil {
+		return nil, fmt.Errorf("query review assignments: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	var assignments []api.ReviewAssignment
+	for rows.Next() {
+		var a api.ReviewAssignment
+		if err = rows.Scan(&a.ReviewerID, &a.Source, &a.SourceTeam, &a.DecisionID, &a.Shadow); err != nil {
+			return nil, fmt.Errorf("scan review assignment: %w", err)
+		}
+		assignments = append(assignments, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return assignments, nil
+}
+
+func reviewerIDs(assignments []api.ReviewAssignment) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(assignments))
+	for _, a := range assignments {
+		ids = append(ids, a.ReviewerID)
+	}
+	return ids
+}
+
+// FillReviewers добирает ревьюверов в открытые PR с need_more_reviewers.
+// Пустой teamName означает обход всех команд.
+func (s *Storage) FillReviewers(ctx context.Context, teamName string) ([]api.PullRequest, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var teamIDs []uuid.UUID
+	if teamName != "" {
+		id, err := teamIDByName(ctx, tx, teamName)
+		if err != nil {
+			return nil, err
+		}
+		teamIDs = []uuid.UUID{id}
+	}
+
+	prs, err := s.fillReviewers(ctx, tx, teamIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return prs, tx.Commit()
+}
+
+// teamsReachingMembers возвращает команды, в пулы кандидатов которых попадают пользователи userIDs:
+// их команды, команды с резервом на одну из них, команды с резервом ancestors, чьи предки включают
+// одну из них в поддерево, и команды с резервом any_active.
+func teamsReachingMembers(ctx context.Context, q querier, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.QueryContext(ctx, `
+		WITH RECURSIVE member_teams AS (
+			SELECT DISTINCT team_id AS id FROM team_memberships
+			WHERE user_id = ANY($1)
+		), ancestors AS (
+			SELECT t.parent_id AS id FROM teams t
+			JOIN member_teams m ON m.id = t.id
+			WHERE t.parent_id IS NOT NULL
+			UNION
+			SELECT t.parent_id
+			FROM teams t
+			JOIN ancestors a ON t.id = a.id
+			WHERE t.parent_id IS NOT NULL
+		), below AS (
+			SELECT t.id FROM teams t
+			JOIN ancestors a ON t.parent_id = a.id
+			UNION
+			SELECT t.id FROM teams t
+			JOIN below b ON t.parent_id = b.id
+		)
+		SELECT id FROM member_teams
+		UNION
+		SELECT f.team_id FROM team_fallbacks f
+		JOIN member_teams m ON m.id = f.fallback_team_id
+		WHERE f.kind = 'team'
+		UNION
+		SELECT f.team_id FROM team_fallbacks f
+		JOIN below b ON b.id = f.team_id
+		WHERE f.kind = 'ancestors'
+		UNION
+		SELECT team_id FROM team_fallbacks
+		WHERE kind = 'any_active'
+	`, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query teams reaching members: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	var teamIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan team: %w", err)
+		}
+		teamIDs = append(teamIDs, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return teamIDs, nil
+}
+
+type understaffedPR struct {
+	pr          api.PullRequest
+	teamID      uuid.UUID
+	reviewer1ID *uuid.UUID
+	reviewer2ID *uuid.UUID
+}
+
+// fillReviewers выполняет добор в рамках переданной транзакции для PR команд teamIDs (nil — всех команд).
+// PR, заблокированные параллельными транзакциями, пропускаются до следующего прохода.
+func (s *Storage) fillReviewers(ctx context.Context, tx *sql.Tx, teamIDs []uuid.UUID) ([]api.PullRequest, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT pr.id, pr.title, pr.author_id, t.name, pr.reviewer1_id, pr.reviewer2_id, pr.shadow_reviewer_id,
+			pr.created_at, pr.team_id
+		FROM pull_request pr
+		JOIN teams t ON t.id = pr.team_id
+		WHERE pr.status = 'OPEN'
+			AND pr.need_more_reviewers
+			AND ($1::uuid[] IS NULL OR pr.team_id = ANY($1))
+		ORDER BY pr.created_at
+		FOR UPDATE OF pr SKIP LOCKED
+	`, teamIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query understaffed pull requests: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	var pending []understaffedPR
+	for rows.Next() {
+		var (
+			item      understaffedPR
+			createdAt time.Time
+		)
+		err = rows.Scan(&item.pr.PullRequestID, &item.pr.PullRequestName, &item.pr.AuthorID, &item.pr.TeamName,
+			&item.reviewer1ID, &item.reviewer2ID, &item.pr.ShadowReviewer, &createdAt, &item.teamID)
+		if err != nil {
+			return nil, fmt.Errorf("scan pull request: %w", err)
+		}
+		item.pr.Status = api.StatusOpen
+		item.pr.CreatedAt = createdAt
+		pending = append(pending, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	filled := make([]api.PullRequest, 0, len(pending))
+	for _, item := range pending {
+		current := makeReviewers(item.reviewer1ID, item.reviewer2ID)
+		exclude := append([]uuid.UUID{item.pr.AuthorID}, current...)
+		if item.pr.ShadowReviewer != nil {
+			exclude = append(exclude, *item.pr.ShadowReviewer)
+		}
+
+		picked, err := s.pickReviewers(ctx, tx, pickRequest{
+			prID:     item.pr.PullRequestID,
+			teamID:   item.teamID,
+			authorID: item.pr.AuthorID,
+			exclude:  exclude,
+			keep:     current,
+			limit:    reviewersPerPR - len(current),
+			reason:   api.DecisionBackfill,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(picked) == 0 {
+			continue
+		}
+
+		for _, a := range picked {
+			reviewer := a.ReviewerID
+			if item.reviewer1ID == nil {
+				item.reviewer1ID = &reviewer
+			} else {
+				item.reviewer2ID = &reviewer
+			}
+		}
+
+		reviewers := makeReviewers(item.reviewer1ID, item.reviewer2ID)
+		_, err = tx.ExecContext(ctx, `
+			UPDATE pull_request
+			SET reviewer1_id = $1,
+				reviewer2_id = $2,
+				need_more_reviewers = $3
+			WHERE id = $4
+		`, item.reviewer1ID, item.reviewer2ID, len(reviewers) < reviewersPerPR, item.pr.PullRequestID)
+		if err != nil {
+			return nil, fmt.Errorf("update pull request: %w", err)
+		}
+
+		if err = recordAssignments(ctx, tx, item.pr.PullRequestID, picked, api.DecisionBackfill, nil); err != nil {
+			return nil, err
+		}
+
+		item.pr.AssignedReviewers = reviewers
+		if item.pr.Assignments, err = loadAssignments(ctx, tx, item.pr.PullRequestID); err != nil {
+			return nil, err
+		}
+		filled = append(filled, item.pr)
+	}
+
+	return filled, nil
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/F3dosik/PRS.git/internal/assignment"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+func (s *Storage) GetUserSkills(ctx context.Context, userID uuid.UUID) (*api.UserSkills, error) {
+	var exist bool
+	err := s.db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)
+	`, userID).Scan(&exist)
+	if err != nil {
+		return nil, fmt.Errorf("query exist user_id: %w", err)
+	}
+	if !exist {
+		return nil, api.NewAPIError(api.ErrNotFound, "user not found")
+	}
+
+	skills, err := loadTags(ctx, s.db, `
+		SELECT skill FROM user_skills
+		WHERE user_id = $1
+		ORDER BY skill
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query user skills: %w", err)
+	}
+
+	return &api.UserSkills{UserID: userID, Skills: skills}, nil
+}
+
+// SetUserSkills полностью заменяет набор навыков пользователя.
+func (s *Storage) SetUserSkills(ctx context.Context, userSkills *api.UserSkills) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var exist bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)
+	`, userSkills.UserID).Scan(&exist)
+	if err != nil {
+		return fmt.Errorf("query exist user_id: %w", err)
+	}
+	if !exist {
+		return api.NewAPIError(api.ErrNotFound, "user not found")
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM user_skills
+		WHERE user_id = $1
+	`, userSkills.UserID)
+	if err != nil {
+		return fmt.Errorf("delete user skills: %w", err)
+	}
+
+	userSkills.Skills = normalizeTags(userSkills.Skills)
+	for _, skill := range userSkills.Skills {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO user_skills (user_id, skill)
+			VALUES ($1, $2)
+		`, userSkills.UserID, skill)
+		if err != nil {
+			return fmt.Errorf("insert user skill: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func saveLabels(ctx context.Context, q querier, prID uuid.UUID, labels []string) error {
+	for _, label := range labels {
+		_, err := q.ExecContext(ctx, `
+			INSERT INTO pull_request_labels (pull_request_id, label)
+			VALUES ($1, $2)
+			ON CONFLICT DO NOTHING
+		`, prID, label)
+		if err != nil {
+			return fmt.Errorf("insert pull request label: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func loadLabels(ctx context.Context, q querier, prID uuid.UUID) ([]string, error) {
+	labels, err := loadTags(ctx, q, `
+		SELECT label FROM pull_request_labels
+		WHERE pull_request_id = $1
+		ORDER BY label
+	`, prID)
+	if err != nil {
+		return nil, fmt.Errorf("query pull request labels: %w", err)
+	}
+
+	return labels, nil
+}
+
+func loadTags(ctx context.Context, q querier, query string, id uuid.UUID) ([]string, error) {
+	rows, err := q.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	tags := []string{}
+	for rows.Next() {
+		var tag string
+		if err = rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// normalizeTags приводит навыки и метки к нижнему регистру и убирает пустые и повторяющиеся.
+func normalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = assignment.NormalizeTag(tag)
+		if tag != "" && !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	slices.Sort(normalized)
+	return normalized
+}
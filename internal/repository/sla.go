@@ -0,0 +1,339 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/calendar"
+	"github.com/F3dosik/PRS.git/internal/sla"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+func (s *Storage) GetTeamSLA(ctx context.Context, teamName string) (*api.TeamSLA, error) {
+	teamID, err := teamIDByName(ctx, s.db, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	teamSLA := &api.TeamSLA{TeamName: teamName}
+	err = s.db.QueryRowContext(ctx, `
+		SELECT first_review_hours, reassign_after_hours
+		FROM team_sla
+		WHERE team_id = $1
+	`, teamID).Scan(&teamSLA.FirstReviewHours, &teamSLA.ReassignAfterHours)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("query team sla: %w", err)
+	}
+
+	return teamSLA, nil
+}
+
+// SetTeamSLA заменяет сроки ревью команды; нулевой FirstReviewHours отключает SLA.
+func (s *Storage) SetTeamSLA(ctx context.Context, teamSLA *api.TeamSLA) error {
+	if teamSLA.FirstReviewHours < 0 {
+		return api.NewAPIError(api.ErrInvalidParameter, "first_review_hours must not be negative")
+	}
+	if teamSLA.ReassignAfterHours != nil && *teamSLA.ReassignAfterHours <= teamSLA.FirstReviewHours {
+		return api.NewAPIError(api.ErrInvalidParameter, "reassign_after_hours must be greater than first_review_hours")
+	}
+
+	teamID, err := teamIDByName(ctx, s.db, teamSLA.TeamName)
+	if err != nil {
+		return err
+	}
+
+	if teamSLA.FirstReviewHours == 0 {
+		teamSLA.ReassignAfterHours = nil
+		_, err = s.db.ExecContext(ctx, `
+			DELETE FROM team_sla
+			WHERE team_id = $1
+		`, teamID)
+		if err != nil {
+			return fmt.Errorf("delete team sla: %w", err)
+		}
+		return nil
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO team_sla (team_id, first_review_hours, reassign_after_hours)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (team_id) DO UPDATE
+		SET first_review_hours = EXCLUDED.first_review_hours,
+			reassign_after_hours = EXCLUDED.reassign_after_hours
+	`, teamID, teamSLA.FirstReviewHours, teamSLA.ReassignAfterHours)
+	if err != nil {
+		return fmt.Errorf("upsert team sla: %w", err)
+	}
+
+	return nil
+}
+
+type slaAssignment struct {
+	id          int64
+	review      api.OverdueReview
+	teamID      uuid.UUID
+	policy      sla.Policy
+	schedule    calendar.Schedule
+	escalatedAt *time.Time
+}
+
+func (a *slaAssignment) evaluate(now time.Time) sla.Status {
+	st := sla.Evaluate(a.policy, a.schedule, a.review.AssignedAt, now)
+	a.review.DueAt = st.DueAt
+	a.review.ReassignAt = st.ReassignAt
+	return st
+}
+
+// loadSLAAssignments возвращает действующие обязательные назначения открытых PR команд с SLA.
+// Сроки считаются по расписанию ревьювера, а без него — по расписанию команды PR.
+// nil teamIDs означает все команды.
+func loadSLAAssignments(ctx context.Context, q querier, teamIDs []uuid.UUID) ([]slaAssignment, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT a.id, pr.id, pr.title, pr.author_id, pr.team_id, t.name, a.reviewer_id,
+			a.assigned_at, a.sla_breached_at, a.sla_escalated_at,
+			sla.first_review_hours, sla.reassign_after_hours
+		FROM review_assignments a
+		JOIN pull_request pr ON pr.id = a.pull_request_id
+		JOIN teams t ON t.id = pr.team_id
+		JOIN team_sla sla ON sla.team_id = pr.team_id
+		WHERE pr.status = 'OPEN'
+			AND a.unassigned_at IS NULL
+			AND NOT a.shadow
+			AND ($1::uuid[] IS NULL OR pr.team_id = ANY($1))
+		ORDER BY a.assigned_at, a.id
+	`, teamIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query sla assignments: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	var assignments []slaAssignment
+	for rows.Next() {
+		var (
+			a             slaAssignment
+			firstReview   int
+			reassignAfter *int
+		)
+		err = rows.Scan(&a.id, &a.review.PullRequestID, &a.review.PullRequestName, &a.review.AuthorID,
+			&a.teamID, &a.review.TeamName, &a.review.ReviewerID,
+			&a.review.AssignedAt, &a.review.BreachedAt, &a.escalatedAt,
+			&firstReview, &reassignAfter)
+		if err != nil {
+			return nil, fmt.Errorf("scan sla assignment: %w", err)
+		}
+		a.policy.FirstReview = time.Duration(firstReview) * time.Hour
+		if reassignAfter != nil {
+			a.policy.ReassignAfter = time.Duration(*reassignAfter) * time.Hour
+		}
+		assignments = append(assignments, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	schedules := newScheduleCache(q)
+	for i := range assignments {
+		a := &assignments[i]
+		if a.schedule, err = schedules.get(ctx, a.review.ReviewerID, a.teamID); err != nil {
+			return nil, err
+		}
+	}
+
+	return assignments, nil
+}
+
+// GetOverdue возвращает назначения с истёкшим сроком первого ревью, начиная с самых давних.
+func (s *Storage) GetOverdue(ctx context.Context, teamName string, includeDescendants bool, now time.Time) (*api.OverdueResponse, error) {
+	teamIDs, err := statsTeamIDs(ctx, s.db, teamName, includeDescendants)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := loadSLAAssignments(ctx, s.db, teamIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.OverdueResponse{Overdue: []api.OverdueReview{}}
+	for _, a := range assignments {
+		if a.evaluate(now).Breached {
+			resp.Overdue = append(resp.Overdue, a.review)
+		}
+	}
+
+	return resp, nil
+}
+
+// SLAReport — итог одного прохода проверки SLA.
+type SLAReport struct {
+	Breached    int
+	Reassigned  int
+	NoCandidate int
+	Skipped     int // Уже эскалированы параллельным проходом или сняты с PR
+}
+
+// CheckSLA фиксирует нарушения SLA на момент now событием review.sla_breached, а назначения,
+// прошедшие второй порог, переназначает с событием review.sla_reassigned.
+// Каждое назначение обрабатывается в своей транзакции, поэтому параллельные проходы не дублируют события.
+func (s *Storage) CheckSLA(ctx context.Context, now time.Time) (*SLAReport, error) {
+	assignments, err := loadSLAAssignments(ctx, s.db, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SLAReport{}
+	for i := range assignments {
+		a := &assignments[i]
+		st := a.evaluate(now)
+
+		if st.Breached && a.review.BreachedAt == nil {
+			breached, err := s.markSLABreached(ctx, a, now)
+			if err != nil {
+				return report, err
+			}
+			if breached {
+				report.Breached++
+			}
+		}
+
+		if st.Escalate && a.escalatedAt == nil {
+			outcome, err := s.escalateSLA(ctx, a, now)
+			if err != nil {
+				return report, err
+			}
+			switch outcome {
+			case escalationReassigned:
+				report.Reassigned++
+			case escalationNoCandidate:
+				report.NoCandidate++
+			case escalationSkipped:
+				report.Skipped++
+			}
+		}
+	}
+
+	return report, nil
+}
+
+func (s *Storage) markSLABreached(ctx context.Context, a *slaAssignment, now time.Time) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var id int64
+	err = tx.QueryRowContext(ctx, `
+		UPDATE review_assignments
+		SET sla_breached_at = $2
+		WHERE id = $1
+			AND sla_breached_at IS NULL
+			AND unassigned_at IS NULL
+		RETURNING id
+	`, a.id, now).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("mark sla breached: %w", err)
+	}
+	a.review.BreachedAt = &now
+
+	err = recordEvent(ctx, tx, event{
+		eventType:     api.EventSLABreached,
+		pullRequestID: &a.review.PullRequestID,
+		teamID:        &a.teamID,
+		userID:        &a.review.ReviewerID,
+		payload:       a.review,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+type escalationOutcome int
+
+const (
+	escalationSkipped escalationOutcome = iota
+	escalationReassigned
+	escalationNoCandidate
+)
+
+// escalateSLA переназначает ревьювера. Попытка делается один раз: если замены нет,
+// назначение остаётся помеченным как эскалированное. Назначение, которое уже эскалировал
+// параллельный проход или которое снято, пропускается.
+func (s *Storage) escalateSLA(ctx context.Context, a *slaAssignment, now time.Time) (escalationOutcome, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return escalationSkipped, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var id int64
+	err = tx.QueryRowContext(ctx, `
+		UPDATE review_assignments
+		SET sla_escalated_at = $2
+		WHERE id = $1
+			AND sla_escalated_at IS NULL
+			AND unassigned_at IS NULL
+		RETURNING id
+	`, a.id, now).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return escalationSkipped, nil
+		}
+		return escalationSkipped, fmt.Errorf("mark sla escalated: %w", err)
+	}
+
+	// Переназначение в точке сохранения: при отсутствии кандидата откатывается только оно
+	if _, err = tx.ExecContext(ctx, `SAVEPOINT sla_reassign`); err != nil {
+		return escalationSkipped, fmt.Errorf("savepoint: %w", err)
+	}
+
+	resp, err := s.reassign(ctx, tx, a.review.PullRequestID, a.review.ReviewerID, api.DecisionSLA)
+	if err != nil {
+		var apiErr *api.APIError
+		if !errors.As(err, &apiErr) {
+			return escalationSkipped, err
+		}
+		if _, err = tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT sla_reassign`); err != nil {
+			return escalationSkipped, fmt.Errorf("rollback to savepoint: %w", err)
+		}
+		// PR могли слить или ревьювера снять после загрузки назначений — это не нехватка кандидатов
+		outcome := escalationSkipped
+		if apiErr.Code == api.ErrNoCandidate {
+			outcome = escalationNoCandidate
+		}
+		return outcome, tx.Commit()
+	}
+
+	err = recordEvent(ctx, tx, event{
+		eventType:     api.EventSLAReassigned,
+		pullRequestID: &a.review.PullRequestID,
+		teamID:        &a.teamID,
+		userID:        &resp.ReplacedBy,
+		payload: map[string]any{
+			"old_reviewer_id": a.review.ReviewerID,
+			"new_reviewer_id": resp.ReplacedBy,
+			"assigned_at":     a.review.AssignedAt,
+			"reassign_at":     a.review.ReassignAt,
+		},
+	})
+	if err != nil {
+		return escalationSkipped, err
+	}
+
+	return escalationReassigned, tx.Commit()
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+func (s *Storage) GetSlackUser(ctx context.Context, userID uuid.UUID) (*api.SlackUser, error) {
+	if err := checkUserExists(ctx, s.db, userID); err != nil {
+		return nil, err
+	}
+
+	user := &api.SlackUser{UserID: userID}
+	err := s.db.QueryRowContext(ctx, `
+		SELECT slack_id FROM user_slack_ids
+		WHERE user_id = $1
+	`, userID).Scan(&user.SlackID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("query slack id: %w", err)
+	}
+
+	return user, nil
+}
+
+// SetSlackUser привязывает пользователя к идентификатору Slack; пустой идентификатор удаляет привязку.
+func (s *Storage) SetSlackUser(ctx context.Context, user *api.SlackUser) error {
+	if err := checkUserExists(ctx, s.db, user.UserID); err != nil {
+		return err
+	}
+
+	user.SlackID = strings.TrimSpace(user.SlackID)
+	if user.SlackID == "" {
+		_, err := s.db.ExecContext(ctx, `
+			DELETE FROM user_slack_ids
+			WHERE user_id = $1
+		`, user.UserID)
+		if err != nil {
+			return fmt.Errorf("delete slack id: %w", err)
+		}
+		return nil
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO user_slack_ids (user_id, slack_id)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET slack_id = EXCLUDED.slack_id
+	`, user.UserID, user.SlackID)
+	if err != nil {
+		return fmt.Errorf("upsert slack id: %w", err)
+	}
+
+	return nil
+}
+
+// SlackIDs возвращает идентификаторы Slack пользователей; пользователи без привязки пропускаются.
+func (s *Storage) SlackIDs(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	ids := make(map[uuid.UUID]string, len(userIDs))
+	if len(userIDs) == 0 {
+		return ids, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT user_id, slack_id FROM user_slack_ids
+		WHERE user_id = ANY($1)
+	`, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query slack ids: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	for rows.Next() {
+		var (
+			userID  uuid.UUID
+			slackID string
+		)
+		if err = rows.Scan(&userID, &slackID); err != nil {
+			return nil, fmt.Errorf("scan slack id: %w", err)
+		}
+		ids[userID] = slackID
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return ids, nil
+}
@@ -5,31 +5,99 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
 	"time"
 
-	"github.com/F3dosik/PRS.git/internal/models/api"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgconn"
-	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
 )
 
 type Storage struct {
-	db *sql.DB
+	db   *sql.DB
+	pool *pgxpool.Pool // Соединения db берутся из пула pgx, сам database/sql их не держит
+
+	// seeds выдаёт seed для каждого решения о назначении ревьюверов
+	seeds func() uint64
+}
+
+// PoolConfig — настройки пула соединений; нулевые значения оставляют значения по умолчанию pgxpool
+// или строки подключения.
+type PoolConfig struct {
+	MaxConns         int32
+	MinConns         int32
+	MaxConnLifetime  time.Duration
+	StatementTimeout time.Duration // Передаётся серверу как statement_timeout каждого соединения
+	ApplicationName  string
 }
 
-func NewStorage(dsn string) (*Storage, error) {
-	db, err := sql.Open("pgx", dsn)
+func NewStorage(dsn string, cfg PoolConfig) (*Storage, error) {
+	poolConfig, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("parse dsn: %w", err)
+	}
+	if cfg.MaxConns > 0 {
+		poolConfig.MaxConns = cfg.MaxConns
+	}
+	if cfg.MinConns > 0 {
+		poolConfig.MinConns = cfg.MinConns
+	}
+	if cfg.MaxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = cfg.MaxConnLifetime
+	}
+	params := poolConfig.ConnConfig.RuntimeParams
+	if cfg.StatementTimeout > 0 {
+		params["statement_timeout"] = strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10)
+	}
+	if cfg.ApplicationName != "" {
+		params["application_name"] = cfg.ApplicationName
+	}
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
 	if err != nil {
-		return nil, fmt.Errorf("open db: %w", err)
+		return nil, fmt.Errorf("open db pool: %w", err)
 	}
 
 	storage := &Storage{
-		db: db,
+		db:    stdlib.OpenDBFromPool(pool),
+		pool:  pool,
+		seeds: rand.Uint64,
 	}
 
 	return storage, nil
 }
 
+// Close закрывает пул, дожидаясь возврата занятых соединений.
+func (s *Storage) Close() {
+	_ = s.db.Close()
+	s.pool.Close()
+}
+
+func (s *Storage) PoolStats() api.PoolStats {
+	stat := s.pool.Stat()
+	return api.PoolStats{
+		MaxConns:             stat.MaxConns(),
+		TotalConns:           stat.TotalConns(),
+		IdleConns:            stat.IdleConns(),
+		AcquiredConns:        stat.AcquiredConns(),
+		ConstructingConns:    stat.ConstructingConns(),
+		AcquireCount:         stat.AcquireCount(),
+		EmptyAcquireCount:    stat.EmptyAcquireCount(),
+		CanceledAcquireCount: stat.CanceledAcquireCount(),
+		AcquireDurationMs:    stat.AcquireDuration().Milliseconds(),
+		NewConnsCount:        stat.NewConnsCount(),
+	}
+}
+
+// SetSeedSource подменяет источник seed'ов, например детерминированным для воспроизводимых тестов.
+func (s *Storage) SetSeedSource(seeds func() uint64) {
+	s.seeds = seeds
+}
+
 func (s *Storage) UpdateTeam(ctx context.Context, team *api.Team) error {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -37,13 +105,22 @@ func (s *Storage) UpdateTeam(ctx context.Context, team *api.Team) error {
 	}
 	defer func() { _ = tx.Rollback() }()
 
+	var parentID *uuid.UUID
+	if team.ParentTeam != nil {
+		id, err := teamIDByName(ctx, tx, *team.ParentTeam)
+		if err != nil {
+			return err
+		}
+		parentID = &id
+	}
+
 	var teamID uuid.UUID
 
 	err = tx.QueryRowContext(ctx, `
-		INSERT INTO teams (name)
-		VALUES ($1)
+		INSERT INTO teams (name, parent_id)
+		VALUES ($1, $2)
 		RETURNING id
-		`, team.TeamName).Scan(&teamID)
+		`, team.TeamName, parentID).Scan(&teamID)
 
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -54,31 +131,77 @@ func (s *Storage) UpdateTeam(ctx context.Context, team *api.Team) error {
 		return fmt.Errorf("insert team: %w", err)
 	}
 
-	for _, member := range team.Members {
-		_, err = tx.ExecContext(ctx, `
-			INSERT INTO users (id, name, is_active, team_id)
-			VALUES ($1, $2, $3, $4)
+	for i, member := range team.Members {
+		if member.Seniority != "" && !member.Seniority.Valid() {
+			return api.NewAPIError(api.ErrInvalidParameter, "unknown seniority: "+string(member.Seniority))
+		}
+
+		// Активность участника относится к членству в этой команде: глобальный флаг
+		// существующего пользователя не меняется при добавлении в ещё одну команду
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO users (id, name, is_active, seniority)
+			VALUES ($1, $2, TRUE, COALESCE(NULLIF($3, ''), 'middle'))
 			ON CONFLICT (id) DO UPDATE
-			SET name = EXCLUDED.name, 
-				is_active = EXCLUDED.is_active,
-				team_id = EXCLUDED.team_id
-		`, member.UserID, member.Username, member.IsActive, teamID)
+			SET name = EXCLUDED.name,
+				seniority = COALESCE(NULLIF($3, ''), users.seniority)
+			RETURNING seniority
+		`, member.UserID, member.Username, member.Seniority).Scan(&team.Members[i].Seniority)
 		if err != nil {
 			return fmt.Errorf("upsert user: %w", err)
 		}
+
+		if member.Role == "" {
+			team.Members[i].Role = api.DefaultRole
+		}
+
+		// Первая команда пользователя становится основной, остальные членства добавляются к ней.
+		// Повтор участника в запросе не ошибка: остаются роль и активность последнего вхождения
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO team_memberships (user_id, team_id, role, is_active, is_primary)
+			VALUES ($1, $2, $3, $4, NOT EXISTS (
+				SELECT 1 FROM team_memberships WHERE user_id = $1 AND is_primary
+			))
+			ON CONFLICT (user_id, team_id) DO UPDATE
+			SET role = EXCLUDED.role,
+				is_active = EXCLUDED.is_active
+		`, member.UserID, teamID, team.Members[i].Role, member.IsActive)
+		if err != nil {
+			return fmt.Errorf("insert membership: %w", err)
+		}
+	}
+
+	// Новая команда ещё без PR: новые участники могут закрыть пустые слоты в PR команд,
+	// в пулы которых они попадают
+	userIDs := make([]uuid.UUID, 0, len(team.Members))
+	for _, member := range team.Members {
+		userIDs = append(userIDs, member.UserID)
+	}
+	affectedTeams, err := teamsReachingMembers(ctx, tx, userIDs)
+	if err != nil {
+		return err
+	}
+	// nil в fillReviewers означает все команды, поэтому без команд добор не нужен
+	if len(affectedTeams) > 0 {
+		if _, err = s.fillReviewers(ctx, tx, affectedTeams); err != nil {
+			return fmt.Errorf("fill reviewers: %w", err)
+		}
 	}
 
 	return tx.Commit()
 }
 
-func (s *Storage) GetTeam(ctx context.Context, teamName string) (*api.Team, error) {
+// GetTeam возвращает команду с участниками; при includeDescendants в состав попадают
+// и участники всех подкоманд (каждый пользователь — один раз).
+func (s *Storage) GetTeam(ctx context.Context, teamName string, includeDescendants bool) (*api.Team, error) {
 	var teamID uuid.UUID
 	var team api.Team
 
 	err := s.db.QueryRowContext(ctx, `
-		SELECT id, name FROM teams
-		WHERE name = $1
-	`, teamName).Scan(&teamID, &team.TeamName)
+		SELECT t.id, t.name, p.name
+		FROM teams t
+		LEFT JOIN teams p ON p.id = t.parent_id
+		WHERE t.name = $1
+	`, teamName).Scan(&teamID, &team.TeamName, &team.ParentTeam)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, api.NewAPIError(api.ErrNotFound, "team not found")
@@ -86,10 +209,26 @@ func (s *Storage) GetTeam(ctx context.Context, teamName string) (*api.Team, erro
 		return nil, fmt.Errorf("query team: %w", err)
 	}
 
+	teamIDs := []uuid.UUID{teamID}
+	if includeDescendants {
+		if teamIDs, err = teamSubtreeIDs(ctx, s.db, teamID); err != nil {
+			return nil, err
+		}
+	}
+
 	rows, err := s.db.QueryContext(ctx, `
-		SELECT id, name, is_active FROM users
-		WHERE team_id = $1
-	`, teamID)
+		SELECT id, name, is_active, role, seniority, team_name
+		FROM (
+			SELECT DISTINCT ON (u.id) u.id, u.name, u.is_active AND m.is_active AS is_active,
+				m.role, u.seniority, t.name AS team_name, m.created_at
+			FROM team_memberships m
+			JOIN users u ON u.id = m.user_id
+			JOIN teams t ON t.id = m.team_id
+			WHERE m.team_id = ANY($1)
+			ORDER BY u.id, m.team_id = $2 DESC, m.created_at
+		) members
+		ORDER BY created_at, name
+	`, teamIDs, teamID)
 	if err != nil {
 		return nil, fmt.Errorf("query team members: %w", err)
 	}
@@ -102,9 +241,14 @@ func (s *Storage) GetTeam(ctx context.Context, teamName string) (*api.Team, erro
 
 	for rows.Next() {
 		var member api.TeamMember
-		if err = rows.Scan(&member.UserID, &member.Username, &member.IsActive); err != nil {
+		var memberTeam string
+		err = rows.Scan(&member.UserID, &member.Username, &member.IsActive, &member.Role, &member.Seniority, &memberTeam)
+		if err != nil {
 			return nil, fmt.Errorf("scan member: %w", err)
 		}
+		if includeDescendants {
+			member.TeamName = memberTeam
+		}
 		team.Members = append(team.Members, member)
 	}
 
@@ -115,16 +259,82 @@ func (s *Storage) GetTeam(ctx context.Context, teamName string) (*api.Team, erro
 	return &team, nil
 }
 
-func (s *Storage) SetIsActive(ctx context.Context, userID uuid.UUID, isActive bool) (*api.User, error) {
-	var username string
-	var teamname *string
-	err := s.db.QueryRowContext(ctx, `
-		SELECT u.name, t.name
-		FROM users u
-		LEFT JOIN teams t ON u.team_id = t.id
-		WHERE u.id = $1
-	`, userID).Scan(&username, &teamname)
+// SetIsActive меняет глобальный флаг активности пользователя, а при непустом teamName —
+// только активность его членства в этой команде.
+func (s *Storage) SetIsActive(ctx context.Context, userID uuid.UUID, teamName string, isActive bool) (*api.User, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
 
+	var exist bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)
+	`, userID).Scan(&exist)
+	if err != nil {
+		return nil, fmt.Errorf("query exist user_id: %w", err)
+	}
+	if !exist {
+		return nil, api.NewAPIError(api.ErrNotFound, "user not found")
+	}
+
+	if teamName != "" {
+		err = tx.QueryRowContext(ctx, `
+			UPDATE team_memberships m
+			SET is_active = $1
+			FROM teams t
+			WHERE t.id = m.team_id
+				AND t.name = $2
+				AND m.user_id = $3
+			RETURNING m.team_id
+		`, isActive, teamName, userID).Scan(new(uuid.UUID))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, api.NewAPIError(api.ErrNotFound, "user is not a member of team")
+			}
+			return nil, fmt.Errorf("update membership: %w", err)
+		}
+	} else {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE users
+			SET is_active = $1
+			WHERE id = $2
+		`, isActive, userID)
+		if err != nil {
+			return nil, fmt.Errorf("update user: %w", err)
+		}
+	}
+
+	user, err := loadUser(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Вернувшийся пользователь может закрыть пустые слоты в PR всех команд, до которых дотягиваются
+	// его пулы кандидатов, — как и при добавлении участников в UpdateTeam
+	if isActive {
+		affectedTeams, err := teamsReachingMembers(ctx, tx, []uuid.UUID{userID})
+		if err != nil {
+			return nil, err
+		}
+		// nil в fillReviewers означает все команды, поэтому без команд добор не нужен
+		if len(affectedTeams) > 0 {
+			if _, err = s.fillReviewers(ctx, tx, affectedTeams); err != nil {
+				return nil, fmt.Errorf("fill reviewers: %w", err)
+			}
+		}
+	}
+
+	return user, tx.Commit()
+}
+
+func loadUser(ctx context.Context, q querier, userID uuid.UUID) (*api.User, error) {
+	user := api.User{UserID: userID, Teams: []api.UserTeam{}}
+	err := q.QueryRowContext(ctx, `
+		SELECT name, is_active, seniority FROM users
+		WHERE id = $1
+	`, userID).Scan(&user.Username, &user.IsActive, &user.Seniority)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, api.NewAPIError(api.ErrNotFound, "user not found")
@@ -132,52 +342,86 @@ func (s *Storage) SetIsActive(ctx context.Context, userID uuid.UUID, isActive bo
 		return nil, fmt.Errorf("query user: %w", err)
 	}
 
-	_, err = s.db.ExecContext(ctx, `
-		UPDATE users
-		SET is_active = $1
-		WHERE id = $2
-	`, isActive, userID)
+	rows, err := q.QueryContext(ctx, `
+		SELECT t.name, m.role, m.is_active, m.is_primary
+		FROM team_memberships m
+		JOIN teams t ON t.id = m.team_id
+		WHERE m.user_id = $1
+		ORDER BY m.is_primary DESC, m.created_at
+	`, userID)
 	if err != nil {
-		return nil, fmt.Errorf("update user: %w", err)
+		return nil, fmt.Errorf("query memberships: %w", err)
 	}
 
-	user := api.User{
-		UserID:   userID,
-		Username: username,
-		TeamName: teamname,
-		IsActive: isActive,
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close rows: %w", closeErr)
+		}
+	}()
+
+	for rows.Next() {
+		var team api.UserTeam
+		if err = rows.Scan(&team.TeamName, &team.Role, &team.IsActive, &team.IsPrimary); err != nil {
+			return nil, fmt.Errorf("scan membership: %w", err)
+		}
+		if team.IsPrimary {
+			user.TeamName = &team.TeamName
+		}
+		user.Teams = append(user.Teams, team)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
 
 	return &user, nil
 }
 
-func (s *Storage) PullRequestCreate(ctx context.Context, prID, authorID uuid.UUID, prName string) (*api.PullRequest, error) {
+// PullRequestCreate создаёт PR в команде req.TeamName; пустое имя означает основную команду автора.
+func (s *Storage) PullRequestCreate(ctx context.Context, req *api.PullRequestCreateRequest) (*api.PullRequest, error) {
+	prID, authorID, prName, teamName := req.PullRequestID, req.AuthorID, req.PullRequestName, req.TeamName
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = tx.Rollback() }()
 
+	var exist bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)
+	`, authorID).Scan(&exist)
+	if err != nil {
+		return nil, fmt.Errorf("query author: %w", err)
+	}
+	if !exist {
+		return nil, api.NewAPIError(api.ErrNotFound, "author not found")
+	}
+
 	var teamID uuid.UUID
 	err = tx.QueryRowContext(ctx, `
-		SELECT team_id FROM users
-		WHERE id = $1
-	`, authorID).Scan(&teamID)
+		SELECT m.team_id, t.name
+		FROM team_memberships m
+		JOIN teams t ON t.id = m.team_id
+		WHERE m.user_id = $1
+			AND ($2 = '' OR t.name = $2)
+		ORDER BY m.is_primary DESC, m.created_at
+		LIMIT 1
+	`, authorID, teamName).Scan(&teamID, &teamName)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, api.NewAPIError(api.ErrNotFound, "author not found")
+			if teamName != "" {
+				return nil, api.NewAPIError(api.ErrNotFound, "author is not a member of team")
+			}
+			return nil, api.NewAPIError(api.ErrNotFound, "author has no team")
 		}
 		return nil, fmt.Errorf("query author team: %w", err)
 	}
 
-	if teamID == uuid.Nil {
-		return nil, api.NewAPIError(api.ErrNotFound, "author has no team")
-	}
-
 	_, err = tx.ExecContext(ctx, `
-		INSERT INTO pull_request (id, title, author_id)
-		VALUES ($1, $2, $3)
-	`, prID, prName, authorID)
+		INSERT INTO pull_request (id, title, author_id, team_id)
+		VALUES ($1, $2, $3, $4)
+	`, prID, prName, authorID, teamID)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -186,36 +430,34 @@ func (s *Storage) PullRequestCreate(ctx context.Context, prID, authorID uuid.UUI
 		return nil, fmt.Errorf("insert pr: %w", err)
 	}
 
-	rows, err := tx.QueryContext(ctx, `
-		SELECT id FROM users
-		WHERE team_id = $1
-			AND is_active = true
-			AND id <> $2
-		ORDER BY RANDOM()
-		LIMIT 2
-	`, teamID, authorID)
-	if err != nil {
-		return nil, fmt.Errorf("query reviewers: %w", err)
+	if err = saveChangedFiles(ctx, tx, prID, req.ChangedFiles); err != nil {
+		return nil, err
 	}
 
-	defer func() {
-		if closeErr := rows.Close(); closeErr != nil && err == nil {
-			err = fmt.Errorf("close rows: %w", closeErr)
-		}
-	}()
+	labels := normalizeTags(req.Labels)
+	if err = saveLabels(ctx, tx, prID, labels); err != nil {
+		return nil, err
+	}
 
-	var reviewers []uuid.UUID
-	for rows.Next() {
-		var reviewer uuid.UUID
-		if err = rows.Scan(&reviewer); err != nil {
-			return nil, fmt.Errorf("scan reviwer: %w", err)
-		}
-		reviewers = append(reviewers, reviewer)
+	rules, err := loadReviewRules(ctx, tx, teamID)
+	if err != nil {
+		return nil, err
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows iteration error: %w", err)
+	picked, err := s.pickReviewers(ctx, tx, pickRequest{
+		prID:     prID,
+		teamID:   teamID,
+		authorID: authorID,
+		exclude:  []uuid.UUID{authorID},
+		limit:    reviewersPerPR,
+		shadow:   rules.shadowJunior,
+		reason:   api.DecisionCreate,
+	})
+	if err != nil {
+		return nil, err
 	}
+	required, shadowID := splitShadow(picked)
+	reviewers := reviewerIDs(required)
 
 	var reviewer1ID, reviewer2ID *uuid.UUID
 
@@ -230,42 +472,82 @@ func (s *Storage) PullRequestCreate(ctx context.Context, prID, authorID uuid.UUI
 		UPDATE pull_request
 		SET reviewer1_id = $1,
 			reviewer2_id = $2,
-			need_more_reviewers = $3
-		WHERE id = $4
-	`, reviewer1ID, reviewer2ID, len(reviewers) < 2, prID)
+			shadow_reviewer_id = $3,
+			need_more_reviewers = $4
+		WHERE id = $5
+	`, reviewer1ID, reviewer2ID, shadowID, len(reviewers) < reviewersPerPR, prID)
 	if err != nil {
 		return nil, fmt.Errorf("update pull request: %w", err)
 	}
 
+	err = recordEvent(ctx, tx, event{
+		eventType:     api.EventPullRequestCreated,
+		pullRequestID: &prID,
+		teamID:        &teamID,
+		userID:        &authorID,
+		payload: api.PullRequestCreatedPayload{
+			PullRequestID:   prID,
+			PullRequestName: prName,
+			AuthorID:        authorID,
+			TeamName:        teamName,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = recordAssignments(ctx, tx, prID, picked, api.DecisionCreate, nil); err != nil {
+		return nil, err
+	}
+
 	pr := api.PullRequest{
 		PullRequestID:     prID,
 		PullRequestName:   prName,
 		AuthorID:          authorID,
+		TeamName:          teamName,
 		Status:            api.StatusOpen,
 		AssignedReviewers: reviewers,
+		ShadowReviewer:    shadowID,
+		ChangedFiles:      req.ChangedFiles,
+		Labels:            labels,
+		Assignments:       picked,
 	}
 
 	return &pr, tx.Commit()
 }
 
+// PullRequestMerge переводит PR в MERGED и закрывает его назначения. Строка PR блокируется
+// до конца транзакции, поэтому параллельный merge дождётся первого и вернёт PR без нового события.
 func (s *Storage) PullRequestMerge(ctx context.Context, prID uuid.UUID) (*api.PullRequest, error) {
 	var (
 		title       string
 		authorID    uuid.UUID
+		teamID      *uuid.UUID
+		teamName    *string
 		status      api.PRStatus
 		reviewer1ID *uuid.UUID
 		reviewer2ID *uuid.UUID
+		shadowID    *uuid.UUID
 		createdAt   time.Time
 		mergedAt    *time.Time
 	)
 
-	err := s.db.QueryRowContext(ctx, `
-		SELECT title, author_id, status, reviewer1_id, reviewer2_id, created_at, merged_at
-		FROM pull_request
-		WHERE id = $1
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = tx.QueryRowContext(ctx, `
+		SELECT pr.title, pr.author_id, pr.team_id, t.name, pr.status, pr.reviewer1_id, pr.reviewer2_id,
+			pr.shadow_reviewer_id, pr.created_at, pr.merged_at
+		FROM pull_request pr
+		LEFT JOIN teams t ON t.id = pr.team_id
+		WHERE pr.id = $1
+		FOR UPDATE OF pr
 	`, prID).Scan(
-		&title, &authorID, &status,
-		&reviewer1ID, &reviewer2ID,
+		&title, &authorID, &teamID, &teamName, &status,
+		&reviewer1ID, &reviewer2ID, &shadowID,
 		&createdAt, &mergedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -278,17 +560,22 @@ func (s *Storage) PullRequestMerge(ctx context.Context, prID uuid.UUID) (*api.Pu
 		PullRequestID:     prID,
 		PullRequestName:   title,
 		AuthorID:          authorID,
+		TeamName:          derefString(teamName),
 		Status:            api.StatusMerged,
 		AssignedReviewers: makeReviewers(reviewer1ID, reviewer2ID),
+		ShadowReviewer:    shadowID,
 		CreatedAt:         createdAt,
 		MergedAt:          mergedAt,
 	}
 
 	if status == api.StatusMerged {
-		return pr, nil
+		if pr.Assignments, err = loadAssignments(ctx, tx, prID); err != nil {
+			return nil, err
+		}
+		return pr, tx.Commit()
 	}
 
-	err = s.db.QueryRowContext(ctx, `
+	err = tx.QueryRowContext(ctx, `
 		UPDATE pull_request
 		SET status = 'MERGED',
 			merged_at = now()
@@ -296,13 +583,50 @@ func (s *Storage) PullRequestMerge(ctx context.Context, prID uuid.UUID) (*api.Pu
 		RETURNING merged_at
 	`, prID).Scan(&mergedAt)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, api.NewAPIError(api.ErrNotFound, "pull request not found")
-		}
 		return nil, fmt.Errorf("update pull_request: %w", err)
 	}
 
-	pr.Status = api.StatusMerged
+	// Назначения закрываются моментом merge: SLA их больше не отслеживает, а в ответах
+	// по смерженному PR они остаются видны (см. loadAssignments)
+	_, err = tx.ExecContext(ctx, `
+		UPDATE review_assignments
+		SET unassigned_at = $2
+		WHERE pull_request_id = $1
+			AND unassigned_at IS NULL
+	`, prID, mergedAt)
+	if err != nil {
+		return nil, fmt.Errorf("close review assignments: %w", err)
+	}
+
+	if pr.Assignments, err = loadAssignments(ctx, tx, prID); err != nil {
+		return nil, err
+	}
+
+	reviewers := slices.Clone(pr.AssignedReviewers)
+	if shadowID != nil {
+		reviewers = append(reviewers, *shadowID)
+	}
+	err = recordEvent(ctx, tx, event{
+		eventType:     api.EventPullRequestMerged,
+		pullRequestID: &prID,
+		teamID:        teamID,
+		userID:        &authorID,
+		payload: api.PullRequestMergedPayload{
+			PullRequestID:   prID,
+			PullRequestName: title,
+			AuthorID:        authorID,
+			Reviewers:       reviewers,
+			MergedAt:        *mergedAt,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
 	pr.MergedAt = mergedAt
 
 	return pr, nil
@@ -315,8 +639,18 @@ func (s *Storage) PullRequestReassign(ctx context.Context, prID, oldUserID uuid.
 	}
 	defer func() { _ = tx.Rollback() }()
 
+	prResponse, err := s.reassign(ctx, tx, prID, oldUserID, api.DecisionReassign)
+	if err != nil {
+		return nil, err
+	}
+
+	return prResponse, tx.Commit()
+}
+
+// reassign заменяет ревьювера oldUserID в рамках переданной транзакции.
+func (s *Storage) reassign(ctx context.Context, tx *sql.Tx, prID, oldUserID uuid.UUID, reason api.DecisionReason) (*api.PullRequestReassignResponse, error) {
 	var exist bool
-	err = tx.QueryRowContext(ctx, `
+	err := tx.QueryRowContext(ctx, `
 		SELECT 
 		EXISTS (SELECT 1 FROM pull_request WHERE id = $1)
 		AND
@@ -329,10 +663,12 @@ func (s *Storage) PullRequestReassign(ctx context.Context, prID, oldUserID uuid.
 		return nil, api.NewAPIError(api.ErrNotFound, "pull_request_id or old_user_id not found")
 	}
 
+	// Блокировка до проверки статуса: параллельный merge успеет либо до неё, либо после замены
 	var status api.PRStatus
 	err = tx.QueryRowContext(ctx, `
 		SELECT status FROM pull_request
 		WHERE id = $1
+		FOR UPDATE
 	`, prID).Scan(&status)
 	if err != nil {
 		return nil, fmt.Errorf("query pr status: %w", err)
@@ -341,66 +677,102 @@ func (s *Storage) PullRequestReassign(ctx context.Context, prID, oldUserID uuid.
 		return nil, api.NewAPIError(api.ErrPRMerged, "cannot reassign on merged PR")
 	}
 
-	var teamID uuid.UUID
-	err = tx.QueryRowContext(ctx, `
-		SELECT team_id FROM users WHERE id = (
-			SELECT author_id FROM pull_request WHERE id = $1
-		)
-	`, prID).Scan(&teamID)
-	if err != nil {
-		return nil, fmt.Errorf("query team_id: %w", err)
-	}
-
-	var newUserID uuid.UUID
-	err = tx.QueryRowContext(ctx, `
-		SELECT id FROM users
-		WHERE is_active = true
-			AND team_id = $1
-			AND id <> $2
-		ORDER BY RANDOM()
-		LIMIT 1
-	`, teamID, oldUserID).Scan(&newUserID)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, api.NewAPIError(api.ErrNoCandidate, "no active replacement candidate in team")
-		}
-		return nil, fmt.Errorf("query users: %w", err)
-	}
-
 	var (
+		teamID      uuid.UUID
+		teamName    string
 		title       string
 		authorID    uuid.UUID
 		reviewer1ID *uuid.UUID
 		reviewer2ID *uuid.UUID
+		shadowID    *uuid.UUID
 	)
+	err = tx.QueryRowContext(ctx, `
+		SELECT pr.team_id, t.name, pr.title, pr.author_id, pr.reviewer1_id, pr.reviewer2_id, pr.shadow_reviewer_id
+		FROM pull_request pr
+		JOIN teams t ON t.id = pr.team_id
+		WHERE pr.id = $1
+		FOR UPDATE OF pr
+	`, prID).Scan(&teamID, &teamName, &title, &authorID, &reviewer1ID, &reviewer2ID, &shadowID)
+	if err != nil {
+		return nil, fmt.Errorf("query pull request: %w", err)
+	}
+
+	current := makeReviewers(reviewer1ID, reviewer2ID)
+	replacingShadow := shadowID != nil && *shadowID == oldUserID
+	if !replacingShadow && !slices.Contains(current, oldUserID) {
+		return nil, api.NewAPIError(api.ErrNotAssigned, "reviewer is not assigned to this PR")
+	}
+
+	exclude := append([]uuid.UUID{authorID}, current...)
+	if shadowID != nil {
+		exclude = append(exclude, *shadowID)
+	}
+
+	// Теневого ревьювера меняем на другого junior, обязательного — так, чтобы правило о senior
+	// выполнялось вместе с остающимся ревьювером
+	req := pickRequest{
+		prID:     prID,
+		teamID:   teamID,
+		authorID: authorID,
+		exclude:  exclude,
+		keep:     current,
+		limit:    1,
+		reason:   reason,
+	}
+	if replacingShadow {
+		req.limit, req.shadow = 0, true
+	} else {
+		req.keep = slices.DeleteFunc(slices.Clone(current), func(id uuid.UUID) bool { return id == oldUserID })
+	}
+
+	picked, err := s.pickReviewers(ctx, tx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(picked) == 0 {
+		return nil, api.NewAPIError(api.ErrNoCandidate, "no active replacement candidate in team")
+	}
+	newUserID := picked[0].ReviewerID
 
 	err = tx.QueryRowContext(ctx, `
 		UPDATE pull_request
 		SET
 			reviewer1_id = CASE WHEN reviewer1_id = $1 THEN $2 ELSE reviewer1_id END,
-			reviewer2_id = CASE WHEN reviewer2_id = $1 THEN $2 ELSE reviewer2_id END
+			reviewer2_id = CASE WHEN reviewer2_id = $1 THEN $2 ELSE reviewer2_id END,
+			shadow_reviewer_id = CASE WHEN shadow_reviewer_id = $1 THEN $2 ELSE shadow_reviewer_id END
 		WHERE id = $3
-		RETURNING title, author_id, reviewer1_id, reviewer2_id
-	`, oldUserID, newUserID, prID).Scan(&title, &authorID, &reviewer1ID, &reviewer2ID)
+		RETURNING reviewer1_id, reviewer2_id, shadow_reviewer_id
+	`, oldUserID, newUserID, prID).Scan(&reviewer1ID, &reviewer2ID, &shadowID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, api.NewAPIError(api.ErrNotAssigned, "reviewer is not assigned to this PR")
-		}
 		return nil, fmt.Errorf("update pull request: %w", err)
 	}
 
+	if err = closeAssignment(ctx, tx, prID, oldUserID); err != nil {
+		return nil, err
+	}
+	if err = recordAssignments(ctx, tx, prID, picked, reason, &oldUserID); err != nil {
+		return nil, err
+	}
+	assignments, err := loadAssignments(ctx, tx, prID)
+	if err != nil {
+		return nil, err
+	}
+
 	pr := api.PullRequest{
 		PullRequestID:     prID,
 		PullRequestName:   title,
 		AuthorID:          authorID,
+		TeamName:          teamName,
 		Status:            status,
 		AssignedReviewers: makeReviewers(reviewer1ID, reviewer2ID),
+		ShadowReviewer:    shadowID,
+		Assignments:       assignments,
 	}
 	prResponse := &api.PullRequestReassignResponse{
 		PullRequest: pr,
 		ReplacedBy:  newUserID,
 	}
-	return prResponse, tx.Commit()
+	return prResponse, nil
 }
 
 func (s *Storage) GetReview(ctx context.Context, userID uuid.UUID) (*api.GetReviewResponse, error) {
@@ -417,7 +789,7 @@ func (s *Storage) GetReview(ctx context.Context, userID uuid.UUID) (*api.GetRevi
 
 	rows, err := s.db.QueryContext(ctx, `
 		SELECT id, title, author_id, status FROM pull_request
-		WHERE reviewer1_id = $1 OR reviewer2_id = $1
+		WHERE reviewer1_id = $1 OR reviewer2_id = $1 OR shadow_reviewer_id = $1
 	`, userID)
 	if err != nil {
 		return nil, fmt.Errorf("query pull_request: %w", err)
@@ -451,34 +823,64 @@ func (s *Storage) GetReview(ctx context.Context, userID uuid.UUID) (*api.GetRevi
 	return grr, nil
 }
 
-func (s *Storage) GetStats(ctx context.Context) (*api.StatsResponse, error) {
+// GetStats считает статистику по всем PR либо только по PR команды teamName
+// (при includeDescendants — вместе с подкомандами). Назначения в API v1 считаются по имени ревьювера.
+func (s *Storage) GetStats(ctx context.Context, teamName string, includeDescendants bool) (*api.StatsResponse, error) {
+	reviewerStats, err := s.GetReviewerStats(ctx, teamName, includeDescendants)
+	if err != nil {
+		return nil, err
+	}
+
 	stats := &api.StatsResponse{
-		ReviewAssignments: make(map[string]int),
+		TeamName:          reviewerStats.TeamName,
+		TotalPR:           reviewerStats.TotalPR,
+		OpenPR:            reviewerStats.OpenPR,
+		ReviewAssignments: make(map[string]int, len(reviewerStats.ReviewAssignments)),
+	}
+	for _, count := range reviewerStats.ReviewAssignments {
+		stats.ReviewAssignments[count.Username] += count.Count
 	}
 
-	err := s.db.QueryRowContext(ctx, `
+	return stats, nil
+}
+
+// GetReviewerStats — та же статистика, но назначения списком по ревьюверам, от большего числа к меньшему.
+func (s *Storage) GetReviewerStats(ctx context.Context, teamName string, includeDescendants bool) (*api.StatsResponseV2, error) {
+	stats := &api.StatsResponseV2{
+		TeamName:          teamName,
+		ReviewAssignments: []api.ReviewerCount{},
+	}
+
+	teamIDs, err := statsTeamIDs(ctx, s.db, teamName, includeDescendants)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.QueryRowContext(ctx, `
 		SELECT COUNT(*), COUNT(*) FILTER (WHERE status = 'OPEN')
 		FROM pull_request
-	`).Scan(&stats.TotalPR, &stats.OpenPR)
+		WHERE $1::uuid[] IS NULL OR team_id = ANY($1)
+	`, teamIDs).Scan(&stats.TotalPR, &stats.OpenPR)
 	if err != nil {
 		return nil, fmt.Errorf("query total PR: %w", err)
 	}
 
 	rows, err := s.db.QueryContext(ctx, `
-		SELECT u.name, COUNT(*) AS total_reviews
+		SELECT u.id, u.name, COUNT(*) AS total_reviews
 		FROM (
-			SELECT reviewer1_id AS reviewer_id FROM pull_request WHERE reviewer1_id IS NOT NULL
+			SELECT reviewer1_id AS reviewer_id, team_id FROM pull_request WHERE reviewer1_id IS NOT NULL
 			UNION ALL
-			SELECT reviewer2_id AS reviewer_id FROM pull_request WHERE reviewer2_id IS NOT NULL
+			SELECT reviewer2_id AS reviewer_id, team_id FROM pull_request WHERE reviewer2_id IS NOT NULL
 		) sub
 		JOIN users u ON u.id = sub.reviewer_id
-		GROUP BY u.name
-		ORDER BY total_reviews DESC;
-	`)
+		WHERE $1::uuid[] IS NULL OR sub.team_id = ANY($1)
+		GROUP BY u.id, u.name
+		ORDER BY total_reviews DESC, u.name, u.id
+	`, teamIDs)
 	if err != nil {
 		return nil, fmt.Errorf("query review assignments: %w", err)
 	}
-	
+
 	defer func() {
 		if closeErr := rows.Close(); closeErr != nil && err == nil {
 			err = fmt.Errorf("close rows: %w", closeErr)
@@ -486,20 +888,43 @@ func (s *Storage) GetStats(ctx context.Context) (*api.StatsResponse, error) {
 	}()
 
 	for rows.Next() {
-		var userName string
-		var count int
-		if err := rows.Scan(&userName, &count); err != nil {
+		var count api.ReviewerCount
+		if err = rows.Scan(&count.UserID, &count.Username, &count.Count); err != nil {
 			return nil, fmt.Errorf("scan review assignment: %w", err)
 		}
-		stats.ReviewAssignments[userName] = count
+		stats.ReviewAssignments = append(stats.ReviewAssignments, count)
 	}
-	if err := rows.Err(); err != nil {
+	if err = rows.Err(); err != nil {
 		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
 
 	return stats, nil
 }
 
+// GetPullRequest возвращает PR вместе с действующими назначениями.
+func (s *Storage) GetPullRequest(ctx context.Context, prID uuid.UUID) (*api.PullRequest, error) {
+	prs, err := s.PullRequestsByIDs(ctx, []uuid.UUID{prID})
+	if err != nil {
+		return nil, err
+	}
+
+	pr, ok := prs[prID]
+	if !ok {
+		return nil, api.NewAPIError(api.ErrNotFound, "pull request not found")
+	}
+
+	if pr.Assignments, err = loadAssignments(ctx, s.db, prID); err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+// GetUser возвращает пользователя с его членствами в командах.
+func (s *Storage) GetUser(ctx context.Context, userID uuid.UUID) (*api.User, error) {
+	return loadUser(ctx, s.db, userID)
+}
+
 func makeReviewers(reviewer1ID, reviewer2ID *uuid.UUID) []uuid.UUID {
 	reviewers := make([]uuid.UUID, 0, 2)
 	if reviewer1ID != nil {
@@ -510,3 +935,10 @@ func makeReviewers(reviewer1ID, reviewer2ID *uuid.UUID) []uuid.UUID {
 	}
 	return reviewers
 }
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
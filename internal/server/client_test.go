@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cfg "github.com/F3dosik/PRS.git/internal/config/server"
+	"github.com/F3dosik/PRS.git/pkg/client"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+// TestClient проходит типизированным клиентом по основному сценарию у сервера с настоящими
+// маршрутами и проверяет, что ошибки хендлеров доходят до клиента *api.APIError с их кодами.
+func TestClient(t *testing.T) {
+	config := &cfg.ServerConfig{AdminToken: "client-admin-token"}
+	ts := newTestServer(t, config)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	c := client.New(ts.URL, client.WithHTTPClient(ts.Client()), client.WithToken(config.AdminToken))
+
+	// Имена уникальны: база TEST_DATABASE_URL общая с другими тестами
+	suffix := uuid.NewString()[:8]
+	author, first, second := uuid.New(), uuid.New(), uuid.New()
+	team := api.Team{
+		TeamName: "client-" + suffix,
+		Members: []api.TeamMember{
+			{UserID: author, Username: "Ann " + suffix, IsActive: true},
+			{UserID: first, Username: "Bob " + suffix, IsActive: true},
+			{UserID: second, Username: "Dan " + suffix, IsActive: true},
+		},
+	}
+	if _, err := c.AddTeam(ctx, team); err != nil {
+		t.Fatalf("AddTeam: %v", err)
+	}
+
+	got, err := c.GetTeam(ctx, team.TeamName, false)
+	if err != nil {
+		t.Fatalf("GetTeam: %v", err)
+	}
+	if got.TeamName != team.TeamName || len(got.Members) != len(team.Members) {
+		t.Fatalf("GetTeam = %+v, want %d members of %s", got, len(team.Members), team.TeamName)
+	}
+
+	create := api.PullRequestCreateRequest{
+		PullRequestShort: api.PullRequestShort{PullRequestID: uuid.New(), PullRequestName: "Add retries", AuthorID: author},
+		TeamName:         team.TeamName,
+	}
+	pr, err := c.CreatePullRequest(ctx, create)
+	if err != nil {
+		t.Fatalf("CreatePullRequest: %v", err)
+	}
+	if pr.Status != api.StatusOpen || len(pr.AssignedReviewers) != 2 {
+		t.Fatalf("CreatePullRequest = %+v, want an open PR with 2 reviewers", pr)
+	}
+
+	fetched, err := c.GetPullRequest(ctx, pr.PullRequestID)
+	if err != nil {
+		t.Fatalf("GetPullRequest: %v", err)
+	}
+	if fetched.PullRequestName != create.PullRequestName {
+		t.Fatalf("GetPullRequest = %+v, want %q", fetched, create.PullRequestName)
+	}
+
+	merged, err := c.MergePullRequest(ctx, pr.PullRequestID)
+	if err != nil {
+		t.Fatalf("MergePullRequest: %v", err)
+	}
+	if merged.Status != api.StatusMerged || merged.MergedAt == nil {
+		t.Fatalf("MergePullRequest = %+v, want a merged PR", merged)
+	}
+	again, err := c.MergePullRequest(ctx, pr.PullRequestID)
+	if err != nil {
+		t.Fatalf("repeated MergePullRequest: %v", err)
+	}
+	if !again.MergedAt.Equal(*merged.MergedAt) {
+		t.Fatalf("repeated merge moved merged_at from %v to %v", merged.MergedAt, again.MergedAt)
+	}
+
+	if _, err = c.PoolStats(ctx); err != nil {
+		t.Fatalf("PoolStats: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		do       func() error
+		wantCode api.ErrorCode
+	}{
+		{"team exists", func() error {
+			_, err := c.AddTeam(ctx, team)
+			return err
+		}, api.ErrTeamExist},
+		{"team not found", func() error {
+			_, err := c.GetTeam(ctx, "missing-"+suffix, false)
+			return err
+		}, api.ErrNotFound},
+		{"pull request exists", func() error {
+			_, err := c.CreatePullRequest(ctx, create)
+			return err
+		}, api.ErrPRExist},
+		{"pull request not found", func() error {
+			_, err := c.MergePullRequest(ctx, uuid.New())
+			return err
+		}, api.ErrNotFound},
+		{"reassign on merged", func() error {
+			_, err := c.ReassignPullRequest(ctx, pr.PullRequestID, pr.AssignedReviewers[0])
+			return err
+		}, api.ErrPRMerged},
+		{"user not found", func() error {
+			_, err := c.SetIsActive(ctx, uuid.New(), false, team.TeamName)
+			return err
+		}, api.ErrNotFound},
+		{"admin without token", func() error {
+			_, err := client.New(ts.URL, client.WithHTTPClient(ts.Client())).PoolStats(ctx)
+			return err
+		}, api.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.do()
+			var apiErr *api.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("got %v, want *api.APIError", err)
+			}
+			if apiErr.Code != tt.wantCode {
+				t.Fatalf("got %s (%s), want %s", apiErr.Code, apiErr.Message, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	cfg "github.com/F3dosik/PRS.git/internal/config/server"
+	"github.com/F3dosik/PRS.git/internal/contract"
+	"github.com/F3dosik/PRS.git/internal/middleware"
+	"go.uber.org/zap"
+)
+
+// newTestServer поднимает сервер с настоящими маршрутами на базе TEST_DATABASE_URL
+// с применёнными миграциями; без переменной тест пропускается.
+func newTestServer(t *testing.T, config *cfg.ServerConfig) *httptest.Server {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	config.DatabaseURL = dsn
+	config.AutoMigrate = true
+	if err := config.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := NewServer(config, zap.NewNop().Sugar())
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	t.Cleanup(s.storage.Close)
+
+	ts := httptest.NewServer(s.router)
+	t.Cleanup(ts.Close)
+
+	return ts
+}
+
+// TestContract вызывает каждую операцию спецификаций у сервера с настоящими маршрутами и базой.
+// Проверка создаёт данные, поэтому TEST_DATABASE_URL должен указывать на отдельную базу.
+func TestContract(t *testing.T) {
+	config := &cfg.ServerConfig{
+		OpenAPIValidation: string(middleware.ValidationTest),
+		AdminToken:        "contract-admin-token",
+	}
+	ts := newTestServer(t, config)
+
+	docs, err := contract.LoadSpecs()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := contract.New(ts.URL, ts.Client(), config.AdminToken)
+	if err := c.Setup(); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+
+	for _, doc := range docs {
+		for _, r := range c.CheckSpec(doc) {
+			if r.Err != nil {
+				t.Errorf("%s %s %d: %v", r.Method, r.Path, r.Status, r.Err)
+			}
+		}
+	}
+}
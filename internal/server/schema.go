@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cfg "github.com/F3dosik/PRS.git/internal/config/server"
+	"github.com/F3dosik/PRS.git/internal/migrate"
+	"github.com/F3dosik/PRS.git/migrations"
+	"go.uber.org/zap"
+)
+
+// schemaTimeout рассчитан на автомиграцию, а не только на проверку версии
+const schemaTimeout = 5 * time.Minute
+
+// prepareSchema при AUTO_MIGRATE применяет встроенные миграции и не даёт запустить сервер
+// на схеме, которая новее бинаря или осталась dirty после неудачной миграции.
+func prepareSchema(cfg *cfg.ServerConfig, logger *zap.SugaredLogger) error {
+	ctx, cancel := context.WithTimeout(context.Background(), schemaTimeout)
+	defer cancel()
+
+	migrator, err := migrate.Open(cfg.DatabaseURL, migrations.FS)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = migrator.Close() }()
+
+	if cfg.AutoMigrate {
+		steps, err := migrator.Up(ctx)
+		for _, step := range steps {
+			logger.Infow("migration applied", "version", step.Version, "name", step.Name)
+		}
+		if err != nil {
+			return fmt.Errorf("auto migration failed: %w", err)
+		}
+	}
+
+	status, err := migrator.Check(ctx)
+	if err != nil {
+		return err
+	}
+	if len(status.Pending) > 0 {
+		logger.Warnw("database schema is behind the binary, run prs migrate up",
+			"version", status.Version,
+			"latest", status.Latest,
+		)
+	}
+
+	return nil
+}
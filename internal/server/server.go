@@ -3,74 +3,260 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	spec "github.com/F3dosik/PRS.git/api"
+	"github.com/F3dosik/PRS.git/internal/assignment"
 	cfg "github.com/F3dosik/PRS.git/internal/config/server"
+	"github.com/F3dosik/PRS.git/internal/digest"
+	"github.com/F3dosik/PRS.git/internal/gql"
+	"github.com/F3dosik/PRS.git/internal/grpcserver"
 	"github.com/F3dosik/PRS.git/internal/handler"
+	v2 "github.com/F3dosik/PRS.git/internal/handler/v2"
 	"github.com/F3dosik/PRS.git/internal/middleware"
+	"github.com/F3dosik/PRS.git/internal/notify"
 	"github.com/F3dosik/PRS.git/internal/repository"
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 type Server struct {
-	config  *cfg.ServerConfig
-	storage *repository.Storage
-	router  chi.Router
-	logger  *zap.SugaredLogger
+	config   *cfg.ServerConfig
+	storage  *repository.Storage
+	notifier *notify.Notifier // nil, если уведомления не настроены
+	digests  *digest.Job      // nil, если почта не настроена
+	shutdown chan struct{}    // Закрывается при остановке сервера и завершает потоки событий
+	graphql  *gql.Executor
+	router   chi.Router
+	grpc     *grpc.Server // gRPC API на отдельном порту, те же операции, что и в routes
+	logger   *zap.SugaredLogger
 }
 
 func NewServer(cfg *cfg.ServerConfig, logger *zap.SugaredLogger) (*Server, error) {
-	storage, err := repository.NewStorage(cfg.DatabaseURL)
+	if err := prepareSchema(cfg, logger); err != nil {
+		return nil, fmt.Errorf("failed to prepare database schema: %w", err)
+	}
+	storage, err := repository.NewStorage(cfg.DatabaseURL, repository.PoolConfig{
+		MaxConns:         int32(cfg.DBMaxConns),
+		MinConns:         int32(cfg.DBMinConns),
+		MaxConnLifetime:  cfg.DBMaxConnLifetime,
+		StatementTimeout: cfg.DBStatementTimeout,
+		ApplicationName:  cfg.DBApplicationName,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to init storage: %w", err)
 	}
+	if cfg.AssignmentSeed != 0 {
+		storage.SetSeedSource(assignment.NewSeedSource(cfg.AssignmentSeed))
+	}
+	executor, err := gql.NewExecutor(storage, logger, gql.Config{
+		MaxDepth:      cfg.GraphQLMaxDepth,
+		MaxComplexity: cfg.GraphQLMaxComplexity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build graphql schema: %w", err)
+	}
 	r := chi.NewRouter()
 
 	server := &Server{
-		config:  cfg,
-		storage: storage,
-		router:  r,
-		logger:  logger,
+		config:   cfg,
+		storage:  storage,
+		shutdown: make(chan struct{}),
+		graphql:  executor,
+		router:   r,
+		logger:   logger,
+	}
+	if err := server.routes(); err != nil {
+		return nil, err
+	}
+	server.grpc = grpcserver.NewServer(grpcserver.NewService(storage, logger, server.shutdown), logger)
+
+	slack := notify.SlackConfig{
+		APIURL:       cfg.SlackAPIURL,
+		Token:        cfg.SlackToken,
+		WebhookURL:   cfg.SlackWebhookURL,
+		RateInterval: cfg.SlackRateInterval,
+		MaxAttempts:  cfg.SlackMaxAttempts,
+	}
+	if slack.Enabled() {
+		templates, err := notify.LoadTemplates(cfg.NotifyTemplatesFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load notification templates: %w", err)
+		}
+		server.notifier = notify.NewNotifier(storage, notify.NewSlackSender(slack), templates, logger)
+	}
+
+	smtp := digest.SMTPConfig{
+		Host:     cfg.SMTPHost,
+		Port:     cfg.SMTPPort,
+		Username: cfg.SMTPUsername,
+		Password: cfg.SMTPPassword,
+		From:     cfg.SMTPFrom,
+	}
+	if smtp.Enabled() {
+		templates, err := digest.LoadTemplates(cfg.DigestTemplateDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load digest templates: %w", err)
+		}
+		server.digests = digest.NewJob(storage, digest.NewSMTPMailer(smtp), templates, logger)
 	}
-	server.routes()
 
 	return server, nil
 }
 
-func (s *Server) routes() {
+func (s *Server) routes() error {
 	s.router.Use(middleware.WithLogging(s.logger))
+	s.router.Use(middleware.WithReadDeadline(handler.ImportTimeout, s.logger,
+		"/pullRequest/import", "/api/v1/pullRequest/import", v2.Prefix+"/pull-requests/import"))
+
+	if mode := middleware.ValidationMode(s.config.OpenAPIValidation); mode != middleware.ValidationOff {
+		docs := make([]*openapi3.T, 0, len(spec.Specs))
+		for _, name := range spec.Specs {
+			doc, err := middleware.LoadOpenAPI(spec.FS, name)
+			if err != nil {
+				return fmt.Errorf("failed to load openapi spec: %w", err)
+			}
+			docs = append(docs, doc)
+		}
+		validation, err := middleware.WithOpenAPIValidation(docs, mode, s.logger)
+		if err != nil {
+			return fmt.Errorf("failed to init openapi validation: %w", err)
+		}
+		s.router.Use(validation)
+	}
 
-	s.router.Route("/team", func(r chi.Router) {
+	// Пути без префикса — псевдонимы /api/v1 для существующих клиентов
+	s.router.Group(s.routesV1)
+	s.router.Route("/api/v1", s.routesV1)
+	s.router.Route(v2.Prefix, s.routesV2)
+
+	return nil
+}
+
+func (s *Server) routesV1(router chi.Router) {
+	router.Route("/team", func(r chi.Router) {
 		r.Post("/add", handler.HandleTeamAdd(s.storage, s.logger))
 		r.Get("/get", handler.HandleTeamGet(s.storage, s.logger))
+		r.Get("/fallback", handler.HandleTeamFallbackGet(s.storage, s.logger))
+		r.Post("/fallback", handler.HandleTeamFallbackSet(s.storage, s.logger))
+		r.Get("/subtree", handler.HandleTeamSubtree(s.storage, s.logger))
+		r.Post("/setParent", handler.HandleTeamSetParent(s.storage, s.logger))
+		r.Get("/owners", handler.HandleTeamOwnersGet(s.storage, s.logger))
+		r.Post("/owners", handler.HandleTeamOwnersSet(s.storage, s.logger))
+		r.Delete("/owners", handler.HandleTeamOwnersDelete(s.storage, s.logger))
+		r.Get("/reviewRules", handler.HandleTeamReviewRulesGet(s.storage, s.logger))
+		r.Post("/reviewRules", handler.HandleTeamReviewRulesSet(s.storage, s.logger))
+		r.Get("/sla", handler.HandleTeamSLAGet(s.storage, s.logger))
+		r.Post("/sla", handler.HandleTeamSLASet(s.storage, s.logger))
+		r.Get("/schedule", handler.HandleTeamScheduleGet(s.storage, s.logger))
+		r.Post("/schedule", handler.HandleTeamScheduleSet(s.storage, s.logger))
 	})
 
-	s.router.Route("/users", func(r chi.Router) {
+	router.Route("/users", func(r chi.Router) {
+		r.Get("/get", handler.HandlerGetUser(s.storage, s.logger))
 		r.Post("/setIsActive", handler.HandlerSetIsActive(s.storage, s.logger))
+		r.Post("/setSeniority", handler.HandlerSetSeniority(s.storage, s.logger))
 		r.Get("/getReview", handler.HandlerGetReview(s.storage, s.logger))
+		r.Get("/skills", handler.HandlerGetSkills(s.storage, s.logger))
+		r.Post("/skills", handler.HandlerSetSkills(s.storage, s.logger))
+		r.Get("/schedule", handler.HandlerGetSchedule(s.storage, s.logger))
+		r.Post("/schedule", handler.HandlerSetSchedule(s.storage, s.logger))
+		r.Get("/availability", handler.HandlerAvailability(s.storage, s.logger))
+		r.Get("/slack", handler.HandlerGetSlackUser(s.storage, s.logger))
+		r.Post("/slack", handler.HandlerSetSlackUser(s.storage, s.logger))
+		r.Get("/digest", handler.HandlerGetDigest(s.storage, s.logger))
+		r.Post("/digest", handler.HandlerSetDigest(s.storage, s.logger))
 	})
 
-	s.router.Route("/pullRequest", func(r chi.Router) {
+	router.Route("/pullRequest", func(r chi.Router) {
+		r.Get("/get", handler.HandlerPullRequestGet(s.storage, s.logger))
+		r.Get("/list", handler.HandlerPullRequestList(s.storage, s.logger))
 		r.Post("/create", handler.HandlerPullRequestCreate(s.storage, s.logger))
 		r.Post("/merge", handler.HandlerPullRequestMerge(s.storage, s.logger))
 		r.Post("/reassign", handler.HandlerPullRequestReassign(s.storage, s.logger))
+		r.Post("/import", handler.HandlerPullRequestImport(s.storage, s.logger))
+		r.Post("/fillReviewers", handler.HandlerPullRequestFillReviewers(s.storage, s.logger))
+		r.Get("/explainAssignment", handler.HandlerPullRequestExplainAssignment(s.storage, s.logger))
+		r.Get("/overdue", handler.HandlerPullRequestOverdue(s.storage, s.logger))
+	})
+
+	router.Get("/stats", handler.HandlerStats(s.storage, s.logger))
+	router.Get("/stats/pairs", handler.HandlerPairStats(s.storage, s.logger))
+	router.Get("/stats/latency", handler.HandlerLatencyStats(s.storage, s.logger))
+
+	router.Get("/events/stream", handler.HandlerEventsStream(s.storage, s.logger, s.shutdown))
+
+	router.Get("/holidays", handler.HandlerHolidays(s.storage, s.logger))
+	router.Post("/holidays/import", handler.HandlerHolidaysImport(s.storage, s.logger))
+
+	router.Route("/admin", func(r chi.Router) {
+		r.Use(middleware.WithAdminToken(s.config.AdminToken))
+		r.Get("/export", handler.HandlerAdminExport(s.storage, s.logger))
+		r.Get("/pool", handler.HandlerAdminPoolStats(s.storage, s.logger))
 	})
 
-	s.router.Get("/stats", handler.HandlerStats(s.storage, s.logger))
+	router.Get("/graphql", handler.HandlerGraphQL(s.graphql, s.logger))
+	router.Post("/graphql", handler.HandlerGraphQL(s.graphql, s.logger))
+}
+
+// routesV2 — те же операции над тем же хранилищем, что и v1, в виде REST-ресурсов.
+func (s *Server) routesV2(router chi.Router) {
+	router.Post("/teams", v2.HandlerTeamCreate(s.storage, s.logger))
+	router.Route("/teams/{team_name}", func(r chi.Router) {
+		r.Get("/", v2.HandlerTeamGet(s.storage, s.logger))
+		r.Put("/parent", v2.HandlerTeamSetParent(s.storage, s.logger))
+		r.Get("/subtree", v2.HandlerTeamSubtree(s.storage, s.logger))
+	})
+
+	router.Route("/users/{user_id}", func(r chi.Router) {
+		r.Get("/", v2.HandlerUserGet(s.storage, s.logger))
+		r.Put("/active", v2.HandlerUserSetActive(s.storage, s.logger))
+		r.Put("/seniority", v2.HandlerUserSetSeniority(s.storage, s.logger))
+		r.Get("/reviews", v2.HandlerUserReviews(s.storage, s.logger))
+	})
 
+	router.Get("/pull-requests", v2.HandlerPullRequestList(s.storage, s.logger))
+	router.Post("/pull-requests", v2.HandlerPullRequestCreate(s.storage, s.logger))
+	router.Post("/pull-requests/import", handler.HandlerPullRequestImport(s.storage, s.logger))
+	router.Post("/pull-requests/fill-reviewers", v2.HandlerPullRequestFillReviewers(s.storage, s.logger))
+	router.Route("/pull-requests/{pull_request_id}", func(r chi.Router) {
+		r.Get("/", v2.HandlerPullRequestGet(s.storage, s.logger))
+		r.Post("/merge", v2.HandlerPullRequestMerge(s.storage, s.logger))
+		r.Post("/reassign", v2.HandlerPullRequestReassign(s.storage, s.logger))
+		r.Get("/explanation", v2.HandlerPullRequestExplanation(s.storage, s.logger))
+	})
+
+	router.Get("/stats", v2.HandlerStats(s.storage, s.logger))
 }
 
 func (s *Server) Run() error {
 	s.logger.Infow("starting server",
 		"port", s.config.Port,
+		"grpc_port", s.config.GRPCPort,
 		"log_mode", s.config.LogMode,
+		"openapi_validation", s.config.OpenAPIValidation,
+		"auto_migrate", s.config.AutoMigrate,
+		"db_max_conns", s.storage.PoolStats().MaxConns,
 	)
 
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	go s.runBackfill(jobsCtx)
+	go s.runSLACheck(jobsCtx)
+	if s.notifier != nil {
+		go s.runNotifier(jobsCtx)
+	}
+	if s.digests != nil {
+		go s.runDigest(jobsCtx)
+	}
+
 	srv := &http.Server{
 		Addr:              s.config.Port,
 		Handler:           s.router,
@@ -79,25 +265,174 @@ func (s *Server) Run() error {
 		WriteTimeout:      10 * time.Second,
 		IdleTimeout:       30 * time.Second,
 	}
+	srv.RegisterOnShutdown(func() { close(s.shutdown) })
 
+	lis, err := net.Listen("tcp", s.config.GRPCPort)
+	if err != nil {
+		s.closeStorage()
+		return fmt.Errorf("grpc listen failed: %w", err)
+	}
 	go func() {
+		if err := s.grpc.Serve(lis); err != nil {
+			s.logger.Errorw("grpc server failed", "err", err)
+		}
+	}()
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+
 		stop := make(chan os.Signal, 1)
 		signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 		<-stop
 
 		s.logger.Infow("shutdown signal received")
+		stopJobs()
 
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
+
+		// Потоки событий gRPC завершаются по закрытию s.shutdown в srv.Shutdown
+		grpcStopped := make(chan struct{})
+		go func() {
+			s.grpc.GracefulStop()
+			close(grpcStopped)
+		}()
+
 		if err := srv.Shutdown(ctx); err != nil {
 			s.logger.Errorw("graceful shutdown failed", "err", err)
 		}
+
+		select {
+		case <-grpcStopped:
+		case <-ctx.Done():
+			s.logger.Errorw("grpc graceful shutdown timed out")
+			s.grpc.Stop()
+		}
+
+		s.closeStorage()
 	}()
 
 	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		s.grpc.Stop()
+		s.closeStorage()
 		return fmt.Errorf("server listen failed: %w", err)
 	}
 
+	<-stopped
 	s.logger.Infow("server stopped")
 	return nil
 }
+
+// closeStorage закрывает пул соединений и пишет в лог его итоговую статистику.
+func (s *Server) closeStorage() {
+	stats := s.storage.PoolStats()
+	s.logger.Infow("closing database pool",
+		"total_conns", stats.TotalConns,
+		"acquired_conns", stats.AcquiredConns,
+		"acquire_count", stats.AcquireCount,
+		"empty_acquire_count", stats.EmptyAcquireCount,
+		"canceled_acquire_count", stats.CanceledAcquireCount,
+		"acquire_duration_ms", stats.AcquireDurationMs,
+	)
+	s.storage.Close()
+}
+
+// runBackfill периодически добирает ревьюверов в PR, оставшиеся без полного состава.
+func (s *Server) runBackfill(ctx context.Context) {
+	ticker := time.NewTicker(s.config.BackfillInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sweepCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+			prs, err := s.storage.FillReviewers(sweepCtx, "")
+			cancel()
+			if err != nil {
+				s.logger.Errorw("reviewers backfill failed", "err", err)
+				continue
+			}
+			if len(prs) > 0 {
+				s.logger.Infow("reviewers backfilled", "pull_requests", len(prs))
+			}
+		}
+	}
+}
+
+func (s *Server) runSLACheck(ctx context.Context) {
+	ticker := time.NewTicker(s.config.SLACheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			checkCtx, cancel := context.WithTimeout(ctx, time.Minute)
+			report, err := s.storage.CheckSLA(checkCtx, now)
+			cancel()
+			if err != nil {
+				s.logger.Errorw("sla check failed", "err", err)
+				continue
+			}
+			if report.Breached > 0 || report.Reassigned > 0 || report.NoCandidate > 0 || report.Skipped > 0 {
+				s.logger.Infow("sla check",
+					"breached", report.Breached,
+					"reassigned", report.Reassigned,
+					"no_candidate", report.NoCandidate,
+					"skipped", report.Skipped,
+				)
+			}
+		}
+	}
+}
+
+// runNotifier периодически рассылает уведомления о новых событиях.
+func (s *Server) runNotifier(ctx context.Context) {
+	ticker := time.NewTicker(s.config.NotifyInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sent, err := s.notifier.Poll(ctx)
+			if err != nil && ctx.Err() == nil {
+				s.logger.Errorw("notification delivery failed", "err", err)
+				continue
+			}
+			if sent > 0 {
+				s.logger.Infow("notifications sent", "messages", sent)
+			}
+		}
+	}
+}
+
+// runDigest периодически рассылает дайджесты подписчикам, у которых наступило время отправки.
+func (s *Server) runDigest(ctx context.Context) {
+	ticker := time.NewTicker(s.config.DigestCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			report, err := s.digests.Run(ctx, now)
+			if err != nil && ctx.Err() == nil {
+				s.logger.Errorw("digest run failed", "err", err)
+				continue
+			}
+			if report != nil && (report.Sent > 0 || report.Failed > 0) {
+				s.logger.Infow("digests sent",
+					"sent", report.Sent,
+					"failed", report.Failed,
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Package sla вычисляет сроки ревью по политике команды.
+package sla
+
+import (
+	"time"
+
+	"github.com/F3dosik/PRS.git/internal/calendar"
+)
+
+// Policy — сроки ревью команды в рабочем времени. ReassignAfter == 0 отключает автоматическое переназначение.
+type Policy struct {
+	FirstReview   time.Duration
+	ReassignAfter time.Duration
+}
+
+// Status — состояние назначения относительно политики в момент now.
+type Status struct {
+	DueAt      time.Time
+	ReassignAt *time.Time
+	Breached   bool // Срок первого ревью истёк
+	Escalate   bool // Истёк и второй порог — ревьювера пора переназначить
+}
+
+// Evaluate отсчитывает сроки от момента назначения ревьювера: PRS не знает о самих ревью,
+// поэтому активностью считается назначение, и переназначение перезапускает таймер.
+// Сроки идут только в рабочее время расписания schedule.
+func Evaluate(p Policy, schedule calendar.Schedule, assignedAt, now time.Time) Status {
+	st := Status{DueAt: schedule.Add(assignedAt, p.FirstReview)}
+	st.Breached = !now.Before(st.DueAt)
+
+	if p.ReassignAfter > 0 {
+		reassignAt := schedule.Add(assignedAt, p.ReassignAfter)
+		st.ReassignAt = &reassignAt
+		st.Escalate = !now.Before(reassignAt)
+	}
+
+	return st
+}
@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_pull_request_need_more_reviewers
+    ON pull_request (created_at)
+    WHERE status = 'OPEN' AND need_more_reviewers;
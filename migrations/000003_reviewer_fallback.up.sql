@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS team_fallbacks (
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('team', 'any_active')),
+    fallback_team_id UUID REFERENCES teams(id) ON DELETE CASCADE,
+    PRIMARY KEY (team_id, position),
+    CHECK ((kind = 'team') = (fallback_team_id IS NOT NULL))
+);
+
+CREATE TABLE IF NOT EXISTS review_assignments (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id UUID NOT NULL REFERENCES pull_request(id) ON DELETE CASCADE,
+    reviewer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    source TEXT NOT NULL CHECK (source IN ('team', 'fallback_team', 'any_active')),
+    source_team_id UUID REFERENCES teams(id) ON DELETE SET NULL,
+    assigned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    unassigned_at TIMESTAMP WITH TIME ZONE NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_assignments_active
+    ON review_assignments (pull_request_id)
+    WHERE unassigned_at IS NULL;
+
+-- Существующие назначения считаем сделанными из команды автора
+INSERT INTO review_assignments (pull_request_id, reviewer_id, source, source_team_id, assigned_at)
+SELECT pr.id, r.reviewer_id, 'team', u.team_id, pr.created_at
+FROM pull_request pr
+JOIN users u ON u.id = pr.author_id
+CROSS JOIN LATERAL (VALUES (pr.reviewer1_id), (pr.reviewer2_id)) AS r(reviewer_id)
+WHERE r.reviewer_id IS NOT NULL;
@@ -0,0 +1,10 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS team_id UUID REFERENCES teams(id) ON DELETE SET NULL;
+
+UPDATE users u
+SET team_id = m.team_id
+FROM team_memberships m
+WHERE m.user_id = u.id AND m.is_primary;
+
+ALTER TABLE pull_request DROP COLUMN IF EXISTS team_id;
+
+DROP TABLE IF EXISTS team_memberships;
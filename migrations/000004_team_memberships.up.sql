@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS team_memberships (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    role TEXT NOT NULL DEFAULT 'member',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now(),
+    PRIMARY KEY (user_id, team_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_team_memberships_primary
+    ON team_memberships (user_id)
+    WHERE is_primary;
+
+CREATE INDEX IF NOT EXISTS idx_team_memberships_team
+    ON team_memberships (team_id);
+
+INSERT INTO team_memberships (user_id, team_id, is_primary)
+SELECT id, team_id, TRUE FROM users
+WHERE team_id IS NOT NULL;
+
+-- Команда PR фиксируется при создании и больше не зависит от членства автора
+ALTER TABLE pull_request ADD COLUMN IF NOT EXISTS team_id UUID REFERENCES teams(id) ON DELETE SET NULL;
+
+UPDATE pull_request pr
+SET team_id = u.team_id
+FROM users u
+WHERE u.id = pr.author_id;
+
+ALTER TABLE users DROP COLUMN IF EXISTS team_id;
@@ -0,0 +1,10 @@
+DELETE FROM team_fallbacks WHERE kind = 'ancestors';
+ALTER TABLE team_fallbacks DROP CONSTRAINT IF EXISTS team_fallbacks_kind_check;
+ALTER TABLE team_fallbacks ADD CONSTRAINT team_fallbacks_kind_check
+    CHECK (kind IN ('team', 'any_active'));
+
+DROP INDEX IF EXISTS idx_pull_request_team;
+DROP INDEX IF EXISTS idx_teams_parent;
+
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS teams_parent_not_self;
+ALTER TABLE teams DROP COLUMN IF EXISTS parent_id;
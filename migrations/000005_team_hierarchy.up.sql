@@ -0,0 +1,10 @@
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES teams(id) ON DELETE SET NULL;
+ALTER TABLE teams ADD CONSTRAINT teams_parent_not_self CHECK (parent_id <> id);
+
+CREATE INDEX IF NOT EXISTS idx_teams_parent ON teams (parent_id);
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_team ON pull_request (team_id);
+
+ALTER TABLE team_fallbacks DROP CONSTRAINT IF EXISTS team_fallbacks_kind_check;
+ALTER TABLE team_fallbacks ADD CONSTRAINT team_fallbacks_kind_check
+    CHECK (kind IN ('team', 'ancestors', 'any_active'));
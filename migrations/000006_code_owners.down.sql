@@ -0,0 +1,8 @@
+UPDATE review_assignments SET source = 'team' WHERE source = 'code_owner';
+ALTER TABLE review_assignments DROP CONSTRAINT IF EXISTS review_assignments_source_check;
+ALTER TABLE review_assignments ADD CONSTRAINT review_assignments_source_check
+    CHECK (source IN ('team', 'fallback_team', 'any_active'));
+
+DROP TABLE IF EXISTS code_owner_rule_owners;
+DROP TABLE IF EXISTS code_owner_rules;
+DROP TABLE IF EXISTS pull_request_files;
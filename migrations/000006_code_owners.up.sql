@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS pull_request_files (
+    pull_request_id UUID NOT NULL REFERENCES pull_request(id) ON DELETE CASCADE,
+    path TEXT NOT NULL,
+    PRIMARY KEY (pull_request_id, path)
+);
+
+CREATE TABLE IF NOT EXISTS code_owner_rules (
+    id BIGSERIAL PRIMARY KEY,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    pattern TEXT NOT NULL,
+    UNIQUE (team_id, position)
+);
+
+CREATE TABLE IF NOT EXISTS code_owner_rule_owners (
+    rule_id BIGINT NOT NULL REFERENCES code_owner_rules(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    owner_team_id UUID REFERENCES teams(id) ON DELETE CASCADE,
+    CHECK ((user_id IS NULL) <> (owner_team_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_code_owner_rule_owners_rule ON code_owner_rule_owners (rule_id);
+
+ALTER TABLE review_assignments DROP CONSTRAINT IF EXISTS review_assignments_source_check;
+ALTER TABLE review_assignments ADD CONSTRAINT review_assignments_source_check
+    CHECK (source IN ('code_owner', 'team', 'fallback_team', 'any_active'));
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_pull_request_open_reviewer2;
+DROP INDEX IF EXISTS idx_pull_request_open_reviewer1;
+DROP TABLE IF EXISTS pull_request_labels;
+DROP TABLE IF EXISTS user_skills;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS user_skills (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    skill TEXT NOT NULL,
+    PRIMARY KEY (user_id, skill)
+);
+
+CREATE TABLE IF NOT EXISTS pull_request_labels (
+    pull_request_id UUID NOT NULL REFERENCES pull_request(id) ON DELETE CASCADE,
+    label TEXT NOT NULL,
+    PRIMARY KEY (pull_request_id, label)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_open_reviewer1 ON pull_request (reviewer1_id) WHERE status = 'OPEN';
+CREATE INDEX IF NOT EXISTS idx_pull_request_open_reviewer2 ON pull_request (reviewer2_id) WHERE status = 'OPEN';
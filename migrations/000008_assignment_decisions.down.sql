@@ -0,0 +1,2 @@
+ALTER TABLE review_assignments DROP COLUMN IF EXISTS decision_id;
+DROP TABLE IF EXISTS assignment_decisions;
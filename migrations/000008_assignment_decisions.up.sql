@@ -0,0 +1,16 @@
+-- Снимок входных данных каждого решения о назначении: по нему и seed решение воспроизводится
+CREATE TABLE IF NOT EXISTS assignment_decisions (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id UUID NOT NULL REFERENCES pull_request(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL CHECK (reason IN ('create', 'reassign', 'backfill')),
+    seed BIGINT NOT NULL,
+    algorithm_version INT NOT NULL,
+    input JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_assignment_decisions_pull_request
+    ON assignment_decisions (pull_request_id, id);
+
+ALTER TABLE review_assignments
+    ADD COLUMN IF NOT EXISTS decision_id BIGINT REFERENCES assignment_decisions(id) ON DELETE SET NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE review_assignments DROP COLUMN IF EXISTS shadow;
+ALTER TABLE pull_request DROP COLUMN IF EXISTS shadow_reviewer_id;
+DROP TABLE IF EXISTS team_review_rules;
+ALTER TABLE users DROP COLUMN IF EXISTS seniority;
@@ -0,0 +1,13 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS seniority TEXT NOT NULL DEFAULT 'middle'
+    CHECK (seniority IN ('junior', 'middle', 'senior'));
+
+CREATE TABLE IF NOT EXISTS team_review_rules (
+    team_id UUID PRIMARY KEY REFERENCES teams(id) ON DELETE CASCADE,
+    require_senior BOOLEAN NOT NULL DEFAULT FALSE,
+    shadow_junior BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+-- Теневой ревьювер не занимает слот reviewer1/reviewer2 и не влияет на need_more_reviewers
+ALTER TABLE pull_request ADD COLUMN IF NOT EXISTS shadow_reviewer_id UUID REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE review_assignments ADD COLUMN IF NOT EXISTS shadow BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_review_assignments_reviewer;
+DROP INDEX IF EXISTS idx_pull_request_author_created;
+ALTER TABLE team_review_rules
+    DROP COLUMN IF EXISTS rotation_penalty,
+    DROP COLUMN IF EXISTS rotation_window;
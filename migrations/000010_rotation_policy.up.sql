@@ -0,0 +1,9 @@
+ALTER TABLE team_review_rules
+    ADD COLUMN IF NOT EXISTS rotation_window INT NOT NULL DEFAULT 0 CHECK (rotation_window >= 0),
+    ADD COLUMN IF NOT EXISTS rotation_penalty DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (rotation_penalty >= 0);
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_author_created
+    ON pull_request (author_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_review_assignments_reviewer
+    ON review_assignments (reviewer_id, pull_request_id);
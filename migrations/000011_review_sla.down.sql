@@ -0,0 +1,12 @@
+DELETE FROM assignment_decisions WHERE reason = 'sla';
+ALTER TABLE assignment_decisions DROP CONSTRAINT IF EXISTS assignment_decisions_reason_check;
+ALTER TABLE assignment_decisions ADD CONSTRAINT assignment_decisions_reason_check
+    CHECK (reason IN ('create', 'reassign', 'backfill'));
+
+DROP TABLE IF EXISTS events;
+
+ALTER TABLE review_assignments
+    DROP COLUMN IF EXISTS sla_escalated_at,
+    DROP COLUMN IF EXISTS sla_breached_at;
+
+DROP TABLE IF EXISTS team_sla;
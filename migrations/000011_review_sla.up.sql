@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS team_sla (
+    team_id UUID PRIMARY KEY REFERENCES teams(id) ON DELETE CASCADE,
+    first_review_hours INT NOT NULL CHECK (first_review_hours > 0),
+    reassign_after_hours INT CHECK (reassign_after_hours > first_review_hours)
+);
+
+ALTER TABLE review_assignments
+    ADD COLUMN IF NOT EXISTS sla_breached_at TIMESTAMP WITH TIME ZONE NULL,
+    ADD COLUMN IF NOT EXISTS sla_escalated_at TIMESTAMP WITH TIME ZONE NULL;
+
+-- Журнал событий PRS: из него читают уведомления и потоковые подписчики
+CREATE TABLE IF NOT EXISTS events (
+    id BIGSERIAL PRIMARY KEY,
+    type TEXT NOT NULL,
+    pull_request_id UUID REFERENCES pull_request(id) ON DELETE CASCADE,
+    team_id UUID REFERENCES teams(id) ON DELETE SET NULL,
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_type ON events (type, id);
+
+ALTER TABLE assignment_decisions DROP CONSTRAINT IF EXISTS assignment_decisions_reason_check;
+ALTER TABLE assignment_decisions ADD CONSTRAINT assignment_decisions_reason_check
+    CHECK (reason IN ('create', 'reassign', 'backfill', 'sla'));
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS holidays;
+DROP TABLE IF EXISTS user_schedules;
+DROP TABLE IF EXISTS team_schedules;
@@ -0,0 +1,29 @@
+-- work_days — битовая маска дней недели: бит 0 — воскресенье, бит 6 — суббота.
+-- Рабочие часы [start_minute, end_minute) в минутах от полуночи по местному времени
+CREATE TABLE IF NOT EXISTS team_schedules (
+    team_id UUID PRIMARY KEY REFERENCES teams(id) ON DELETE CASCADE,
+    timezone TEXT NOT NULL,
+    work_days SMALLINT NOT NULL CHECK (work_days > 0 AND work_days < 128),
+    start_minute SMALLINT NOT NULL CHECK (start_minute >= 0),
+    end_minute SMALLINT NOT NULL CHECK (end_minute <= 1440 AND end_minute > start_minute)
+);
+
+-- Расписание пользователя важнее расписания команды
+CREATE TABLE IF NOT EXISTS user_schedules (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    timezone TEXT NOT NULL,
+    work_days SMALLINT NOT NULL CHECK (work_days > 0 AND work_days < 128),
+    start_minute SMALLINT NOT NULL CHECK (start_minute >= 0),
+    end_minute SMALLINT NOT NULL CHECK (end_minute <= 1440 AND end_minute > start_minute)
+);
+
+-- Праздники без команды действуют для всех; пустой year означает ежегодный праздник
+CREATE TABLE IF NOT EXISTS holidays (
+    id BIGSERIAL PRIMARY KEY,
+    team_id UUID REFERENCES teams(id) ON DELETE CASCADE,
+    year SMALLINT,
+    month SMALLINT NOT NULL CHECK (month BETWEEN 1 AND 12),
+    day SMALLINT NOT NULL CHECK (day BETWEEN 1 AND 31),
+    name TEXT NOT NULL DEFAULT '',
+    UNIQUE NULLS NOT DISTINCT (team_id, year, month, day)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS user_slack_ids (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    slack_id TEXT NOT NULL
+);
+
+-- Позиция потребителя в журнале событий: id последнего обработанного события
+CREATE TABLE IF NOT EXISTS event_cursors (
+    name TEXT PRIMARY KEY,
+    last_event_id BIGINT NOT NULL
+);
@@ -0,0 +1,11 @@
+-- Подписка на дайджест ожидающих ревью. send_minute — время отправки в минутах от полуночи
+-- по часовому поясу пользователя, weekday (0 — воскресенье) используется для еженедельного дайджеста
+CREATE TABLE IF NOT EXISTS digest_subscriptions (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    email TEXT NOT NULL,
+    frequency TEXT NOT NULL CHECK (frequency IN ('daily', 'weekly')),
+    send_minute SMALLINT NOT NULL CHECK (send_minute >= 0 AND send_minute < 1440),
+    weekday SMALLINT NOT NULL DEFAULT 1 CHECK (weekday BETWEEN 0 AND 6),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    last_sent_at TIMESTAMP WITH TIME ZONE NULL
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_pull_request_author_id;
+DROP INDEX IF EXISTS idx_pull_request_created_at_id;
@@ -0,0 +1,4 @@
+-- Постраничная выдача /pullRequest/list: от новых к старым, курсор — (created_at, id)
+CREATE INDEX IF NOT EXISTS idx_pull_request_created_at_id
+    ON pull_request (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_pull_request_author_id ON pull_request (author_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_events_xid;
+
+ALTER TABLE events DROP COLUMN IF EXISTS xid;
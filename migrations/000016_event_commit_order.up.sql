@@ -0,0 +1,6 @@
+-- Транзакция, записавшая событие. Читатели журнала видят только события транзакций старше
+-- самой старой незавершённой и идут в порядке (xid, id): событие, зафиксированное позже
+-- событий с большим id, не пропускается
+ALTER TABLE events ADD COLUMN IF NOT EXISTS xid xid8 NOT NULL DEFAULT pg_current_xact_id();
+
+CREATE INDEX IF NOT EXISTS idx_events_xid ON events (xid, id);
@@ -0,0 +1,8 @@
+// Package migrations встраивает SQL-миграции в бинарь: команда prs migrate и автомиграция
+// при старте применяют ровно те файлы, с которыми собран сервер.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
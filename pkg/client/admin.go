@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+)
+
+const (
+	ExportNDJSON = "ndjson"
+	ExportZip    = "zip"
+)
+
+// Export записывает в w выгрузку данных в формате ExportNDJSON или ExportZip.
+func (c *Client) Export(ctx context.Context, format string, w io.Writer) error {
+	query := url.Values{"format": {format}}
+
+	resp, err := c.streaming().send(ctx, true, http.MethodGet, "/admin/export", query, "", nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("read export: %w", err)
+	}
+	return nil
+}
+
+// PoolStats возвращает состояние пула соединений сервиса с базой.
+func (c *Client) PoolStats(ctx context.Context) (*api.PoolStats, error) {
+	var resp api.PoolStatsResponse
+	if err := c.do(ctx, http.MethodGet, "/admin/pool", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Pool, nil
+}
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// GraphQL выполняет запрос к /graphql и декодирует поле data ответа в out.
+// Ошибки выполнения из поля errors возвращаются одной ошибкой.
+func (c *Client) GraphQL(ctx context.Context, query string, variables map[string]any, out any) error {
+	req := graphQLRequest{Query: query, Variables: variables}
+
+	var resp graphQLResponse
+	if err := c.doIdempotent(ctx, http.MethodPost, "/graphql", nil, req, &resp); err != nil {
+		return err
+	}
+
+	if len(resp.Errors) > 0 {
+		errs := make([]error, len(resp.Errors))
+		for i, e := range resp.Errors {
+			errs[i] = errors.New(e.Message)
+		}
+		return fmt.Errorf("graphql: %w", errors.Join(errs...))
+	}
+
+	if out == nil || len(resp.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(resp.Data, out)
+}
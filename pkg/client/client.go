@@ -0,0 +1,198 @@
+// Package client — типизированный клиент HTTP API PRS (v1, префикс /api/v1).
+// Ошибки, которые вернул сервис, приходят как *api.APIError с кодом из тела ответа.
+// Идемпотентные вызовы повторяются при сетевых ошибках и ответах 429, 502, 503 и 504.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+)
+
+const (
+	apiPrefix      = "/api/v1"
+	defaultTimeout = 30 * time.Second
+	defaultRetries = 2
+	defaultBackoff = 200 * time.Millisecond
+
+	contentTypeJSON = "application/json"
+)
+
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+	retries    int
+	backoff    time.Duration
+}
+
+type Option func(*Client)
+
+// WithHTTPClient заменяет http.Client по умолчанию (с таймаутом 30 с).
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithToken добавляет к запросам заголовок Authorization: Bearer — для сервиса за авторизующим прокси.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithRetries задаёт число повторов идемпотентных вызовов и паузу перед первым повтором;
+// каждая следующая пауза вдвое длиннее. Ноль повторов отключает их.
+func WithRetries(retries int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.retries = retries
+		c.backoff = backoff
+	}
+}
+
+// New создаёт клиент сервиса по адресу baseURL, например http://localhost:8080.
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		retries:    defaultRetries,
+		backoff:    defaultBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// do отправляет JSON-запрос и декодирует успешный ответ в out, если он не nil.
+// Повторяются только GET и DELETE; идемпотентные POST вызываются через doIdempotent.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	idempotent := method == http.MethodGet || method == http.MethodDelete
+	return c.doJSON(ctx, idempotent, method, path, query, body, out)
+}
+
+// doIdempotent — для POST, повтор которых не меняет результат: замена настроек целиком,
+// слияние PR, запросы GraphQL на чтение.
+func (c *Client) doIdempotent(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	return c.doJSON(ctx, true, method, path, query, body, out)
+}
+
+func (c *Client) doJSON(ctx context.Context, idempotent bool, method, path string, query url.Values, body, out any) error {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+	}
+
+	resp, err := c.send(ctx, idempotent, method, path, query, contentTypeJSON, data)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode %s %s response: %w", method, path, err)
+	}
+
+	return nil
+}
+
+// send выполняет запрос, при необходимости с повторами, и возвращает успешный ответ;
+// его тело закрывает вызывающий. Ответ со статусом 4xx или 5xx превращается в ошибку.
+func (c *Client) send(ctx context.Context, idempotent bool, method, path string, query url.Values, contentType string, body []byte) (*http.Response, error) {
+	target := c.baseURL + apiPrefix + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	for attempt := 0; ; attempt++ {
+		var reader io.Reader
+		if body != nil {
+			reader = bytes.NewReader(body)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, target, reader)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", contentTypeJSON)
+		if body != nil {
+			req.Header.Set("Content-Type", contentType)
+		}
+		if c.token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.token)
+		}
+
+		resp, err := c.httpClient.Do(req)
+		retry := idempotent && attempt < c.retries && ctx.Err() == nil &&
+			(err != nil || retryableStatus(resp.StatusCode))
+		if !retry {
+			if err != nil {
+				return nil, err
+			}
+			if resp.StatusCode >= http.StatusBadRequest {
+				defer func() { _ = resp.Body.Close() }()
+				return nil, responseError(resp)
+			}
+			return resp, nil
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+			_ = resp.Body.Close()
+		}
+		if err := sleep(ctx, c.backoff<<attempt); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func retryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// responseError возвращает *api.APIError из тела ошибки или, если тело не в формате API, ошибку со статусом.
+func responseError(resp *http.Response) error {
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("read error response: %w", err)
+	}
+
+	var errResp api.ErrorResponse
+	if err := json.Unmarshal(data, &errResp); err == nil && errResp.Err.Code != "" {
+		return &errResp.Err
+	}
+
+	return fmt.Errorf("unexpected response %s: %s", resp.Status, strings.TrimSpace(string(data)))
+}
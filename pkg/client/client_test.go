@@ -0,0 +1,174 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/client"
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+var pullRequestID = uuid.MustParse("e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a54")
+
+// Вызовы против настоящих маршрутов и коды ошибок хендлеров проверяет TestClient в internal/server;
+// подставной сервер нужен только для повторов и отмены, которые настоящий сервер не воспроизводит.
+
+// flakyServer отвечает status на первые failures запросов, затем — успешным ответом вызова.
+func flakyServer(t *testing.T, status, failures int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var attempts atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if int(attempts.Add(1)) <= failures {
+			writeJSON(w, status, api.NewErrorResponse(*api.NewAPIError(api.ErrorCode("UNAVAILABLE"), "try again")))
+			return
+		}
+
+		switch r.URL.Path {
+		case "/api/v1/team/get":
+			writeJSON(w, http.StatusOK, api.TeamResponse{Team: &api.Team{TeamName: "backend", Members: []api.TeamMember{}}})
+		case "/api/v1/pullRequest/merge", "/api/v1/pullRequest/create":
+			writeJSON(w, http.StatusOK, api.PullRequestResponse{PullRequest: api.PullRequest{}})
+		case "/api/v1/pullRequest/reassign":
+			writeJSON(w, http.StatusOK, api.PullRequestReassignResponse{})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	return ts, &attempts
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+type call struct {
+	name string
+	do   func(ctx context.Context, c *client.Client) error
+}
+
+var (
+	getTeam = call{"GET /team/get", func(ctx context.Context, c *client.Client) error {
+		_, err := c.GetTeam(ctx, "backend", false)
+		return err
+	}}
+	mergePullRequest = call{"POST /pullRequest/merge", func(ctx context.Context, c *client.Client) error {
+		_, err := c.MergePullRequest(ctx, pullRequestID)
+		return err
+	}}
+	createPullRequest = call{"POST /pullRequest/create", func(ctx context.Context, c *client.Client) error {
+		_, err := c.CreatePullRequest(ctx, api.PullRequestCreateRequest{
+			PullRequestShort: api.PullRequestShort{PullRequestID: pullRequestID, PullRequestName: "Add retries", AuthorID: uuid.New()},
+		})
+		return err
+	}}
+	reassignPullRequest = call{"POST /pullRequest/reassign", func(ctx context.Context, c *client.Client) error {
+		_, err := c.ReassignPullRequest(ctx, pullRequestID, uuid.New())
+		return err
+	}}
+)
+
+func TestRetryIdempotent(t *testing.T) {
+	statuses := []int{
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+
+	for _, c := range []call{getTeam, mergePullRequest} {
+		for _, status := range statuses {
+			t.Run(fmt.Sprintf("%s %d", c.name, status), func(t *testing.T) {
+				ts, attempts := flakyServer(t, status, 2)
+				cl := client.New(ts.URL, client.WithRetries(2, time.Millisecond))
+
+				if err := c.do(context.Background(), cl); err != nil {
+					t.Fatalf("got %v after %d attempts", err, attempts.Load())
+				}
+				if got := attempts.Load(); got != 3 {
+					t.Fatalf("got %d attempts, want 3", got)
+				}
+			})
+		}
+	}
+}
+
+func TestRetryLimit(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		retries      int
+		wantAttempts int32
+	}{
+		{name: "retries exhausted", status: http.StatusServiceUnavailable, retries: 2, wantAttempts: 3},
+		{name: "retries disabled", status: http.StatusServiceUnavailable, retries: 0, wantAttempts: 1},
+		{name: "not retryable status", status: http.StatusInternalServerError, retries: 2, wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, attempts := flakyServer(t, tt.status, 10)
+			cl := client.New(ts.URL, client.WithRetries(tt.retries, time.Millisecond))
+
+			err := getTeam.do(context.Background(), cl)
+			var apiErr *api.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("got %v, want the last *api.APIError", err)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Fatalf("got %d attempts, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestNoRetryNonIdempotent(t *testing.T) {
+	for _, c := range []call{createPullRequest, reassignPullRequest} {
+		for _, status := range []int{http.StatusTooManyRequests, http.StatusServiceUnavailable} {
+			t.Run(fmt.Sprintf("%s %d", c.name, status), func(t *testing.T) {
+				ts, attempts := flakyServer(t, status, 1)
+				cl := client.New(ts.URL, client.WithRetries(2, time.Millisecond))
+
+				if err := c.do(context.Background(), cl); err == nil {
+					t.Fatal("expected error")
+				}
+				if got := attempts.Load(); got != 1 {
+					t.Fatalf("got %d attempts, want 1", got)
+				}
+			})
+		}
+	}
+}
+
+func TestCancelDuringBackoff(t *testing.T) {
+	ts, attempts := flakyServer(t, http.StatusServiceUnavailable, 10)
+	// Пауза перед повтором заведомо длиннее теста: вернуть управление может только отмена контекста
+	cl := client.New(ts.URL, client.WithRetries(2, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := getTeam.do(ctx, cl)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("returned after %v", elapsed)
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Fatalf("got %d attempts, want 1", got)
+	}
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+// EventsOptions — фильтр потока событий. Без LastEventID поток начинается с новых событий.
+type EventsOptions struct {
+	TeamName           string
+	IncludeDescendants bool
+	UserID             *uuid.UUID
+	Types              []api.EventType
+	LastEventID        *int64
+}
+
+// Events читает поток /events/stream и вызывает handle для каждого события, пока не отменён ctx,
+// сервис не закрыл поток или handle не вернул ошибку. Переподключение — на вызывающем:
+// ID последнего обработанного события передаётся в LastEventID.
+func (c *Client) Events(ctx context.Context, opts EventsOptions, handle func(api.Event) error) error {
+	query := teamQuery(opts.TeamName, opts.IncludeDescendants)
+	if opts.UserID != nil {
+		query.Set("user_id", opts.UserID.String())
+	}
+	if len(opts.Types) > 0 {
+		types := make([]string, len(opts.Types))
+		for i, t := range opts.Types {
+			types[i] = string(t)
+		}
+		query.Set("types", strings.Join(types, ","))
+	}
+	if opts.LastEventID != nil {
+		query.Set("last_event_id", strconv.FormatInt(*opts.LastEventID, 10))
+	}
+
+	resp, err := c.streaming().send(ctx, true, http.MethodGet, "/events/stream", query, "", nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1<<20)
+
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			// Пустая строка завершает событие; блоки без data (retry, комментарии) пропускаются
+			if data.Len() == 0 {
+				continue
+			}
+			var event api.Event
+			if err := json.Unmarshal([]byte(data.String()), &event); err != nil {
+				return fmt.Errorf("decode event: %w", err)
+			}
+			data.Reset()
+			if err := handle(event); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// streaming возвращает копию клиента без общего таймаута запроса: поток событий и выгрузка
+// читаются дольше, их ограничивает только ctx.
+func (c *Client) streaming() *Client {
+	stream := *c
+	httpClient := *c.httpClient
+	httpClient.Timeout = 0
+	stream.httpClient = &httpClient
+	return &stream
+}
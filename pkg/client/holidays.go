@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+)
+
+// GetHolidays возвращает общие праздники и праздники команды; пустой teamName — праздники всех команд.
+func (c *Client) GetHolidays(ctx context.Context, teamName string) ([]api.Holiday, error) {
+	var resp api.HolidaysResponse
+	if err := c.do(ctx, http.MethodGet, "/holidays", teamQuery(teamName, false), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Holidays, nil
+}
+
+// ImportHolidays загружает праздники из iCalendar; с replace ранее загруженные праздники
+// команды (или общие) удаляются. Возвращает число импортированных дней.
+func (c *Client) ImportHolidays(ctx context.Context, ics io.Reader, teamName string, replace bool) (int, error) {
+	data, err := io.ReadAll(ics)
+	if err != nil {
+		return 0, fmt.Errorf("read calendar: %w", err)
+	}
+
+	query := teamQuery(teamName, false)
+	if replace {
+		query.Set("replace", strconv.FormatBool(true))
+	}
+
+	resp, err := c.send(ctx, replace, http.MethodPost, "/holidays/import", query, "text/calendar", data)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var imported api.HolidayImportResponse
+	if err := json.NewDecoder(resp.Body).Decode(&imported); err != nil {
+		return 0, fmt.Errorf("decode holidays import response: %w", err)
+	}
+	return imported.Imported, nil
+}
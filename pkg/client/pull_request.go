@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+// CreatePullRequest создаёт PR и назначает ревьюверов.
+func (c *Client) CreatePullRequest(ctx context.Context, req api.PullRequestCreateRequest) (*api.PullRequest, error) {
+	// Спецификация не допускает null вместо массива
+	if req.ChangedFiles == nil {
+		req.ChangedFiles = []string{}
+	}
+	if req.Labels == nil {
+		req.Labels = []string{}
+	}
+
+	var resp api.PullRequestResponse
+	if err := c.do(ctx, http.MethodPost, "/pullRequest/create", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.PullRequest, nil
+}
+
+func (c *Client) GetPullRequest(ctx context.Context, pullRequestID uuid.UUID) (*api.PullRequest, error) {
+	var resp api.PullRequestResponse
+	if err := c.do(ctx, http.MethodGet, "/pullRequest/get", pullRequestQuery(pullRequestID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.PullRequest, nil
+}
+
+// ListPullRequests возвращает страницу PR; следующая запрашивается с Cursor = NextCursor.
+func (c *Client) ListPullRequests(ctx context.Context, filter api.PullRequestFilter) (*api.PullRequestListResponse, error) {
+	query := teamQuery(filter.TeamName, filter.IncludeDescendants)
+	if filter.Status != "" {
+		query.Set("status", string(filter.Status))
+	}
+	if filter.AuthorID != nil {
+		query.Set("author_id", filter.AuthorID.String())
+	}
+	if filter.ReviewerID != nil {
+		query.Set("reviewer_id", filter.ReviewerID.String())
+	}
+	if filter.CreatedFrom != nil {
+		query.Set("created_from", filter.CreatedFrom.Format(time.RFC3339Nano))
+	}
+	if filter.CreatedTo != nil {
+		query.Set("created_to", filter.CreatedTo.Format(time.RFC3339Nano))
+	}
+	if filter.NeedMoreReviewers != nil {
+		query.Set("need_more_reviewers", strconv.FormatBool(*filter.NeedMoreReviewers))
+	}
+	if filter.Cursor != "" {
+		query.Set("cursor", filter.Cursor)
+	}
+	if filter.Limit > 0 {
+		query.Set("limit", strconv.Itoa(filter.Limit))
+	}
+
+	var resp api.PullRequestListResponse
+	if err := c.do(ctx, http.MethodGet, "/pullRequest/list", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// MergePullRequest помечает PR слитым; повторный вызов возвращает тот же PR.
+func (c *Client) MergePullRequest(ctx context.Context, pullRequestID uuid.UUID) (*api.PullRequest, error) {
+	req := api.PullRequestMergeRequest{PullRequestID: pullRequestID}
+
+	var resp api.PullRequestResponse
+	if err := c.doIdempotent(ctx, http.MethodPost, "/pullRequest/merge", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.PullRequest, nil
+}
+
+// ReassignPullRequest заменяет ревьювера oldUserID другим кандидатом.
+func (c *Client) ReassignPullRequest(ctx context.Context, pullRequestID, oldUserID uuid.UUID) (*api.PullRequestReassignResponse, error) {
+	req := api.PullRequestReassignRequest{PullRequestID: pullRequestID, OldUserID: oldUserID}
+
+	var resp api.PullRequestReassignResponse
+	if err := c.do(ctx, http.MethodPost, "/pullRequest/reassign", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// FillReviewers добирает ревьюверов в PR с need_more_reviewers; пустой teamName — во всех командах.
+func (c *Client) FillReviewers(ctx context.Context, teamName string) ([]api.PullRequest, error) {
+	req := api.FillReviewersRequest{TeamName: teamName}
+
+	var resp api.FillReviewersResponse
+	if err := c.do(ctx, http.MethodPost, "/pullRequest/fillReviewers", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.PullRequests, nil
+}
+
+// ExplainAssignment повторяет решения о назначении ревьюверов PR; с decisionID — только одно решение.
+func (c *Client) ExplainAssignment(ctx context.Context, pullRequestID uuid.UUID, decisionID *int64) (*api.ExplainAssignmentResponse, error) {
+	query := pullRequestQuery(pullRequestID)
+	if decisionID != nil {
+		query.Set("decision_id", strconv.FormatInt(*decisionID, 10))
+	}
+
+	var resp api.ExplainAssignmentResponse
+	if err := c.do(ctx, http.MethodGet, "/pullRequest/explainAssignment", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetOverdue возвращает назначения с истёкшим сроком первого ревью.
+func (c *Client) GetOverdue(ctx context.Context, teamName string, includeDescendants bool) ([]api.OverdueReview, error) {
+	var resp api.OverdueResponse
+	if err := c.do(ctx, http.MethodGet, "/pullRequest/overdue", teamQuery(teamName, includeDescendants), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Overdue, nil
+}
+
+// ImportPullRequests переносит существующие PR; с partial корректные элементы импортируются,
+// даже если в других есть ошибки. Итог по элементам — в ответе, а не в ошибке.
+func (c *Client) ImportPullRequests(ctx context.Context, items []api.PullRequestImportItem, partial bool) (*api.PullRequestImportResponse, error) {
+	query := url.Values{}
+	if partial {
+		query.Set("partial", strconv.FormatBool(true))
+	}
+
+	var resp api.PullRequestImportResponse
+	if err := c.do(ctx, http.MethodPost, "/pullRequest/import", query, items, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func pullRequestQuery(pullRequestID uuid.UUID) url.Values {
+	return url.Values{"pull_request_id": {pullRequestID.String()}}
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+)
+
+// Stats возвращает статистику назначений; пустой teamName — по всем командам.
+func (c *Client) Stats(ctx context.Context, teamName string, includeDescendants bool) (*api.StatsResponse, error) {
+	var resp api.StatsResponse
+	if err := c.do(ctx, http.MethodGet, "/stats", teamQuery(teamName, includeDescendants), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// PairStats возвращает матрицу повторений пар автор — ревьювер.
+func (c *Client) PairStats(ctx context.Context, teamName string, includeDescendants bool) (*api.PairStatsResponse, error) {
+	var resp api.PairStatsResponse
+	if err := c.do(ctx, http.MethodGet, "/stats/pairs", teamQuery(teamName, includeDescendants), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LatencyStats возвращает задержки ревью и слияния в рабочих часах.
+func (c *Client) LatencyStats(ctx context.Context, teamName string, includeDescendants bool) (*api.LatencyStatsResponse, error) {
+	var resp api.LatencyStatsResponse
+	if err := c.do(ctx, http.MethodGet, "/stats/latency", teamQuery(teamName, includeDescendants), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
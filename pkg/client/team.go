@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+)
+
+// AddTeam создаёт команду с участниками; пользователи создаются или обновляются.
+func (c *Client) AddTeam(ctx context.Context, team api.Team) (*api.Team, error) {
+	var resp api.TeamResponse
+	if err := c.do(ctx, http.MethodPost, "/team/add", nil, team, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Team, nil
+}
+
+// GetTeam возвращает команду; с includeDescendants — вместе с участниками подкоманд.
+func (c *Client) GetTeam(ctx context.Context, teamName string, includeDescendants bool) (*api.Team, error) {
+	var resp api.TeamResponse
+	if err := c.do(ctx, http.MethodGet, "/team/get", teamQuery(teamName, includeDescendants), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Team, nil
+}
+
+func (c *Client) GetTeamSubtree(ctx context.Context, teamName string) (*api.TeamNode, error) {
+	var resp api.TeamSubtreeResponse
+	if err := c.do(ctx, http.MethodGet, "/team/subtree", teamQuery(teamName, false), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Subtree, nil
+}
+
+// SetTeamParent переносит команду под parentTeam; nil делает её корневой.
+func (c *Client) SetTeamParent(ctx context.Context, teamName string, parentTeam *string) (*api.Team, error) {
+	req := api.TeamSetParentRequest{TeamName: teamName, ParentTeam: parentTeam}
+
+	var resp api.TeamResponse
+	if err := c.doIdempotent(ctx, http.MethodPost, "/team/setParent", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Team, nil
+}
+
+func (c *Client) GetTeamFallback(ctx context.Context, teamName string) (*api.TeamFallback, error) {
+	var resp api.TeamFallbackResponse
+	if err := c.do(ctx, http.MethodGet, "/team/fallback", teamQuery(teamName, false), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Fallback, nil
+}
+
+// SetTeamFallback заменяет цепочку резервных пулов ревьюверов команды.
+func (c *Client) SetTeamFallback(ctx context.Context, fallback api.TeamFallback) (*api.TeamFallback, error) {
+	var resp api.TeamFallbackResponse
+	if err := c.doIdempotent(ctx, http.MethodPost, "/team/fallback", nil, fallback, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Fallback, nil
+}
+
+func (c *Client) GetTeamOwners(ctx context.Context, teamName string) (*api.TeamOwners, error) {
+	var resp api.TeamOwnersResponse
+	if err := c.do(ctx, http.MethodGet, "/team/owners", teamQuery(teamName, false), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Owners, nil
+}
+
+// SetTeamOwners заменяет правила владения кодом команды.
+func (c *Client) SetTeamOwners(ctx context.Context, owners api.TeamOwners) (*api.TeamOwners, error) {
+	var resp api.TeamOwnersResponse
+	if err := c.doIdempotent(ctx, http.MethodPost, "/team/owners", nil, owners, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Owners, nil
+}
+
+func (c *Client) DeleteTeamOwners(ctx context.Context, teamName string) error {
+	return c.do(ctx, http.MethodDelete, "/team/owners", teamQuery(teamName, false), nil, nil)
+}
+
+func (c *Client) GetTeamReviewRules(ctx context.Context, teamName string) (*api.TeamReviewRules, error) {
+	var resp api.TeamReviewRulesResponse
+	if err := c.do(ctx, http.MethodGet, "/team/reviewRules", teamQuery(teamName, false), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Rules, nil
+}
+
+func (c *Client) SetTeamReviewRules(ctx context.Context, rules api.TeamReviewRules) (*api.TeamReviewRules, error) {
+	var resp api.TeamReviewRulesResponse
+	if err := c.doIdempotent(ctx, http.MethodPost, "/team/reviewRules", nil, rules, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Rules, nil
+}
+
+func (c *Client) GetTeamSLA(ctx context.Context, teamName string) (*api.TeamSLA, error) {
+	var resp api.TeamSLAResponse
+	if err := c.do(ctx, http.MethodGet, "/team/sla", teamQuery(teamName, false), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.SLA, nil
+}
+
+func (c *Client) SetTeamSLA(ctx context.Context, sla api.TeamSLA) (*api.TeamSLA, error) {
+	var resp api.TeamSLAResponse
+	if err := c.doIdempotent(ctx, http.MethodPost, "/team/sla", nil, sla, &resp); err != nil {
+		return nil, err
+	}
+	return resp.SLA, nil
+}
+
+func (c *Client) GetTeamSchedule(ctx context.Context, teamName string) (*api.TeamSchedule, error) {
+	var resp api.TeamScheduleResponse
+	if err := c.do(ctx, http.MethodGet, "/team/schedule", teamQuery(teamName, false), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Schedule, nil
+}
+
+func (c *Client) SetTeamSchedule(ctx context.Context, schedule api.TeamSchedule) (*api.TeamSchedule, error) {
+	var resp api.TeamScheduleResponse
+	if err := c.doIdempotent(ctx, http.MethodPost, "/team/schedule", nil, schedule, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Schedule, nil
+}
+
+// teamQuery — параметры team_name и include_descendants; пустые значения не передаются.
+func teamQuery(teamName string, includeDescendants bool) url.Values {
+	query := url.Values{}
+	if teamName != "" {
+		query.Set("team_name", teamName)
+	}
+	if includeDescendants {
+		query.Set("include_descendants", strconv.FormatBool(true))
+	}
+	return query
+}
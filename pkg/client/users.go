@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/F3dosik/PRS.git/pkg/models/api"
+	"github.com/google/uuid"
+)
+
+func (c *Client) GetUser(ctx context.Context, userID uuid.UUID) (*api.User, error) {
+	var resp api.UserResponse
+	if err := c.do(ctx, http.MethodGet, "/users/get", userQuery(userID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.User, nil
+}
+
+// SetIsActive включает или выключает пользователя; с непустым teamName — только его членство в команде.
+func (c *Client) SetIsActive(ctx context.Context, userID uuid.UUID, isActive bool, teamName string) (*api.User, error) {
+	req := api.SetIsActiveRequest{UserID: userID, IsActive: isActive, TeamName: teamName}
+
+	var resp api.UserResponse
+	if err := c.doIdempotent(ctx, http.MethodPost, "/users/setIsActive", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.User, nil
+}
+
+func (c *Client) SetSeniority(ctx context.Context, userID uuid.UUID, seniority api.Seniority) (*api.User, error) {
+	req := api.SetSeniorityRequest{UserID: userID, Seniority: seniority}
+
+	var resp api.UserResponse
+	if err := c.doIdempotent(ctx, http.MethodPost, "/users/setSeniority", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.User, nil
+}
+
+// GetReview возвращает PR, в которых пользователь назначен ревьювером.
+func (c *Client) GetReview(ctx context.Context, userID uuid.UUID) (*api.GetReviewResponse, error) {
+	var resp api.GetReviewResponse
+	if err := c.do(ctx, http.MethodGet, "/users/getReview", userQuery(userID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) GetSkills(ctx context.Context, userID uuid.UUID) (*api.UserSkills, error) {
+	var resp api.UserSkills
+	if err := c.do(ctx, http.MethodGet, "/users/skills", userQuery(userID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetSkills заменяет навыки пользователя.
+func (c *Client) SetSkills(ctx context.Context, skills api.UserSkills) (*api.UserSkills, error) {
+	var resp api.UserSkills
+	if err := c.doIdempotent(ctx, http.MethodPost, "/users/skills", nil, skills, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) GetUserSchedule(ctx context.Context, userID uuid.UUID) (*api.UserSchedule, error) {
+	var resp api.UserScheduleResponse
+	if err := c.do(ctx, http.MethodGet, "/users/schedule", userQuery(userID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Schedule, nil
+}
+
+func (c *Client) SetUserSchedule(ctx context.Context, schedule api.UserSchedule) (*api.UserSchedule, error) {
+	var resp api.UserScheduleResponse
+	if err := c.doIdempotent(ctx, http.MethodPost, "/users/schedule", nil, schedule, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Schedule, nil
+}
+
+// GetAvailability сообщает, в рабочем ли времени пользователь в момент at; nil — сейчас.
+func (c *Client) GetAvailability(ctx context.Context, userID uuid.UUID, at *time.Time) (*api.Availability, error) {
+	query := userQuery(userID)
+	if at != nil {
+		query.Set("at", at.Format(time.RFC3339))
+	}
+
+	var resp api.Availability
+	if err := c.do(ctx, http.MethodGet, "/users/availability", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) GetSlackUser(ctx context.Context, userID uuid.UUID) (*api.SlackUser, error) {
+	var resp api.SlackUser
+	if err := c.do(ctx, http.MethodGet, "/users/slack", userQuery(userID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetSlackUser привязывает пользователя к Slack; пустой SlackID удаляет привязку.
+func (c *Client) SetSlackUser(ctx context.Context, user api.SlackUser) (*api.SlackUser, error) {
+	var resp api.SlackUser
+	if err := c.doIdempotent(ctx, http.MethodPost, "/users/slack", nil, user, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) GetDigest(ctx context.Context, userID uuid.UUID) (*api.DigestSubscription, error) {
+	var resp api.DigestSubscription
+	if err := c.do(ctx, http.MethodGet, "/users/digest", userQuery(userID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetDigest сохраняет подписку на дайджест; пустой Email отменяет её.
+func (c *Client) SetDigest(ctx context.Context, sub api.DigestSubscription) (*api.DigestSubscription, error) {
+	var resp api.DigestSubscription
+	if err := c.doIdempotent(ctx, http.MethodPost, "/users/digest", nil, sub, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func userQuery(userID uuid.UUID) url.Values {
+	return url.Values{"user_id": {userID.String()}}
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkSchedule — рабочее время: часовой пояс IANA, рабочие дни ("mon".."sun")
+// и интервал рабочих часов "HH:MM" по местному времени.
+type WorkSchedule struct {
+	TimeZone  string   `json:"time_zone"`
+	WorkDays  []string `json:"work_days"`
+	WorkStart string   `json:"work_start"`
+	WorkEnd   string   `json:"work_end"`
+}
+
+// TeamSchedule — расписание команды; пустой TimeZone при сохранении удаляет расписание.
+type TeamSchedule struct {
+	TeamName string `json:"team_name"`
+	WorkSchedule
+}
+
+type TeamScheduleResponse struct {
+	Schedule *TeamSchedule `json:"schedule"`
+}
+
+// UserSchedule — расписание пользователя; пустой TimeZone при сохранении удаляет расписание,
+// и действует расписание основной команды.
+type UserSchedule struct {
+	UserID uuid.UUID `json:"user_id"`
+	WorkSchedule
+}
+
+type UserScheduleResponse struct {
+	Schedule *UserSchedule `json:"schedule"`
+}
+
+// Holiday — нерабочий день. Пустой TeamName означает праздник для всех команд.
+// Date — "YYYY-MM-DD", у ежегодного (Recurring) праздника — "MM-DD".
+type Holiday struct {
+	Date      string `json:"date"`
+	Name      string `json:"name"`
+	Recurring bool   `json:"recurring"`
+	TeamName  string `json:"team_name,omitempty"`
+}
+
+type HolidaysResponse struct {
+	Holidays []Holiday `json:"holidays"`
+}
+
+type HolidayImportResponse struct {
+	Imported int `json:"imported"`
+}
+
+// Availability — находится ли пользователь в рабочем времени в момент At.
+type Availability struct {
+	UserID          uuid.UUID `json:"user_id"`
+	TimeZone        string    `json:"time_zone"`
+	At              time.Time `json:"at"`
+	LocalTime       string    `json:"local_time"`
+	Available       bool      `json:"available"`
+	NextAvailableAt time.Time `json:"next_available_at"`
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DigestFrequency string
+
+const (
+	DigestDaily  DigestFrequency = "daily"
+	DigestWeekly DigestFrequency = "weekly"
+)
+
+// DigestSubscription — подписка на дайджест ожидающих ревью. SendAt — время "HH:MM"
+// по часовому поясу расписания пользователя, Weekday ("mon".."sun") — день еженедельной отправки.
+// Пустой Email при сохранении отменяет подписку.
+type DigestSubscription struct {
+	UserID     uuid.UUID       `json:"user_id"`
+	Email      string          `json:"email"`
+	Frequency  DigestFrequency `json:"frequency,omitempty"`
+	SendAt     string          `json:"send_at,omitempty"`
+	Weekday    string          `json:"weekday,omitempty"`
+	LastSentAt *time.Time      `json:"last_sent_at,omitempty"`
+}
+
+// DigestReview — открытый PR, ожидающий ревью пользователя.
+type DigestReview struct {
+	PullRequestID   uuid.UUID `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorName      string    `json:"author_name"`
+	TeamName        string    `json:"team_name"`
+	AssignedAt      time.Time `json:"assigned_at"`
+	Shadow          bool      `json:"shadow"`
+}
@@ -13,8 +13,11 @@ const (
 	ErrInvalidTeam      ErrorCode = "INVALID_TEAM"
 	ErrInvalidParameter ErrorCode = "INVALID_PARAMETER"
 	ErrInvalidUser      ErrorCode = "INVALID_USER"
+	ErrHierarchyCycle   ErrorCode = "HIERARCHY_CYCLE"
 
 	ErrInvalidPR ErrorCode = "INVALID_PULL_REQUEST"
+
+	ErrUnauthorized ErrorCode = "UNAUTHORIZED"
 )
 
 type APIError struct {
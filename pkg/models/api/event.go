@@ -0,0 +1,63 @@
+package api
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	EventPullRequestCreated EventType = "pull_request.created"
+	EventReviewAssigned     EventType = "review.assigned"
+	EventPullRequestMerged  EventType = "pull_request.merged"
+	EventSLABreached        EventType = "review.sla_breached"
+	EventSLAReassigned      EventType = "review.sla_reassigned"
+)
+
+// Event — запись журнала событий. UserID — пользователь, которого событие касается в первую очередь.
+type Event struct {
+	ID            int64           `json:"id"`
+	Type          EventType       `json:"type"`
+	PullRequestID *uuid.UUID      `json:"pull_request_id,omitempty"`
+	TeamID        *uuid.UUID      `json:"team_id,omitempty"`
+	UserID        *uuid.UUID      `json:"user_id,omitempty"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// PullRequestCreatedPayload — данные события pull_request.created.
+type PullRequestCreatedPayload struct {
+	PullRequestID   uuid.UUID `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        uuid.UUID `json:"author_id"`
+	TeamName        string    `json:"team_name"`
+}
+
+// ReviewAssignedPayload — данные события review.assigned. ReplacedReviewerID заполнен при переназначении.
+type ReviewAssignedPayload struct {
+	PullRequestID      uuid.UUID      `json:"pull_request_id"`
+	PullRequestName    string         `json:"pull_request_name"`
+	AuthorID           uuid.UUID      `json:"author_id"`
+	ReviewerID         uuid.UUID      `json:"reviewer_id"`
+	Reason             DecisionReason `json:"reason"`
+	Shadow             bool           `json:"shadow"`
+	ReplacedReviewerID *uuid.UUID     `json:"replaced_reviewer_id,omitempty"`
+}
+
+// PullRequestMergedPayload — данные события pull_request.merged.
+type PullRequestMergedPayload struct {
+	PullRequestID   uuid.UUID   `json:"pull_request_id"`
+	PullRequestName string      `json:"pull_request_name"`
+	AuthorID        uuid.UUID   `json:"author_id"`
+	Reviewers       []uuid.UUID `json:"reviewers"` // Обязательные и теневой ревьюверы на момент слияния
+	MergedAt        time.Time   `json:"merged_at"`
+}
+
+// SlackUser — привязка пользователя PRS к идентификатору Slack; пустой SlackID удаляет привязку.
+type SlackUser struct {
+	UserID  uuid.UUID `json:"user_id"`
+	SlackID string    `json:"slack_id"`
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PullRequestImportItem — PR из переносимого бэклога. Пустой Status означает OPEN, пустой TeamName —
+// основную команду автора, пустой CreatedAt — момент импорта. Ревьюверы назначаются как есть, без подбора.
+type PullRequestImportItem struct {
+	PullRequestID     uuid.UUID   `json:"pull_request_id"`
+	PullRequestName   string      `json:"pull_request_name"`
+	AuthorID          uuid.UUID   `json:"author_id"`
+	TeamName          string      `json:"team_name,omitempty"`
+	Status            PRStatus    `json:"status,omitempty"`
+	AssignedReviewers []uuid.UUID `json:"assigned_reviewers,omitempty"`
+	ShadowReviewer    *uuid.UUID  `json:"shadow_reviewer,omitempty"`
+	CreatedAt         *time.Time  `json:"created_at,omitempty"`
+	MergedAt          *time.Time  `json:"merged_at,omitempty"`
+	ChangedFiles      []string    `json:"changed_files,omitempty"`
+	Labels            []string    `json:"labels,omitempty"`
+}
+
+type ImportStatus string
+
+const (
+	ImportImported ImportStatus = "imported"
+	ImportFailed   ImportStatus = "failed"
+	ImportSkipped  ImportStatus = "skipped" // Элемент корректен, но импорт отменён из-за ошибок в других
+)
+
+// PullRequestImportResult — итог по элементу с номером Index (с нуля, в порядке запроса).
+type PullRequestImportResult struct {
+	Index         int          `json:"index"`
+	PullRequestID uuid.UUID    `json:"pull_request_id"`
+	Status        ImportStatus `json:"status"`
+	Error         *APIError    `json:"error,omitempty"`
+}
+
+type PullRequestImportResponse struct {
+	Imported int                       `json:"imported"`
+	Failed   int                       `json:"failed"`
+	Results  []PullRequestImportResult `json:"results"`
+}
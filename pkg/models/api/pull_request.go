@@ -0,0 +1,232 @@
+package api
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PRStatus string
+
+const (
+	StatusOpen   PRStatus = "OPEN"
+	StatusMerged PRStatus = "MERGED"
+)
+
+type ReviewerSource string
+
+const (
+	SourceCodeOwner    ReviewerSource = "code_owner"
+	SourceTeam         ReviewerSource = "team"
+	SourceFallbackTeam ReviewerSource = "fallback_team"
+	SourceAnyActive    ReviewerSource = "any_active"
+)
+
+// DecisionReason — операция, в ходе которой принималось решение о назначении.
+type DecisionReason string
+
+const (
+	DecisionCreate   DecisionReason = "create"
+	DecisionReassign DecisionReason = "reassign"
+	DecisionBackfill DecisionReason = "backfill"
+	DecisionSLA      DecisionReason = "sla" // Автоматическое переназначение по истечении SLA
+)
+
+type ReviewAssignment struct {
+	ReviewerID uuid.UUID      `json:"reviewer_id"`
+	Source     ReviewerSource `json:"source"`
+	SourceTeam *string        `json:"source_team,omitempty"`
+	DecisionID *int64         `json:"decision_id,omitempty"`
+	Shadow     bool           `json:"shadow,omitempty"`
+}
+
+type PullRequest struct {
+	PullRequestID     uuid.UUID   `json:"pull_request_id"`
+	PullRequestName   string      `json:"pull_request_name"`
+	AuthorID          uuid.UUID   `json:"author_id"`
+	TeamName          string      `json:"team_name,omitempty"`
+	Status            PRStatus    `json:"status"`
+	AssignedReviewers []uuid.UUID `json:"assigned_reviewers"`
+	ShadowReviewer    *uuid.UUID  `json:"shadow_reviewer,omitempty"` // Не учитывается в обязательных ревьюверах
+	CreatedAt         time.Time   `json:"createdAt,omitempty"`
+	MergedAt          *time.Time  `json:"mergedAt,omitempty"`
+
+	ChangedFiles []string           `json:"changed_files,omitempty"`
+	Labels       []string           `json:"labels,omitempty"`
+	Assignments  []ReviewAssignment `json:"assignments,omitempty"`
+}
+
+type PullRequestShort struct {
+	PullRequestID   uuid.UUID `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        uuid.UUID `json:"author_id"`
+	Status          PRStatus  `json:"status"`
+}
+
+// PullRequestCreateRequest — тело /pullRequest/create. TeamName по умолчанию — основная команда автора,
+// ChangedFiles используются для выбора владельцев кода, Labels — для подбора по навыкам.
+type PullRequestCreateRequest struct {
+	PullRequestShort
+	TeamName     string   `json:"team_name"`
+	ChangedFiles []string `json:"changed_files"`
+	Labels       []string `json:"labels"`
+}
+
+type PullRequestMergeRequest struct {
+	PullRequestID uuid.UUID `json:"pull_request_id"`
+}
+
+type PullRequestReassignRequest struct {
+	PullRequestID uuid.UUID `json:"pull_request_id"`
+	OldUserID     uuid.UUID `json:"old_user_id"`
+}
+
+// FillReviewersRequest — тело /pullRequest/fillReviewers; пустой TeamName — все команды.
+type FillReviewersRequest struct {
+	TeamName string `json:"team_name"`
+}
+
+type PullRequestResponse struct {
+	PullRequest PullRequest `json:"pr"`
+}
+
+type PullRequestReassignResponse struct {
+	PullRequest PullRequest `json:"pr"`
+	ReplacedBy  uuid.UUID   `json:"replaced_by"`
+}
+
+type FillReviewersResponse struct {
+	PullRequests []PullRequest `json:"pull_requests"`
+}
+
+// PullRequestFilter — условия /pullRequest/list; пустые поля выборку не ограничивают.
+// CreatedFrom включается в интервал, CreatedTo — нет.
+type PullRequestFilter struct {
+	TeamName           string
+	IncludeDescendants bool
+	Status             PRStatus
+	AuthorID           *uuid.UUID
+	ReviewerID         *uuid.UUID // Обязательный или теневой ревьювер
+	CreatedFrom        *time.Time
+	CreatedTo          *time.Time
+	NeedMoreReviewers  *bool
+	Cursor             string // next_cursor предыдущей страницы
+	Limit              int
+}
+
+// PullRequestListResponse — страница PR от новых к старым. Пустой NextCursor означает последнюю страницу.
+type PullRequestListResponse struct {
+	PullRequests []PullRequest `json:"pull_requests"`
+	NextCursor   string        `json:"next_cursor,omitempty"`
+}
+
+// CandidateExplanation — как кандидат был рассмотрен в одном из пулов решения.
+// Score и Rank заполняются только для допущенных кандидатов.
+type CandidateExplanation struct {
+	UserID     uuid.UUID      `json:"user_id"`
+	Source     ReviewerSource `json:"source"`
+	SourceTeam *string        `json:"source_team,omitempty"`
+	Eligible   bool           `json:"eligible"`
+	Reason     string         `json:"reason,omitempty"`
+	Seniority  Seniority      `json:"seniority,omitempty"`
+	Skills     []string       `json:"skills,omitempty"`
+	Load       int            `json:"load"`
+	Recent     int            `json:"recent,omitempty"` // Ревью последних PR того же автора
+	Score      *float64       `json:"score,omitempty"`
+	Rank       int            `json:"rank,omitempty"`
+	Picked     bool           `json:"picked"`
+	Shadow     bool           `json:"shadow,omitempty"`
+}
+
+// AssignmentDecision — повтор сохранённого решения. Recorded — ревьюверы, назначенные этим решением,
+// Picked — результат повтора (теневой ревьювер последним); Reproduced сообщает, совпали ли они.
+// Решения прежних версий алгоритма не повторяются: для них заполнен ReplayError.
+type AssignmentDecision struct {
+	DecisionID       int64                  `json:"decision_id"`
+	Reason           DecisionReason         `json:"reason"`
+	Seed             uint64                 `json:"seed,string"`
+	AlgorithmVersion int                    `json:"algorithm_version"`
+	CreatedAt        time.Time              `json:"created_at"`
+	Labels           []string               `json:"labels,omitempty"`
+	Limit            int                    `json:"limit"`
+	RequireSenior    bool                   `json:"require_senior,omitempty"`
+	Shadow           bool                   `json:"shadow,omitempty"`
+	Recorded         []uuid.UUID            `json:"recorded"`
+	Picked           []uuid.UUID            `json:"picked"`
+	Reproduced       bool                   `json:"reproduced"`
+	ReplayError      string                 `json:"replay_error,omitempty"` // Почему решение не повторялось
+	Candidates       []CandidateExplanation `json:"candidates"`
+}
+
+type ExplainAssignmentResponse struct {
+	PullRequestID uuid.UUID            `json:"pull_request_id"`
+	Decisions     []AssignmentDecision `json:"decisions"`
+}
+
+// OverdueReview — назначение, у которого истёк срок первого ревью по SLA команды.
+type OverdueReview struct {
+	PullRequestID   uuid.UUID  `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+	AuthorID        uuid.UUID  `json:"author_id"`
+	TeamName        string     `json:"team_name"`
+	ReviewerID      uuid.UUID  `json:"reviewer_id"`
+	AssignedAt      time.Time  `json:"assigned_at"`
+	DueAt           time.Time  `json:"due_at"`
+	ReassignAt      *time.Time `json:"reassign_at,omitempty"`
+	BreachedAt      *time.Time `json:"breached_at,omitempty"` // Когда нарушение зафиксировал планировщик
+}
+
+type OverdueResponse struct {
+	Overdue []OverdueReview `json:"overdue"`
+}
+
+// PullRequestV2 — PR в API v2: то же, что PullRequest, но время в snake_case, как и остальные поля.
+type PullRequestV2 struct {
+	PullRequestID     uuid.UUID          `json:"pull_request_id"`
+	PullRequestName   string             `json:"pull_request_name"`
+	AuthorID          uuid.UUID          `json:"author_id"`
+	TeamName          string             `json:"team_name,omitempty"`
+	Status            PRStatus           `json:"status"`
+	AssignedReviewers []uuid.UUID        `json:"assigned_reviewers"`
+	ShadowReviewer    *uuid.UUID         `json:"shadow_reviewer,omitempty"`
+	CreatedAt         *time.Time         `json:"created_at,omitempty"`
+	MergedAt          *time.Time         `json:"merged_at,omitempty"`
+	ChangedFiles      []string           `json:"changed_files,omitempty"`
+	Labels            []string           `json:"labels,omitempty"`
+	Assignments       []ReviewAssignment `json:"assignments,omitempty"`
+}
+
+func (pr PullRequest) V2() PullRequestV2 {
+	v2 := PullRequestV2{
+		PullRequestID:     pr.PullRequestID,
+		PullRequestName:   pr.PullRequestName,
+		AuthorID:          pr.AuthorID,
+		TeamName:          pr.TeamName,
+		Status:            pr.Status,
+		AssignedReviewers: pr.AssignedReviewers,
+		ShadowReviewer:    pr.ShadowReviewer,
+		MergedAt:          pr.MergedAt,
+		ChangedFiles:      pr.ChangedFiles,
+		Labels:            pr.Labels,
+		Assignments:       pr.Assignments,
+	}
+	if !pr.CreatedAt.IsZero() {
+		v2.CreatedAt = &pr.CreatedAt
+	}
+
+	return v2
+}
+
+type PullRequestReassignResponseV2 struct {
+	PullRequest PullRequestV2 `json:"pull_request"`
+	ReplacedBy  uuid.UUID     `json:"replaced_by"`
+}
+
+type FillReviewersResponseV2 struct {
+	PullRequests []PullRequestV2 `json:"pull_requests"`
+}
+
+type PullRequestListResponseV2 struct {
+	PullRequests []PullRequestV2 `json:"pull_requests"`
+	NextCursor   string          `json:"next_cursor,omitempty"`
+}
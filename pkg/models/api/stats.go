@@ -0,0 +1,55 @@
+package api
+
+import "github.com/google/uuid"
+
+type StatsResponse struct {
+	TeamName          string         `json:"team_name,omitempty"`
+	TotalPR           int            `json:"total_pr"`
+	OpenPR            int            `json:"open_pr"`
+	ReviewAssignments map[string]int `json:"review_assignments"` // username -> count
+}
+
+// PairStatsResponse — матрица повторений пар: Matrix[i][j] — сколько PR автора Authors[i]
+// ревьюил Reviewers[j].
+type PairStatsResponse struct {
+	TeamName  string      `json:"team_name,omitempty"`
+	Authors   []uuid.UUID `json:"authors"`
+	Reviewers []uuid.UUID `json:"reviewers"`
+	Matrix    [][]int     `json:"matrix"`
+}
+
+// LatencySummary — распределение задержки в рабочих часах.
+type LatencySummary struct {
+	Count       int     `json:"count"`
+	MeanHours   float64 `json:"mean_hours"`
+	MedianHours float64 `json:"median_hours"`
+	P90Hours    float64 `json:"p90_hours"`
+}
+
+type ReviewerLatency struct {
+	UserID uuid.UUID `json:"user_id"`
+	LatencySummary
+}
+
+// LatencyStatsResponse — задержки по слитым PR: Merge — от создания до слияния по расписанию
+// команды PR, Reviewers — от назначения ревьювера до слияния по расписанию ревьювера.
+type LatencyStatsResponse struct {
+	TeamName  string            `json:"team_name,omitempty"`
+	Merge     LatencySummary    `json:"merge"`
+	Reviewers []ReviewerLatency `json:"reviewers"`
+}
+
+// ReviewerCount — число назначений ревьювера.
+type ReviewerCount struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+	Count    int       `json:"count"`
+}
+
+// StatsResponseV2 — статистика в формате API v2: назначения списком по user_id, а не картой по имени.
+type StatsResponseV2 struct {
+	TeamName          string          `json:"team_name,omitempty"`
+	TotalPR           int             `json:"total_pr"`
+	OpenPR            int             `json:"open_pr"`
+	ReviewAssignments []ReviewerCount `json:"review_assignments"`
+}
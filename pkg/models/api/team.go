@@ -0,0 +1,104 @@
+package api
+
+import "github.com/google/uuid"
+
+const DefaultRole = "member"
+
+type TeamMember struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Username  string    `json:"username"`
+	IsActive  bool      `json:"is_active"`
+	Role      string    `json:"role,omitempty"`
+	Seniority Seniority `json:"seniority,omitempty"` // При добавлении пустое значение не меняет текущее
+	TeamName  string    `json:"team_name,omitempty"` // Заполняется при агрегации по подкомандам
+}
+
+type Team struct {
+	TeamName   string       `json:"team_name"`
+	ParentTeam *string      `json:"parent_team,omitempty"`
+	Members    []TeamMember `json:"members"`
+}
+
+type TeamResponse struct {
+	Team *Team `json:"team"`
+}
+
+type FallbackKind string
+
+const (
+	FallbackTeam      FallbackKind = "team"
+	FallbackAncestors FallbackKind = "ancestors" // Поддеревья родительских команд, от ближайшей к корню
+	FallbackAnyActive FallbackKind = "any_active"
+)
+
+type FallbackEntry struct {
+	Kind     FallbackKind `json:"kind"`
+	TeamName string       `json:"team_name,omitempty"`
+}
+
+type TeamSetParentRequest struct {
+	TeamName   string  `json:"team_name"`
+	ParentTeam *string `json:"parent_team"` // null делает команду корневой
+}
+
+type TeamFallback struct {
+	TeamName string          `json:"team_name"`
+	Chain    []FallbackEntry `json:"chain"`
+}
+
+type TeamFallbackResponse struct {
+	Fallback *TeamFallback `json:"fallback"`
+}
+
+type TeamNode struct {
+	TeamName   string     `json:"team_name"`
+	ParentTeam *string    `json:"parent_team"`
+	Children   []TeamNode `json:"children"`
+}
+
+type TeamSubtreeResponse struct {
+	Subtree *TeamNode `json:"subtree"`
+}
+
+// CodeOwnerRule — строка CODEOWNERS: шаблон пути и его владельцы (пользователи и/или команды).
+type CodeOwnerRule struct {
+	Pattern string      `json:"pattern"`
+	Users   []uuid.UUID `json:"users,omitempty"`
+	Teams   []string    `json:"teams,omitempty"`
+}
+
+type TeamOwners struct {
+	TeamName string          `json:"team_name"`
+	Rules    []CodeOwnerRule `json:"rules"`
+}
+
+type TeamOwnersResponse struct {
+	Owners *TeamOwners `json:"owners"`
+}
+
+// TeamReviewRules — ограничения на состав ревьюверов PR команды. RequireSenior: хотя бы один
+// ревьювер уровня senior; ShadowJunior: дополнительно назначать junior теневым ревьювером.
+// Ротация: за каждый из последних RotationWindow PR автора, где кандидат был ревьювером,
+// из его оценки вычитается RotationPenalty.
+type TeamReviewRules struct {
+	TeamName        string  `json:"team_name"`
+	RequireSenior   bool    `json:"require_senior"`
+	ShadowJunior    bool    `json:"shadow_junior"`
+	RotationWindow  int     `json:"rotation_window"`
+	RotationPenalty float64 `json:"rotation_penalty"`
+}
+
+type TeamReviewRulesResponse struct {
+	Rules *TeamReviewRules `json:"rules"`
+}
+
+// TeamSLA — сроки ревью команды в рабочих часах (по расписанию ревьювера или команды). Пустой ReassignAfterHours отключает автоматическое переназначение.
+type TeamSLA struct {
+	TeamName           string `json:"team_name"`
+	FirstReviewHours   int    `json:"first_review_hours"`
+	ReassignAfterHours *int   `json:"reassign_after_hours,omitempty"`
+}
+
+type TeamSLAResponse struct {
+	SLA *TeamSLA `json:"sla"`
+}
@@ -0,0 +1,74 @@
+package api
+
+import "github.com/google/uuid"
+
+type Seniority string
+
+const (
+	SeniorityJunior Seniority = "junior"
+	SeniorityMiddle Seniority = "middle"
+	SenioritySenior Seniority = "senior"
+
+	DefaultSeniority = SeniorityMiddle
+)
+
+func (s Seniority) Valid() bool {
+	switch s {
+	case SeniorityJunior, SeniorityMiddle, SenioritySenior:
+		return true
+	}
+	return false
+}
+
+type UserTeam struct {
+	TeamName  string `json:"team_name"`
+	Role      string `json:"role"`
+	IsActive  bool   `json:"is_active"`
+	IsPrimary bool   `json:"is_primary"`
+}
+
+type User struct {
+	UserID    uuid.UUID  `json:"user_id"`
+	Username  string     `json:"username"`
+	TeamName  *string    `json:"team_name"` // Основная команда
+	IsActive  bool       `json:"is_active"`
+	Seniority Seniority  `json:"seniority"`
+	Teams     []UserTeam `json:"teams"`
+}
+
+// SetIsActiveRequest — тело /users/setIsActive.
+type SetIsActiveRequest struct {
+	UserID   uuid.UUID `json:"user_id"`
+	IsActive bool      `json:"is_active"`
+	TeamName string    `json:"team_name,omitempty"` // Если задано — меняется только членство в команде
+}
+
+type SetSeniorityRequest struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Seniority Seniority `json:"seniority"`
+}
+
+type UserResponse struct {
+	User User `json:"user"`
+}
+
+// GetReviewResponse — ответ /users/getReview. Ключ PullRequests в v1 исторически без snake_case
+// и сохранён для существующих клиентов; в API v2 — pull_requests.
+type GetReviewResponse struct {
+	UserID       uuid.UUID          `json:"user_id"`
+	PullRequests []PullRequestShort `json:"PullRequests"`
+}
+
+type GetReviewResponseV2 struct {
+	UserID       uuid.UUID          `json:"user_id"`
+	PullRequests []PullRequestShort `json:"pull_requests"`
+}
+
+func (r GetReviewResponse) V2() GetReviewResponseV2 {
+	return GetReviewResponseV2{UserID: r.UserID, PullRequests: r.PullRequests}
+}
+
+type UserSkills struct {
+	UserID uuid.UUID `json:"user_id"`
+	Skills []string  `json:"skills"`
+}
@@ -0,0 +1,7062 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: prs/v1/prs.proto
+
+package prsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TeamMember struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Role     string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// junior, middle или senior; при добавлении пустое значение не меняет текущее
+	Seniority string `protobuf:"bytes,5,opt,name=seniority,proto3" json:"seniority,omitempty"`
+	// Заполняется при агрегации по подкомандам
+	TeamName      string `protobuf:"bytes,6,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_prs_v1_prs_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TeamMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *TeamMember) GetSeniority() string {
+	if x != nil {
+		return x.Seniority
+	}
+	return ""
+}
+
+func (x *TeamMember) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ParentTeam    *string                `protobuf:"bytes,2,opt,name=parent_team,json=parentTeam,proto3,oneof" json:"parent_team,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_prs_v1_prs_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetParentTeam() string {
+	if x != nil && x.ParentTeam != nil {
+		return *x.ParentTeam
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type FallbackEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// team, ancestors или any_active
+	Kind          string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	TeamName      string `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FallbackEntry) Reset() {
+	*x = FallbackEntry{}
+	mi := &file_prs_v1_prs_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FallbackEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FallbackEntry) ProtoMessage() {}
+
+func (x *FallbackEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FallbackEntry.ProtoReflect.Descriptor instead.
+func (*FallbackEntry) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FallbackEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FallbackEntry) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type TeamFallback struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Chain         []*FallbackEntry       `protobuf:"bytes,2,rep,name=chain,proto3" json:"chain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamFallback) Reset() {
+	*x = TeamFallback{}
+	mi := &file_prs_v1_prs_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamFallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamFallback) ProtoMessage() {}
+
+func (x *TeamFallback) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamFallback.ProtoReflect.Descriptor instead.
+func (*TeamFallback) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TeamFallback) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamFallback) GetChain() []*FallbackEntry {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+type TeamNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ParentTeam    *string                `protobuf:"bytes,2,opt,name=parent_team,json=parentTeam,proto3,oneof" json:"parent_team,omitempty"`
+	Children      []*TeamNode            `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamNode) Reset() {
+	*x = TeamNode{}
+	mi := &file_prs_v1_prs_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamNode) ProtoMessage() {}
+
+func (x *TeamNode) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamNode.ProtoReflect.Descriptor instead.
+func (*TeamNode) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TeamNode) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamNode) GetParentTeam() string {
+	if x != nil && x.ParentTeam != nil {
+		return *x.ParentTeam
+	}
+	return ""
+}
+
+func (x *TeamNode) GetChildren() []*TeamNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type CodeOwnerRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Users         []string               `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	Teams         []string               `protobuf:"bytes,3,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CodeOwnerRule) Reset() {
+	*x = CodeOwnerRule{}
+	mi := &file_prs_v1_prs_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodeOwnerRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeOwnerRule) ProtoMessage() {}
+
+func (x *CodeOwnerRule) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeOwnerRule.ProtoReflect.Descriptor instead.
+func (*CodeOwnerRule) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CodeOwnerRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *CodeOwnerRule) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *CodeOwnerRule) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type TeamOwners struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Rules         []*CodeOwnerRule       `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamOwners) Reset() {
+	*x = TeamOwners{}
+	mi := &file_prs_v1_prs_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamOwners) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamOwners) ProtoMessage() {}
+
+func (x *TeamOwners) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamOwners.ProtoReflect.Descriptor instead.
+func (*TeamOwners) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TeamOwners) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamOwners) GetRules() []*CodeOwnerRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type TeamReviewRules struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TeamName        string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	RequireSenior   bool                   `protobuf:"varint,2,opt,name=require_senior,json=requireSenior,proto3" json:"require_senior,omitempty"`
+	ShadowJunior    bool                   `protobuf:"varint,3,opt,name=shadow_junior,json=shadowJunior,proto3" json:"shadow_junior,omitempty"`
+	RotationWindow  int32                  `protobuf:"varint,4,opt,name=rotation_window,json=rotationWindow,proto3" json:"rotation_window,omitempty"`
+	RotationPenalty float64                `protobuf:"fixed64,5,opt,name=rotation_penalty,json=rotationPenalty,proto3" json:"rotation_penalty,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TeamReviewRules) Reset() {
+	*x = TeamReviewRules{}
+	mi := &file_prs_v1_prs_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamReviewRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamReviewRules) ProtoMessage() {}
+
+func (x *TeamReviewRules) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamReviewRules.ProtoReflect.Descriptor instead.
+func (*TeamReviewRules) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TeamReviewRules) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamReviewRules) GetRequireSenior() bool {
+	if x != nil {
+		return x.RequireSenior
+	}
+	return false
+}
+
+func (x *TeamReviewRules) GetShadowJunior() bool {
+	if x != nil {
+		return x.ShadowJunior
+	}
+	return false
+}
+
+func (x *TeamReviewRules) GetRotationWindow() int32 {
+	if x != nil {
+		return x.RotationWindow
+	}
+	return 0
+}
+
+func (x *TeamReviewRules) GetRotationPenalty() float64 {
+	if x != nil {
+		return x.RotationPenalty
+	}
+	return 0
+}
+
+type TeamSLA struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TeamName         string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	FirstReviewHours int32                  `protobuf:"varint,2,opt,name=first_review_hours,json=firstReviewHours,proto3" json:"first_review_hours,omitempty"`
+	// Без значения автоматическое переназначение отключено
+	ReassignAfterHours *int32 `protobuf:"varint,3,opt,name=reassign_after_hours,json=reassignAfterHours,proto3,oneof" json:"reassign_after_hours,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TeamSLA) Reset() {
+	*x = TeamSLA{}
+	mi := &file_prs_v1_prs_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamSLA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamSLA) ProtoMessage() {}
+
+func (x *TeamSLA) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamSLA.ProtoReflect.Descriptor instead.
+func (*TeamSLA) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TeamSLA) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamSLA) GetFirstReviewHours() int32 {
+	if x != nil {
+		return x.FirstReviewHours
+	}
+	return 0
+}
+
+func (x *TeamSLA) GetReassignAfterHours() int32 {
+	if x != nil && x.ReassignAfterHours != nil {
+		return *x.ReassignAfterHours
+	}
+	return 0
+}
+
+// WorkSchedule — рабочее время; пустой time_zone при сохранении удаляет расписание.
+type WorkSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeZone      string                 `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	WorkDays      []string               `protobuf:"bytes,2,rep,name=work_days,json=workDays,proto3" json:"work_days,omitempty"`
+	WorkStart     string                 `protobuf:"bytes,3,opt,name=work_start,json=workStart,proto3" json:"work_start,omitempty"`
+	WorkEnd       string                 `protobuf:"bytes,4,opt,name=work_end,json=workEnd,proto3" json:"work_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkSchedule) Reset() {
+	*x = WorkSchedule{}
+	mi := &file_prs_v1_prs_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkSchedule) ProtoMessage() {}
+
+func (x *WorkSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkSchedule.ProtoReflect.Descriptor instead.
+func (*WorkSchedule) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WorkSchedule) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *WorkSchedule) GetWorkDays() []string {
+	if x != nil {
+		return x.WorkDays
+	}
+	return nil
+}
+
+func (x *WorkSchedule) GetWorkStart() string {
+	if x != nil {
+		return x.WorkStart
+	}
+	return ""
+}
+
+func (x *WorkSchedule) GetWorkEnd() string {
+	if x != nil {
+		return x.WorkEnd
+	}
+	return ""
+}
+
+type TeamSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Schedule      *WorkSchedule          `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamSchedule) Reset() {
+	*x = TeamSchedule{}
+	mi := &file_prs_v1_prs_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamSchedule) ProtoMessage() {}
+
+func (x *TeamSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamSchedule.ProtoReflect.Descriptor instead.
+func (*TeamSchedule) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TeamSchedule) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamSchedule) GetSchedule() *WorkSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type UserSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Schedule      *WorkSchedule          `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSchedule) Reset() {
+	*x = UserSchedule{}
+	mi := &file_prs_v1_prs_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSchedule) ProtoMessage() {}
+
+func (x *UserSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSchedule.ProtoReflect.Descriptor instead.
+func (*UserSchedule) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UserSchedule) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserSchedule) GetSchedule() *WorkSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type Holiday struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Recurring     bool                   `protobuf:"varint,3,opt,name=recurring,proto3" json:"recurring,omitempty"`
+	TeamName      string                 `protobuf:"bytes,4,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_prs_v1_prs_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holiday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holiday) ProtoMessage() {}
+
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Holiday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Holiday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Holiday) GetRecurring() bool {
+	if x != nil {
+		return x.Recurring
+	}
+	return false
+}
+
+func (x *Holiday) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type Availability struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TimeZone        string                 `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	At              *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	LocalTime       string                 `protobuf:"bytes,4,opt,name=local_time,json=localTime,proto3" json:"local_time,omitempty"`
+	Available       bool                   `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	NextAvailableAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_available_at,json=nextAvailableAt,proto3" json:"next_available_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Availability) Reset() {
+	*x = Availability{}
+	mi := &file_prs_v1_prs_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Availability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Availability) ProtoMessage() {}
+
+func (x *Availability) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Availability.ProtoReflect.Descriptor instead.
+func (*Availability) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Availability) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Availability) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Availability) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *Availability) GetLocalTime() string {
+	if x != nil {
+		return x.LocalTime
+	}
+	return ""
+}
+
+func (x *Availability) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *Availability) GetNextAvailableAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAvailableAt
+	}
+	return nil
+}
+
+type UserTeam struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,4,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserTeam) Reset() {
+	*x = UserTeam{}
+	mi := &file_prs_v1_prs_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserTeam) ProtoMessage() {}
+
+func (x *UserTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserTeam.ProtoReflect.Descriptor instead.
+func (*UserTeam) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UserTeam) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *UserTeam) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UserTeam) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UserTeam) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+type User struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// Основная команда
+	TeamName      *string     `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3,oneof" json:"team_name,omitempty"`
+	IsActive      bool        `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Seniority     string      `protobuf:"bytes,5,opt,name=seniority,proto3" json:"seniority,omitempty"`
+	Teams         []*UserTeam `protobuf:"bytes,6,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_prs_v1_prs_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil && x.TeamName != nil {
+		return *x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *User) GetSeniority() string {
+	if x != nil {
+		return x.Seniority
+	}
+	return ""
+}
+
+func (x *User) GetTeams() []*UserTeam {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type UserSkills struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Skills        []string               `protobuf:"bytes,2,rep,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSkills) Reset() {
+	*x = UserSkills{}
+	mi := &file_prs_v1_prs_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSkills) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSkills) ProtoMessage() {}
+
+func (x *UserSkills) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSkills.ProtoReflect.Descriptor instead.
+func (*UserSkills) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UserSkills) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserSkills) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type SlackUser struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Пустое значение удаляет привязку
+	SlackId       string `protobuf:"bytes,2,opt,name=slack_id,json=slackId,proto3" json:"slack_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlackUser) Reset() {
+	*x = SlackUser{}
+	mi := &file_prs_v1_prs_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlackUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlackUser) ProtoMessage() {}
+
+func (x *SlackUser) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlackUser.ProtoReflect.Descriptor instead.
+func (*SlackUser) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SlackUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SlackUser) GetSlackId() string {
+	if x != nil {
+		return x.SlackId
+	}
+	return ""
+}
+
+type DigestSubscription struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Пустое значение отменяет подписку
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// daily или weekly
+	Frequency     string                 `protobuf:"bytes,3,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	SendAt        string                 `protobuf:"bytes,4,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	Weekday       string                 `protobuf:"bytes,5,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	LastSentAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_sent_at,json=lastSentAt,proto3" json:"last_sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigestSubscription) Reset() {
+	*x = DigestSubscription{}
+	mi := &file_prs_v1_prs_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestSubscription) ProtoMessage() {}
+
+func (x *DigestSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestSubscription.ProtoReflect.Descriptor instead.
+func (*DigestSubscription) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DigestSubscription) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DigestSubscription) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *DigestSubscription) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *DigestSubscription) GetSendAt() string {
+	if x != nil {
+		return x.SendAt
+	}
+	return ""
+}
+
+func (x *DigestSubscription) GetWeekday() string {
+	if x != nil {
+		return x.Weekday
+	}
+	return ""
+}
+
+func (x *DigestSubscription) GetLastSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSentAt
+	}
+	return nil
+}
+
+type PullRequestShort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PullRequestShort) Reset() {
+	*x = PullRequestShort{}
+	mi := &file_prs_v1_prs_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestShort) ProtoMessage() {}
+
+func (x *PullRequestShort) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestShort.ProtoReflect.Descriptor instead.
+func (*PullRequestShort) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PullRequestShort) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ReviewAssignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewerId    string                 `protobuf:"bytes,1,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	SourceTeam    *string                `protobuf:"bytes,3,opt,name=source_team,json=sourceTeam,proto3,oneof" json:"source_team,omitempty"`
+	DecisionId    *int64                 `protobuf:"varint,4,opt,name=decision_id,json=decisionId,proto3,oneof" json:"decision_id,omitempty"`
+	Shadow        bool                   `protobuf:"varint,5,opt,name=shadow,proto3" json:"shadow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAssignment) Reset() {
+	*x = ReviewAssignment{}
+	mi := &file_prs_v1_prs_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAssignment) ProtoMessage() {}
+
+func (x *ReviewAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAssignment.ProtoReflect.Descriptor instead.
+func (*ReviewAssignment) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReviewAssignment) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ReviewAssignment) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ReviewAssignment) GetSourceTeam() string {
+	if x != nil && x.SourceTeam != nil {
+		return *x.SourceTeam
+	}
+	return ""
+}
+
+func (x *ReviewAssignment) GetDecisionId() int64 {
+	if x != nil && x.DecisionId != nil {
+		return *x.DecisionId
+	}
+	return 0
+}
+
+func (x *ReviewAssignment) GetShadow() bool {
+	if x != nil {
+		return x.Shadow
+	}
+	return false
+}
+
+type PullRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	TeamName        string                 `protobuf:"bytes,4,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// OPEN или MERGED
+	Status            string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedReviewers []string               `protobuf:"bytes,6,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	ShadowReviewer    *string                `protobuf:"bytes,7,opt,name=shadow_reviewer,json=shadowReviewer,proto3,oneof" json:"shadow_reviewer,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	ChangedFiles      []string               `protobuf:"bytes,10,rep,name=changed_files,json=changedFiles,proto3" json:"changed_files,omitempty"`
+	Labels            []string               `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty"`
+	Assignments       []*ReviewAssignment    `protobuf:"bytes,12,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetShadowReviewer() string {
+	if x != nil && x.ShadowReviewer != nil {
+		return *x.ShadowReviewer
+	}
+	return ""
+}
+
+func (x *PullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetChangedFiles() []string {
+	if x != nil {
+		return x.ChangedFiles
+	}
+	return nil
+}
+
+func (x *PullRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *PullRequest) GetAssignments() []*ReviewAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type CandidateExplanation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	SourceTeam    *string                `protobuf:"bytes,3,opt,name=source_team,json=sourceTeam,proto3,oneof" json:"source_team,omitempty"`
+	Eligible      bool                   `protobuf:"varint,4,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Seniority     string                 `protobuf:"bytes,6,opt,name=seniority,proto3" json:"seniority,omitempty"`
+	Skills        []string               `protobuf:"bytes,7,rep,name=skills,proto3" json:"skills,omitempty"`
+	Load          int32                  `protobuf:"varint,8,opt,name=load,proto3" json:"load,omitempty"`
+	Recent        int32                  `protobuf:"varint,9,opt,name=recent,proto3" json:"recent,omitempty"`
+	Score         *float64               `protobuf:"fixed64,10,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	Rank          int32                  `protobuf:"varint,11,opt,name=rank,proto3" json:"rank,omitempty"`
+	Picked        bool                   `protobuf:"varint,12,opt,name=picked,proto3" json:"picked,omitempty"`
+	Shadow        bool                   `protobuf:"varint,13,opt,name=shadow,proto3" json:"shadow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CandidateExplanation) Reset() {
+	*x = CandidateExplanation{}
+	mi := &file_prs_v1_prs_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CandidateExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandidateExplanation) ProtoMessage() {}
+
+func (x *CandidateExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandidateExplanation.ProtoReflect.Descriptor instead.
+func (*CandidateExplanation) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CandidateExplanation) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CandidateExplanation) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CandidateExplanation) GetSourceTeam() string {
+	if x != nil && x.SourceTeam != nil {
+		return *x.SourceTeam
+	}
+	return ""
+}
+
+func (x *CandidateExplanation) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+func (x *CandidateExplanation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CandidateExplanation) GetSeniority() string {
+	if x != nil {
+		return x.Seniority
+	}
+	return ""
+}
+
+func (x *CandidateExplanation) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *CandidateExplanation) GetLoad() int32 {
+	if x != nil {
+		return x.Load
+	}
+	return 0
+}
+
+func (x *CandidateExplanation) GetRecent() int32 {
+	if x != nil {
+		return x.Recent
+	}
+	return 0
+}
+
+func (x *CandidateExplanation) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *CandidateExplanation) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *CandidateExplanation) GetPicked() bool {
+	if x != nil {
+		return x.Picked
+	}
+	return false
+}
+
+func (x *CandidateExplanation) GetShadow() bool {
+	if x != nil {
+		return x.Shadow
+	}
+	return false
+}
+
+type AssignmentDecision struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	DecisionId       int64                   `protobuf:"varint,1,opt,name=decision_id,json=decisionId,proto3" json:"decision_id,omitempty"`
+	Reason           string                  `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Seed             uint64                  `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	AlgorithmVersion int32                   `protobuf:"varint,4,opt,name=algorithm_version,json=algorithmVersion,proto3" json:"algorithm_version,omitempty"`
+	CreatedAt        *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Labels           []string                `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty"`
+	Limit            int32                   `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	RequireSenior    bool                    `protobuf:"varint,8,opt,name=require_senior,json=requireSenior,proto3" json:"require_senior,omitempty"`
+	Shadow           bool                    `protobuf:"varint,9,opt,name=shadow,proto3" json:"shadow,omitempty"`
+	Recorded         []string                `protobuf:"bytes,10,rep,name=recorded,proto3" json:"recorded,omitempty"`
+	Picked           []string                `protobuf:"bytes,11,rep,name=picked,proto3" json:"picked,omitempty"`
+	Reproduced       bool                    `protobuf:"varint,12,opt,name=reproduced,proto3" json:"reproduced,omitempty"`
+	Candidates       []*CandidateExplanation `protobuf:"bytes,13,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// Почему решение не повторялось (решение прежней версии алгоритма)
+	ReplayError   *string `protobuf:"bytes,14,opt,name=replay_error,json=replayError,proto3,oneof" json:"replay_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentDecision) Reset() {
+	*x = AssignmentDecision{}
+	mi := &file_prs_v1_prs_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentDecision) ProtoMessage() {}
+
+func (x *AssignmentDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentDecision.ProtoReflect.Descriptor instead.
+func (*AssignmentDecision) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AssignmentDecision) GetDecisionId() int64 {
+	if x != nil {
+		return x.DecisionId
+	}
+	return 0
+}
+
+func (x *AssignmentDecision) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AssignmentDecision) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *AssignmentDecision) GetAlgorithmVersion() int32 {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return 0
+}
+
+func (x *AssignmentDecision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AssignmentDecision) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *AssignmentDecision) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AssignmentDecision) GetRequireSenior() bool {
+	if x != nil {
+		return x.RequireSenior
+	}
+	return false
+}
+
+func (x *AssignmentDecision) GetShadow() bool {
+	if x != nil {
+		return x.Shadow
+	}
+	return false
+}
+
+func (x *AssignmentDecision) GetRecorded() []string {
+	if x != nil {
+		return x.Recorded
+	}
+	return nil
+}
+
+func (x *AssignmentDecision) GetPicked() []string {
+	if x != nil {
+		return x.Picked
+	}
+	return nil
+}
+
+func (x *AssignmentDecision) GetReproduced() bool {
+	if x != nil {
+		return x.Reproduced
+	}
+	return false
+}
+
+func (x *AssignmentDecision) GetCandidates() []*CandidateExplanation {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *AssignmentDecision) GetReplayError() string {
+	if x != nil && x.ReplayError != nil {
+		return *x.ReplayError
+	}
+	return ""
+}
+
+type OverdueReview struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	TeamName        string                 `protobuf:"bytes,4,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ReviewerId      string                 `protobuf:"bytes,5,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	AssignedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	DueAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	ReassignAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=reassign_at,json=reassignAt,proto3" json:"reassign_at,omitempty"`
+	BreachedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=breached_at,json=breachedAt,proto3" json:"breached_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OverdueReview) Reset() {
+	*x = OverdueReview{}
+	mi := &file_prs_v1_prs_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverdueReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverdueReview) ProtoMessage() {}
+
+func (x *OverdueReview) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverdueReview.ProtoReflect.Descriptor instead.
+func (*OverdueReview) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OverdueReview) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *OverdueReview) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *OverdueReview) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *OverdueReview) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *OverdueReview) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *OverdueReview) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *OverdueReview) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *OverdueReview) GetReassignAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReassignAt
+	}
+	return nil
+}
+
+func (x *OverdueReview) GetBreachedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BreachedAt
+	}
+	return nil
+}
+
+type LatencySummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	MeanHours     float64                `protobuf:"fixed64,2,opt,name=mean_hours,json=meanHours,proto3" json:"mean_hours,omitempty"`
+	MedianHours   float64                `protobuf:"fixed64,3,opt,name=median_hours,json=medianHours,proto3" json:"median_hours,omitempty"`
+	P90Hours      float64                `protobuf:"fixed64,4,opt,name=p90_hours,json=p90Hours,proto3" json:"p90_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatencySummary) Reset() {
+	*x = LatencySummary{}
+	mi := &file_prs_v1_prs_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatencySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencySummary) ProtoMessage() {}
+
+func (x *LatencySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencySummary.ProtoReflect.Descriptor instead.
+func (*LatencySummary) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LatencySummary) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *LatencySummary) GetMeanHours() float64 {
+	if x != nil {
+		return x.MeanHours
+	}
+	return 0
+}
+
+func (x *LatencySummary) GetMedianHours() float64 {
+	if x != nil {
+		return x.MedianHours
+	}
+	return 0
+}
+
+func (x *LatencySummary) GetP90Hours() float64 {
+	if x != nil {
+		return x.P90Hours
+	}
+	return 0
+}
+
+type ReviewerLatency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Latency       *LatencySummary        `protobuf:"bytes,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerLatency) Reset() {
+	*x = ReviewerLatency{}
+	mi := &file_prs_v1_prs_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerLatency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerLatency) ProtoMessage() {}
+
+func (x *ReviewerLatency) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerLatency.ProtoReflect.Descriptor instead.
+func (*ReviewerLatency) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReviewerLatency) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewerLatency) GetLatency() *LatencySummary {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+// PairRow — строка матрицы повторений пар: сколько PR автора ревьюил каждый из ревьюверов.
+type PairRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counts        []int32                `protobuf:"varint,1,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PairRow) Reset() {
+	*x = PairRow{}
+	mi := &file_prs_v1_prs_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PairRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairRow) ProtoMessage() {}
+
+func (x *PairRow) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairRow.ProtoReflect.Descriptor instead.
+func (*PairRow) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PairRow) GetCounts() []int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	PullRequestId *string                `protobuf:"bytes,3,opt,name=pull_request_id,json=pullRequestId,proto3,oneof" json:"pull_request_id,omitempty"`
+	TeamId        *string                `protobuf:"bytes,4,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`
+	UserId        *string                `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Payload       *structpb.Struct       `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_prs_v1_prs_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Event) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetPullRequestId() string {
+	if x != nil && x.PullRequestId != nil {
+		return *x.PullRequestId
+	}
+	return ""
+}
+
+func (x *Event) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
+func (x *Event) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *Event) GetPayload() *structpb.Struct {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamRequest) Reset() {
+	*x = AddTeamRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamRequest) ProtoMessage() {}
+
+func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type AddTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamResponse) Reset() {
+	*x = AddTeamResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamResponse) ProtoMessage() {}
+
+func (x *AddTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TeamName           string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IncludeDescendants bool                   `protobuf:"varint,2,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *GetTeamRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+type GetTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamResponse) Reset() {
+	*x = GetTeamResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamResponse) ProtoMessage() {}
+
+func (x *GetTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamFallbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamFallbackRequest) Reset() {
+	*x = GetTeamFallbackRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamFallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamFallbackRequest) ProtoMessage() {}
+
+func (x *GetTeamFallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamFallbackRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamFallbackRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTeamFallbackRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamFallbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fallback      *TeamFallback          `protobuf:"bytes,1,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamFallbackResponse) Reset() {
+	*x = GetTeamFallbackResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamFallbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamFallbackResponse) ProtoMessage() {}
+
+func (x *GetTeamFallbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamFallbackResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamFallbackResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetTeamFallbackResponse) GetFallback() *TeamFallback {
+	if x != nil {
+		return x.Fallback
+	}
+	return nil
+}
+
+type SetTeamFallbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fallback      *TeamFallback          `protobuf:"bytes,1,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamFallbackRequest) Reset() {
+	*x = SetTeamFallbackRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamFallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamFallbackRequest) ProtoMessage() {}
+
+func (x *SetTeamFallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamFallbackRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamFallbackRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetTeamFallbackRequest) GetFallback() *TeamFallback {
+	if x != nil {
+		return x.Fallback
+	}
+	return nil
+}
+
+type SetTeamFallbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fallback      *TeamFallback          `protobuf:"bytes,1,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamFallbackResponse) Reset() {
+	*x = SetTeamFallbackResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamFallbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamFallbackResponse) ProtoMessage() {}
+
+func (x *SetTeamFallbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamFallbackResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamFallbackResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetTeamFallbackResponse) GetFallback() *TeamFallback {
+	if x != nil {
+		return x.Fallback
+	}
+	return nil
+}
+
+type GetTeamSubtreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamSubtreeRequest) Reset() {
+	*x = GetTeamSubtreeRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamSubtreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamSubtreeRequest) ProtoMessage() {}
+
+func (x *GetTeamSubtreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamSubtreeRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamSubtreeRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetTeamSubtreeRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamSubtreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subtree       *TeamNode              `protobuf:"bytes,1,opt,name=subtree,proto3" json:"subtree,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamSubtreeResponse) Reset() {
+	*x = GetTeamSubtreeResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamSubtreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamSubtreeResponse) ProtoMessage() {}
+
+func (x *GetTeamSubtreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamSubtreeResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamSubtreeResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetTeamSubtreeResponse) GetSubtree() *TeamNode {
+	if x != nil {
+		return x.Subtree
+	}
+	return nil
+}
+
+type SetTeamParentRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Без значения команда становится корневой
+	ParentTeam    *string `protobuf:"bytes,2,opt,name=parent_team,json=parentTeam,proto3,oneof" json:"parent_team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamParentRequest) Reset() {
+	*x = SetTeamParentRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamParentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamParentRequest) ProtoMessage() {}
+
+func (x *SetTeamParentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamParentRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamParentRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetTeamParentRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetTeamParentRequest) GetParentTeam() string {
+	if x != nil && x.ParentTeam != nil {
+		return *x.ParentTeam
+	}
+	return ""
+}
+
+type SetTeamParentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamParentResponse) Reset() {
+	*x = SetTeamParentResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamParentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamParentResponse) ProtoMessage() {}
+
+func (x *SetTeamParentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamParentResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamParentResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetTeamParentResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamOwnersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamOwnersRequest) Reset() {
+	*x = GetTeamOwnersRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamOwnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamOwnersRequest) ProtoMessage() {}
+
+func (x *GetTeamOwnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamOwnersRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamOwnersRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetTeamOwnersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamOwnersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owners        *TeamOwners            `protobuf:"bytes,1,opt,name=owners,proto3" json:"owners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamOwnersResponse) Reset() {
+	*x = GetTeamOwnersResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamOwnersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamOwnersResponse) ProtoMessage() {}
+
+func (x *GetTeamOwnersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamOwnersResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamOwnersResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetTeamOwnersResponse) GetOwners() *TeamOwners {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+type SetTeamOwnersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owners        *TeamOwners            `protobuf:"bytes,1,opt,name=owners,proto3" json:"owners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamOwnersRequest) Reset() {
+	*x = SetTeamOwnersRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamOwnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamOwnersRequest) ProtoMessage() {}
+
+func (x *SetTeamOwnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamOwnersRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamOwnersRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetTeamOwnersRequest) GetOwners() *TeamOwners {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+type SetTeamOwnersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owners        *TeamOwners            `protobuf:"bytes,1,opt,name=owners,proto3" json:"owners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamOwnersResponse) Reset() {
+	*x = SetTeamOwnersResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamOwnersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamOwnersResponse) ProtoMessage() {}
+
+func (x *SetTeamOwnersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamOwnersResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamOwnersResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetTeamOwnersResponse) GetOwners() *TeamOwners {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+type DeleteTeamOwnersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamOwnersRequest) Reset() {
+	*x = DeleteTeamOwnersRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamOwnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamOwnersRequest) ProtoMessage() {}
+
+func (x *DeleteTeamOwnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamOwnersRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamOwnersRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteTeamOwnersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type DeleteTeamOwnersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamOwnersResponse) Reset() {
+	*x = DeleteTeamOwnersResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamOwnersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamOwnersResponse) ProtoMessage() {}
+
+func (x *DeleteTeamOwnersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamOwnersResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTeamOwnersResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{46}
+}
+
+type GetTeamReviewRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamReviewRulesRequest) Reset() {
+	*x = GetTeamReviewRulesRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamReviewRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamReviewRulesRequest) ProtoMessage() {}
+
+func (x *GetTeamReviewRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamReviewRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamReviewRulesRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetTeamReviewRulesRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamReviewRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         *TeamReviewRules       `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamReviewRulesResponse) Reset() {
+	*x = GetTeamReviewRulesResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamReviewRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamReviewRulesResponse) ProtoMessage() {}
+
+func (x *GetTeamReviewRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamReviewRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamReviewRulesResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetTeamReviewRulesResponse) GetRules() *TeamReviewRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SetTeamReviewRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         *TeamReviewRules       `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamReviewRulesRequest) Reset() {
+	*x = SetTeamReviewRulesRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamReviewRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamReviewRulesRequest) ProtoMessage() {}
+
+func (x *SetTeamReviewRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamReviewRulesRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamReviewRulesRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetTeamReviewRulesRequest) GetRules() *TeamReviewRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SetTeamReviewRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         *TeamReviewRules       `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamReviewRulesResponse) Reset() {
+	*x = SetTeamReviewRulesResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamReviewRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamReviewRulesResponse) ProtoMessage() {}
+
+func (x *SetTeamReviewRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamReviewRulesResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamReviewRulesResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SetTeamReviewRulesResponse) GetRules() *TeamReviewRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type GetTeamSLARequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamSLARequest) Reset() {
+	*x = GetTeamSLARequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamSLARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamSLARequest) ProtoMessage() {}
+
+func (x *GetTeamSLARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamSLARequest.ProtoReflect.Descriptor instead.
+func (*GetTeamSLARequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetTeamSLARequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamSLAResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sla           *TeamSLA               `protobuf:"bytes,1,opt,name=sla,proto3" json:"sla,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamSLAResponse) Reset() {
+	*x = GetTeamSLAResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamSLAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamSLAResponse) ProtoMessage() {}
+
+func (x *GetTeamSLAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamSLAResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamSLAResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetTeamSLAResponse) GetSla() *TeamSLA {
+	if x != nil {
+		return x.Sla
+	}
+	return nil
+}
+
+type SetTeamSLARequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sla           *TeamSLA               `protobuf:"bytes,1,opt,name=sla,proto3" json:"sla,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamSLARequest) Reset() {
+	*x = SetTeamSLARequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamSLARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamSLARequest) ProtoMessage() {}
+
+func (x *SetTeamSLARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamSLARequest.ProtoReflect.Descriptor instead.
+func (*SetTeamSLARequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SetTeamSLARequest) GetSla() *TeamSLA {
+	if x != nil {
+		return x.Sla
+	}
+	return nil
+}
+
+type SetTeamSLAResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sla           *TeamSLA               `protobuf:"bytes,1,opt,name=sla,proto3" json:"sla,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamSLAResponse) Reset() {
+	*x = SetTeamSLAResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamSLAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamSLAResponse) ProtoMessage() {}
+
+func (x *SetTeamSLAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamSLAResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamSLAResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SetTeamSLAResponse) GetSla() *TeamSLA {
+	if x != nil {
+		return x.Sla
+	}
+	return nil
+}
+
+type GetTeamScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamScheduleRequest) Reset() {
+	*x = GetTeamScheduleRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamScheduleRequest) ProtoMessage() {}
+
+func (x *GetTeamScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetTeamScheduleRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *TeamSchedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamScheduleResponse) Reset() {
+	*x = GetTeamScheduleResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamScheduleResponse) ProtoMessage() {}
+
+func (x *GetTeamScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetTeamScheduleResponse) GetSchedule() *TeamSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type SetTeamScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *TeamSchedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamScheduleRequest) Reset() {
+	*x = SetTeamScheduleRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamScheduleRequest) ProtoMessage() {}
+
+func (x *SetTeamScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetTeamScheduleRequest) GetSchedule() *TeamSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type SetTeamScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *TeamSchedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamScheduleResponse) Reset() {
+	*x = SetTeamScheduleResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamScheduleResponse) ProtoMessage() {}
+
+func (x *SetTeamScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetTeamScheduleResponse) GetSchedule() *TeamSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type SetIsActiveRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Если задано — меняется только членство в команде
+	TeamName      string `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIsActiveRequest) Reset() {
+	*x = SetIsActiveRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIsActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIsActiveRequest) ProtoMessage() {}
+
+func (x *SetIsActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIsActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetIsActiveRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SetIsActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetIsActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SetIsActiveRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type SetIsActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIsActiveResponse) Reset() {
+	*x = SetIsActiveResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIsActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIsActiveResponse) ProtoMessage() {}
+
+func (x *SetIsActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIsActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetIsActiveResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SetIsActiveResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type SetSeniorityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Seniority     string                 `protobuf:"bytes,2,opt,name=seniority,proto3" json:"seniority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSeniorityRequest) Reset() {
+	*x = SetSeniorityRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSeniorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSeniorityRequest) ProtoMessage() {}
+
+func (x *SetSeniorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSeniorityRequest.ProtoReflect.Descriptor instead.
+func (*SetSeniorityRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SetSeniorityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetSeniorityRequest) GetSeniority() string {
+	if x != nil {
+		return x.Seniority
+	}
+	return ""
+}
+
+type SetSeniorityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSeniorityResponse) Reset() {
+	*x = SetSeniorityResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSeniorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSeniorityResponse) ProtoMessage() {}
+
+func (x *SetSeniorityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSeniorityResponse.ProtoReflect.Descriptor instead.
+func (*SetSeniorityResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetSeniorityResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewRequest) Reset() {
+	*x = GetReviewRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewRequest) ProtoMessage() {}
+
+func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests  []*PullRequestShort    `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewResponse) Reset() {
+	*x = GetReviewResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewResponse) ProtoMessage() {}
+
+func (x *GetReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetReviewResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetReviewResponse) GetPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+type GetSkillsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkillsRequest) Reset() {
+	*x = GetSkillsRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkillsRequest) ProtoMessage() {}
+
+func (x *GetSkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkillsRequest.ProtoReflect.Descriptor instead.
+func (*GetSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetSkillsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetSkillsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skills        *UserSkills            `protobuf:"bytes,1,opt,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkillsResponse) Reset() {
+	*x = GetSkillsResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkillsResponse) ProtoMessage() {}
+
+func (x *GetSkillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkillsResponse.ProtoReflect.Descriptor instead.
+func (*GetSkillsResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetSkillsResponse) GetSkills() *UserSkills {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type SetSkillsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skills        *UserSkills            `protobuf:"bytes,1,opt,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSkillsRequest) Reset() {
+	*x = SetSkillsRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSkillsRequest) ProtoMessage() {}
+
+func (x *SetSkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSkillsRequest.ProtoReflect.Descriptor instead.
+func (*SetSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SetSkillsRequest) GetSkills() *UserSkills {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type SetSkillsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skills        *UserSkills            `protobuf:"bytes,1,opt,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSkillsResponse) Reset() {
+	*x = SetSkillsResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSkillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSkillsResponse) ProtoMessage() {}
+
+func (x *SetSkillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSkillsResponse.ProtoReflect.Descriptor instead.
+func (*SetSkillsResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SetSkillsResponse) GetSkills() *UserSkills {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type GetUserScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserScheduleRequest) Reset() {
+	*x = GetUserScheduleRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserScheduleRequest) ProtoMessage() {}
+
+func (x *GetUserScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetUserScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetUserScheduleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *UserSchedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserScheduleResponse) Reset() {
+	*x = GetUserScheduleResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserScheduleResponse) ProtoMessage() {}
+
+func (x *GetUserScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetUserScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetUserScheduleResponse) GetSchedule() *UserSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type SetUserScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *UserSchedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserScheduleRequest) Reset() {
+	*x = SetUserScheduleRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserScheduleRequest) ProtoMessage() {}
+
+func (x *SetUserScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetUserScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SetUserScheduleRequest) GetSchedule() *UserSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type SetUserScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *UserSchedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserScheduleResponse) Reset() {
+	*x = SetUserScheduleResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserScheduleResponse) ProtoMessage() {}
+
+func (x *SetUserScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SetUserScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SetUserScheduleResponse) GetSchedule() *UserSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type GetAvailabilityRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// По умолчанию — текущий момент
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityRequest) Reset() {
+	*x = GetAvailabilityRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetAvailabilityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAvailabilityRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Availability  *Availability          `protobuf:"bytes,1,opt,name=availability,proto3" json:"availability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityResponse) Reset() {
+	*x = GetAvailabilityResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetAvailabilityResponse) GetAvailability() *Availability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+type GetSlackUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSlackUserRequest) Reset() {
+	*x = GetSlackUserRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSlackUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlackUserRequest) ProtoMessage() {}
+
+func (x *GetSlackUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlackUserRequest.ProtoReflect.Descriptor instead.
+func (*GetSlackUserRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetSlackUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetSlackUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slack         *SlackUser             `protobuf:"bytes,1,opt,name=slack,proto3" json:"slack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSlackUserResponse) Reset() {
+	*x = GetSlackUserResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSlackUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlackUserResponse) ProtoMessage() {}
+
+func (x *GetSlackUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlackUserResponse.ProtoReflect.Descriptor instead.
+func (*GetSlackUserResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetSlackUserResponse) GetSlack() *SlackUser {
+	if x != nil {
+		return x.Slack
+	}
+	return nil
+}
+
+type SetSlackUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slack         *SlackUser             `protobuf:"bytes,1,opt,name=slack,proto3" json:"slack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSlackUserRequest) Reset() {
+	*x = SetSlackUserRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSlackUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSlackUserRequest) ProtoMessage() {}
+
+func (x *SetSlackUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSlackUserRequest.ProtoReflect.Descriptor instead.
+func (*SetSlackUserRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SetSlackUserRequest) GetSlack() *SlackUser {
+	if x != nil {
+		return x.Slack
+	}
+	return nil
+}
+
+type SetSlackUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slack         *SlackUser             `protobuf:"bytes,1,opt,name=slack,proto3" json:"slack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSlackUserResponse) Reset() {
+	*x = SetSlackUserResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSlackUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSlackUserResponse) ProtoMessage() {}
+
+func (x *SetSlackUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSlackUserResponse.ProtoReflect.Descriptor instead.
+func (*SetSlackUserResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SetSlackUserResponse) GetSlack() *SlackUser {
+	if x != nil {
+		return x.Slack
+	}
+	return nil
+}
+
+type GetDigestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestRequest) Reset() {
+	*x = GetDigestRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestRequest) ProtoMessage() {}
+
+func (x *GetDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestRequest.ProtoReflect.Descriptor instead.
+func (*GetDigestRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetDigestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetDigestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digest        *DigestSubscription    `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestResponse) Reset() {
+	*x = GetDigestResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestResponse) ProtoMessage() {}
+
+func (x *GetDigestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestResponse.ProtoReflect.Descriptor instead.
+func (*GetDigestResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetDigestResponse) GetDigest() *DigestSubscription {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+type SetDigestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digest        *DigestSubscription    `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDigestRequest) Reset() {
+	*x = SetDigestRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDigestRequest) ProtoMessage() {}
+
+func (x *SetDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDigestRequest.ProtoReflect.Descriptor instead.
+func (*SetDigestRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SetDigestRequest) GetDigest() *DigestSubscription {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+type SetDigestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digest        *DigestSubscription    `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDigestResponse) Reset() {
+	*x = SetDigestResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDigestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDigestResponse) ProtoMessage() {}
+
+func (x *SetDigestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDigestResponse.ProtoReflect.Descriptor instead.
+func (*SetDigestResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SetDigestResponse) GetDigest() *DigestSubscription {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// По умолчанию — основная команда автора
+	TeamName      string   `protobuf:"bytes,4,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ChangedFiles  []string `protobuf:"bytes,5,rep,name=changed_files,json=changedFiles,proto3" json:"changed_files,omitempty"`
+	Labels        []string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetChangedFiles() []string {
+	if x != nil {
+		return x.ChangedFiles
+	}
+	return nil
+}
+
+func (x *CreatePullRequestRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type CreatePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestResponse) Reset() {
+	*x = CreatePullRequestResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestResponse) ProtoMessage() {}
+
+func (x *CreatePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreatePullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type MergePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestResponse) Reset() {
+	*x = MergePullRequestResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestResponse) ProtoMessage() {}
+
+func (x *MergePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*MergePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *MergePullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type ReassignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerRequest) Reset() {
+	*x = ReassignReviewerRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerRequest) ProtoMessage() {}
+
+func (x *ReassignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ReassignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+type ReassignReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerResponse) Reset() {
+	*x = ReassignReviewerResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerResponse) ProtoMessage() {}
+
+func (x *ReassignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ReassignReviewerResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *ReassignReviewerResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type FillReviewersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FillReviewersRequest) Reset() {
+	*x = FillReviewersRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FillReviewersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillReviewersRequest) ProtoMessage() {}
+
+func (x *FillReviewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillReviewersRequest.ProtoReflect.Descriptor instead.
+func (*FillReviewersRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *FillReviewersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type FillReviewersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequests  []*PullRequest         `protobuf:"bytes,1,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FillReviewersResponse) Reset() {
+	*x = FillReviewersResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FillReviewersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillReviewersResponse) ProtoMessage() {}
+
+func (x *FillReviewersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillReviewersResponse.ProtoReflect.Descriptor instead.
+func (*FillReviewersResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *FillReviewersResponse) GetPullRequests() []*PullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+type ExplainAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	DecisionId    *int64                 `protobuf:"varint,2,opt,name=decision_id,json=decisionId,proto3,oneof" json:"decision_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainAssignmentRequest) Reset() {
+	*x = ExplainAssignmentRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainAssignmentRequest) ProtoMessage() {}
+
+func (x *ExplainAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*ExplainAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ExplainAssignmentRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ExplainAssignmentRequest) GetDecisionId() int64 {
+	if x != nil && x.DecisionId != nil {
+		return *x.DecisionId
+	}
+	return 0
+}
+
+type ExplainAssignmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Decisions     []*AssignmentDecision  `protobuf:"bytes,2,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainAssignmentResponse) Reset() {
+	*x = ExplainAssignmentResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainAssignmentResponse) ProtoMessage() {}
+
+func (x *ExplainAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*ExplainAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ExplainAssignmentResponse) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ExplainAssignmentResponse) GetDecisions() []*AssignmentDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+type GetOverdueRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TeamName           string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IncludeDescendants bool                   `protobuf:"varint,2,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetOverdueRequest) Reset() {
+	*x = GetOverdueRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOverdueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOverdueRequest) ProtoMessage() {}
+
+func (x *GetOverdueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOverdueRequest.ProtoReflect.Descriptor instead.
+func (*GetOverdueRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetOverdueRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *GetOverdueRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+type GetOverdueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overdue       []*OverdueReview       `protobuf:"bytes,1,rep,name=overdue,proto3" json:"overdue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOverdueResponse) Reset() {
+	*x = GetOverdueResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOverdueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOverdueResponse) ProtoMessage() {}
+
+func (x *GetOverdueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOverdueResponse.ProtoReflect.Descriptor instead.
+func (*GetOverdueResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetOverdueResponse) GetOverdue() []*OverdueReview {
+	if x != nil {
+		return x.Overdue
+	}
+	return nil
+}
+
+type GetPullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestRequest) Reset() {
+	*x = GetPullRequestRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestRequest) ProtoMessage() {}
+
+func (x *GetPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetPullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type GetPullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestResponse) Reset() {
+	*x = GetPullRequestResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestResponse) ProtoMessage() {}
+
+func (x *GetPullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetPullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetPullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+// Пустые поля выборку не ограничивают; created_from включается в интервал, created_to — нет.
+type ListPullRequestsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TeamName           string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IncludeDescendants bool                   `protobuf:"varint,2,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+	// OPEN или MERGED
+	Status   string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	AuthorId string `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Обязательный или теневой ревьювер
+	ReviewerId        string                 `protobuf:"bytes,5,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	CreatedFrom       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	NeedMoreReviewers *bool                  `protobuf:"varint,8,opt,name=need_more_reviewers,json=needMoreReviewers,proto3,oneof" json:"need_more_reviewers,omitempty"`
+	// next_cursor предыдущей страницы
+	Cursor string `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// От 1 до 200, по умолчанию 50
+	Limit         *int32 `protobuf:"varint,10,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPullRequestsRequest) Reset() {
+	*x = ListPullRequestsRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPullRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPullRequestsRequest) ProtoMessage() {}
+
+func (x *ListPullRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPullRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListPullRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListPullRequestsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+func (x *ListPullRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetNeedMoreReviewers() bool {
+	if x != nil && x.NeedMoreReviewers != nil {
+		return *x.NeedMoreReviewers
+	}
+	return false
+}
+
+func (x *ListPullRequestsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListPullRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequests  []*PullRequest         `protobuf:"bytes,1,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPullRequestsResponse) Reset() {
+	*x = ListPullRequestsResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPullRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPullRequestsResponse) ProtoMessage() {}
+
+func (x *ListPullRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPullRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListPullRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListPullRequestsResponse) GetPullRequests() []*PullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+func (x *ListPullRequestsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetStatsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TeamName           string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IncludeDescendants bool                   `protobuf:"varint,2,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetStatsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *GetStatsRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+type GetStatsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	TotalPr  int32                  `protobuf:"varint,2,opt,name=total_pr,json=totalPr,proto3" json:"total_pr,omitempty"`
+	OpenPr   int32                  `protobuf:"varint,3,opt,name=open_pr,json=openPr,proto3" json:"open_pr,omitempty"`
+	// Имя ревьювера -> число назначений
+	ReviewAssignments map[string]int32 `protobuf:"bytes,4,rep,name=review_assignments,json=reviewAssignments,proto3" json:"review_assignments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetStatsResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *GetStatsResponse) GetTotalPr() int32 {
+	if x != nil {
+		return x.TotalPr
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetOpenPr() int32 {
+	if x != nil {
+		return x.OpenPr
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetReviewAssignments() map[string]int32 {
+	if x != nil {
+		return x.ReviewAssignments
+	}
+	return nil
+}
+
+type GetPairStatsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TeamName           string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IncludeDescendants bool                   `protobuf:"varint,2,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetPairStatsRequest) Reset() {
+	*x = GetPairStatsRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPairStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPairStatsRequest) ProtoMessage() {}
+
+func (x *GetPairStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPairStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPairStatsRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetPairStatsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *GetPairStatsRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+type GetPairStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Authors       []string               `protobuf:"bytes,2,rep,name=authors,proto3" json:"authors,omitempty"`
+	Reviewers     []string               `protobuf:"bytes,3,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	Matrix        []*PairRow             `protobuf:"bytes,4,rep,name=matrix,proto3" json:"matrix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPairStatsResponse) Reset() {
+	*x = GetPairStatsResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPairStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPairStatsResponse) ProtoMessage() {}
+
+func (x *GetPairStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPairStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPairStatsResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetPairStatsResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *GetPairStatsResponse) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *GetPairStatsResponse) GetReviewers() []string {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+func (x *GetPairStatsResponse) GetMatrix() []*PairRow {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+type GetLatencyStatsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TeamName           string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IncludeDescendants bool                   `protobuf:"varint,2,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetLatencyStatsRequest) Reset() {
+	*x = GetLatencyStatsRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatencyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatencyStatsRequest) ProtoMessage() {}
+
+func (x *GetLatencyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatencyStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetLatencyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetLatencyStatsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *GetLatencyStatsRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+type GetLatencyStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Merge         *LatencySummary        `protobuf:"bytes,2,opt,name=merge,proto3" json:"merge,omitempty"`
+	Reviewers     []*ReviewerLatency     `protobuf:"bytes,3,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLatencyStatsResponse) Reset() {
+	*x = GetLatencyStatsResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatencyStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatencyStatsResponse) ProtoMessage() {}
+
+func (x *GetLatencyStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatencyStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetLatencyStatsResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetLatencyStatsResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *GetLatencyStatsResponse) GetMerge() *LatencySummary {
+	if x != nil {
+		return x.Merge
+	}
+	return nil
+}
+
+func (x *GetLatencyStatsResponse) GetReviewers() []*ReviewerLatency {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+type StreamEventsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	LastEventId        *int64                 `protobuf:"varint,1,opt,name=last_event_id,json=lastEventId,proto3,oneof" json:"last_event_id,omitempty"`
+	TeamName           string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IncludeDescendants bool                   `protobuf:"varint,3,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+	UserId             string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Types              []string               `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *StreamEventsRequest) GetLastEventId() int64 {
+	if x != nil && x.LastEventId != nil {
+		return *x.LastEventId
+	}
+	return 0
+}
+
+func (x *StreamEventsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *StreamEventsRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+func (x *StreamEventsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamEventsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type StreamEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsResponse) Reset() {
+	*x = StreamEventsResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsResponse) ProtoMessage() {}
+
+func (x *StreamEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsResponse.ProtoReflect.Descriptor instead.
+func (*StreamEventsResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *StreamEventsResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type GetHolidaysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHolidaysRequest) Reset() {
+	*x = GetHolidaysRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHolidaysRequest) ProtoMessage() {}
+
+func (x *GetHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*GetHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetHolidaysRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetHolidaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holidays      []*Holiday             `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHolidaysResponse) Reset() {
+	*x = GetHolidaysResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHolidaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHolidaysResponse) ProtoMessage() {}
+
+func (x *GetHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*GetHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetHolidaysResponse) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type ImportHolidaysRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Replace  bool                   `protobuf:"varint,2,opt,name=replace,proto3" json:"replace,omitempty"`
+	// Файл в формате iCalendar (text/calendar)
+	Ics           []byte `protobuf:"bytes,3,opt,name=ics,proto3" json:"ics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportHolidaysRequest) Reset() {
+	*x = ImportHolidaysRequest{}
+	mi := &file_prs_v1_prs_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportHolidaysRequest) ProtoMessage() {}
+
+func (x *ImportHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*ImportHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ImportHolidaysRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ImportHolidaysRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+func (x *ImportHolidaysRequest) GetIcs() []byte {
+	if x != nil {
+		return x.Ics
+	}
+	return nil
+}
+
+type ImportHolidaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Imported      int32                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportHolidaysResponse) Reset() {
+	*x = ImportHolidaysResponse{}
+	mi := &file_prs_v1_prs_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportHolidaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportHolidaysResponse) ProtoMessage() {}
+
+func (x *ImportHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prs_v1_prs_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*ImportHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_prs_v1_prs_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ImportHolidaysResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+var File_prs_v1_prs_proto protoreflect.FileDescriptor
+
+const file_prs_v1_prs_proto_rawDesc = "" +
+	"\n" +
+	"\x10prs/v1/prs.proto\x12\x06prs.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xad\x01\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1c\n" +
+	"\tseniority\x18\x05 \x01(\tR\tseniority\x12\x1b\n" +
+	"\tteam_name\x18\x06 \x01(\tR\bteamName\"\x87\x01\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12$\n" +
+	"\vparent_team\x18\x02 \x01(\tH\x00R\n" +
+	"parentTeam\x88\x01\x01\x12,\n" +
+	"\amembers\x18\x03 \x03(\v2\x12.prs.v1.TeamMemberR\amembersB\x0e\n" +
+	"\f_parent_team\"@\n" +
+	"\rFallbackEntry\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\"X\n" +
+	"\fTeamFallback\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12+\n" +
+	"\x05chain\x18\x02 \x03(\v2\x15.prs.v1.FallbackEntryR\x05chain\"\x8b\x01\n" +
+	"\bTeamNode\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12$\n" +
+	"\vparent_team\x18\x02 \x01(\tH\x00R\n" +
+	"parentTeam\x88\x01\x01\x12,\n" +
+	"\bchildren\x18\x03 \x03(\v2\x10.prs.v1.TeamNodeR\bchildrenB\x0e\n" +
+	"\f_parent_team\"U\n" +
+	"\rCodeOwnerRule\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\x12\x14\n" +
+	"\x05users\x18\x02 \x03(\tR\x05users\x12\x14\n" +
+	"\x05teams\x18\x03 \x03(\tR\x05teams\"V\n" +
+	"\n" +
+	"TeamOwners\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12+\n" +
+	"\x05rules\x18\x02 \x03(\v2\x15.prs.v1.CodeOwnerRuleR\x05rules\"\xce\x01\n" +
+	"\x0fTeamReviewRules\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12%\n" +
+	"\x0erequire_senior\x18\x02 \x01(\bR\rrequireSenior\x12#\n" +
+	"\rshadow_junior\x18\x03 \x01(\bR\fshadowJunior\x12'\n" +
+	"\x0frotation_window\x18\x04 \x01(\x05R\x0erotationWindow\x12)\n" +
+	"\x10rotation_penalty\x18\x05 \x01(\x01R\x0frotationPenalty\"\xa4\x01\n" +
+	"\aTeamSLA\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12,\n" +
+	"\x12first_review_hours\x18\x02 \x01(\x05R\x10firstReviewHours\x125\n" +
+	"\x14reassign_after_hours\x18\x03 \x01(\x05H\x00R\x12reassignAfterHours\x88\x01\x01B\x17\n" +
+	"\x15_reassign_after_hours\"\x82\x01\n" +
+	"\fWorkSchedule\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\x12\x1b\n" +
+	"\twork_days\x18\x02 \x03(\tR\bworkDays\x12\x1d\n" +
+	"\n" +
+	"work_start\x18\x03 \x01(\tR\tworkStart\x12\x19\n" +
+	"\bwork_end\x18\x04 \x01(\tR\aworkEnd\"]\n" +
+	"\fTeamSchedule\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x120\n" +
+	"\bschedule\x18\x02 \x01(\v2\x14.prs.v1.WorkScheduleR\bschedule\"Y\n" +
+	"\fUserSchedule\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x120\n" +
+	"\bschedule\x18\x02 \x01(\v2\x14.prs.v1.WorkScheduleR\bschedule\"l\n" +
+	"\aHoliday\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\trecurring\x18\x03 \x01(\bR\trecurring\x12\x1b\n" +
+	"\tteam_name\x18\x04 \x01(\tR\bteamName\"\xf5\x01\n" +
+	"\fAvailability\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x1d\n" +
+	"\n" +
+	"local_time\x18\x04 \x01(\tR\tlocalTime\x12\x1c\n" +
+	"\tavailable\x18\x05 \x01(\bR\tavailable\x12F\n" +
+	"\x11next_available_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0fnextAvailableAt\"w\n" +
+	"\bUserTeam\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x04 \x01(\bR\tisPrimary\"\xce\x01\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12 \n" +
+	"\tteam_name\x18\x03 \x01(\tH\x00R\bteamName\x88\x01\x01\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x1c\n" +
+	"\tseniority\x18\x05 \x01(\tR\tseniority\x12&\n" +
+	"\x05teams\x18\x06 \x03(\v2\x10.prs.v1.UserTeamR\x05teamsB\f\n" +
+	"\n" +
+	"_team_name\"=\n" +
+	"\n" +
+	"UserSkills\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06skills\x18\x02 \x03(\tR\x06skills\"?\n" +
+	"\tSlackUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bslack_id\x18\x02 \x01(\tR\aslackId\"\xd2\x01\n" +
+	"\x12DigestSubscription\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1c\n" +
+	"\tfrequency\x18\x03 \x01(\tR\tfrequency\x12\x17\n" +
+	"\asend_at\x18\x04 \x01(\tR\x06sendAt\x12\x18\n" +
+	"\aweekday\x18\x05 \x01(\tR\aweekday\x12<\n" +
+	"\flast_sent_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSentAt\"\x9b\x01\n" +
+	"\x10PullRequestShort\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"\xcf\x01\n" +
+	"\x10ReviewAssignment\x12\x1f\n" +
+	"\vreviewer_id\x18\x01 \x01(\tR\n" +
+	"reviewerId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12$\n" +
+	"\vsource_team\x18\x03 \x01(\tH\x00R\n" +
+	"sourceTeam\x88\x01\x01\x12$\n" +
+	"\vdecision_id\x18\x04 \x01(\x03H\x01R\n" +
+	"decisionId\x88\x01\x01\x12\x16\n" +
+	"\x06shadow\x18\x05 \x01(\bR\x06shadowB\x0e\n" +
+	"\f_source_teamB\x0e\n" +
+	"\f_decision_id\"\x91\x04\n" +
+	"\vPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x1b\n" +
+	"\tteam_name\x18\x04 \x01(\tR\bteamName\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x06 \x03(\tR\x11assignedReviewers\x12,\n" +
+	"\x0fshadow_reviewer\x18\a \x01(\tH\x00R\x0eshadowReviewer\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\x12#\n" +
+	"\rchanged_files\x18\n" +
+	" \x03(\tR\fchangedFiles\x12\x16\n" +
+	"\x06labels\x18\v \x03(\tR\x06labels\x12:\n" +
+	"\vassignments\x18\f \x03(\v2\x18.prs.v1.ReviewAssignmentR\vassignmentsB\x12\n" +
+	"\x10_shadow_reviewer\"\xfc\x02\n" +
+	"\x14CandidateExplanation\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12$\n" +
+	"\vsource_team\x18\x03 \x01(\tH\x00R\n" +
+	"sourceTeam\x88\x01\x01\x12\x1a\n" +
+	"\beligible\x18\x04 \x01(\bR\beligible\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1c\n" +
+	"\tseniority\x18\x06 \x01(\tR\tseniority\x12\x16\n" +
+	"\x06skills\x18\a \x03(\tR\x06skills\x12\x12\n" +
+	"\x04load\x18\b \x01(\x05R\x04load\x12\x16\n" +
+	"\x06recent\x18\t \x01(\x05R\x06recent\x12\x19\n" +
+	"\x05score\x18\n" +
+	" \x01(\x01H\x01R\x05score\x88\x01\x01\x12\x12\n" +
+	"\x04rank\x18\v \x01(\x05R\x04rank\x12\x16\n" +
+	"\x06picked\x18\f \x01(\bR\x06picked\x12\x16\n" +
+	"\x06shadow\x18\r \x01(\bR\x06shadowB\x0e\n" +
+	"\f_source_teamB\b\n" +
+	"\x06_score\"\x81\x04\n" +
+	"\x12AssignmentDecision\x12\x1f\n" +
+	"\vdecision_id\x18\x01 \x01(\x03R\n" +
+	"decisionId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04seed\x18\x03 \x01(\x04R\x04seed\x12+\n" +
+	"\x11algorithm_version\x18\x04 \x01(\x05R\x10algorithmVersion\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
+	"\x06labels\x18\x06 \x03(\tR\x06labels\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12%\n" +
+	"\x0erequire_senior\x18\b \x01(\bR\rrequireSenior\x12\x16\n" +
+	"\x06shadow\x18\t \x01(\bR\x06shadow\x12\x1a\n" +
+	"\brecorded\x18\n" +
+	" \x03(\tR\brecorded\x12\x16\n" +
+	"\x06picked\x18\v \x03(\tR\x06picked\x12\x1e\n" +
+	"\n" +
+	"reproduced\x18\f \x01(\bR\n" +
+	"reproduced\x12<\n" +
+	"\n" +
+	"candidates\x18\r \x03(\v2\x1c.prs.v1.CandidateExplanationR\n" +
+	"candidates\x12&\n" +
+	"\freplay_error\x18\x0e \x01(\tH\x00R\vreplayError\x88\x01\x01B\x0f\n" +
+	"\r_replay_error\"\xa8\x03\n" +
+	"\rOverdueReview\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x1b\n" +
+	"\tteam_name\x18\x04 \x01(\tR\bteamName\x12\x1f\n" +
+	"\vreviewer_id\x18\x05 \x01(\tR\n" +
+	"reviewerId\x12;\n" +
+	"\vassigned_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x121\n" +
+	"\x06due_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12;\n" +
+	"\vreassign_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reassignAt\x12;\n" +
+	"\vbreached_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"breachedAt\"\x85\x01\n" +
+	"\x0eLatencySummary\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x1d\n" +
+	"\n" +
+	"mean_hours\x18\x02 \x01(\x01R\tmeanHours\x12!\n" +
+	"\fmedian_hours\x18\x03 \x01(\x01R\vmedianHours\x12\x1b\n" +
+	"\tp90_hours\x18\x04 \x01(\x01R\bp90Hours\"\\\n" +
+	"\x0fReviewerLatency\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x120\n" +
+	"\alatency\x18\x02 \x01(\v2\x16.prs.v1.LatencySummaryR\alatency\"!\n" +
+	"\aPairRow\x12\x16\n" +
+	"\x06counts\x18\x01 \x03(\x05R\x06counts\"\xae\x02\n" +
+	"\x05Event\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12+\n" +
+	"\x0fpull_request_id\x18\x03 \x01(\tH\x00R\rpullRequestId\x88\x01\x01\x12\x1c\n" +
+	"\ateam_id\x18\x04 \x01(\tH\x01R\x06teamId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x05 \x01(\tH\x02R\x06userId\x88\x01\x01\x121\n" +
+	"\apayload\x18\x06 \x01(\v2\x17.google.protobuf.StructR\apayload\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x12\n" +
+	"\x10_pull_request_idB\n" +
+	"\n" +
+	"\b_team_idB\n" +
+	"\n" +
+	"\b_user_id\"2\n" +
+	"\x0eAddTeamRequest\x12 \n" +
+	"\x04team\x18\x01 \x01(\v2\f.prs.v1.TeamR\x04team\"3\n" +
+	"\x0fAddTeamResponse\x12 \n" +
+	"\x04team\x18\x01 \x01(\v2\f.prs.v1.TeamR\x04team\"^\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12/\n" +
+	"\x13include_descendants\x18\x02 \x01(\bR\x12includeDescendants\"3\n" +
+	"\x0fGetTeamResponse\x12 \n" +
+	"\x04team\x18\x01 \x01(\v2\f.prs.v1.TeamR\x04team\"5\n" +
+	"\x16GetTeamFallbackRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"K\n" +
+	"\x17GetTeamFallbackResponse\x120\n" +
+	"\bfallback\x18\x01 \x01(\v2\x14.prs.v1.TeamFallbackR\bfallback\"J\n" +
+	"\x16SetTeamFallbackRequest\x120\n" +
+	"\bfallback\x18\x01 \x01(\v2\x14.prs.v1.TeamFallbackR\bfallback\"K\n" +
+	"\x17SetTeamFallbackResponse\x120\n" +
+	"\bfallback\x18\x01 \x01(\v2\x14.prs.v1.TeamFallbackR\bfallback\"4\n" +
+	"\x15GetTeamSubtreeRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"D\n" +
+	"\x16GetTeamSubtreeResponse\x12*\n" +
+	"\asubtree\x18\x01 \x01(\v2\x10.prs.v1.TeamNodeR\asubtree\"i\n" +
+	"\x14SetTeamParentRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12$\n" +
+	"\vparent_team\x18\x02 \x01(\tH\x00R\n" +
+	"parentTeam\x88\x01\x01B\x0e\n" +
+	"\f_parent_team\"9\n" +
+	"\x15SetTeamParentResponse\x12 \n" +
+	"\x04team\x18\x01 \x01(\v2\f.prs.v1.TeamR\x04team\"3\n" +
+	"\x14GetTeamOwnersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"C\n" +
+	"\x15GetTeamOwnersResponse\x12*\n" +
+	"\x06owners\x18\x01 \x01(\v2\x12.prs.v1.TeamOwnersR\x06owners\"B\n" +
+	"\x14SetTeamOwnersRequest\x12*\n" +
+	"\x06owners\x18\x01 \x01(\v2\x12.prs.v1.TeamOwnersR\x06owners\"C\n" +
+	"\x15SetTeamOwnersResponse\x12*\n" +
+	"\x06owners\x18\x01 \x01(\v2\x12.prs.v1.TeamOwnersR\x06owners\"6\n" +
+	"\x17DeleteTeamOwnersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"\x1a\n" +
+	"\x18DeleteTeamOwnersResponse\"8\n" +
+	"\x19GetTeamReviewRulesRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"K\n" +
+	"\x1aGetTeamReviewRulesResponse\x12-\n" +
+	"\x05rules\x18\x01 \x01(\v2\x17.prs.v1.TeamReviewRulesR\x05rules\"J\n" +
+	"\x19SetTeamReviewRulesRequest\x12-\n" +
+	"\x05rules\x18\x01 \x01(\v2\x17.prs.v1.TeamReviewRulesR\x05rules\"K\n" +
+	"\x1aSetTeamReviewRulesResponse\x12-\n" +
+	"\x05rules\x18\x01 \x01(\v2\x17.prs.v1.TeamReviewRulesR\x05rules\"0\n" +
+	"\x11GetTeamSLARequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"7\n" +
+	"\x12GetTeamSLAResponse\x12!\n" +
+	"\x03sla\x18\x01 \x01(\v2\x0f.prs.v1.TeamSLAR\x03sla\"6\n" +
+	"\x11SetTeamSLARequest\x12!\n" +
+	"\x03sla\x18\x01 \x01(\v2\x0f.prs.v1.TeamSLAR\x03sla\"7\n" +
+	"\x12SetTeamSLAResponse\x12!\n" +
+	"\x03sla\x18\x01 \x01(\v2\x0f.prs.v1.TeamSLAR\x03sla\"5\n" +
+	"\x16GetTeamScheduleRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"K\n" +
+	"\x17GetTeamScheduleResponse\x120\n" +
+	"\bschedule\x18\x01 \x01(\v2\x14.prs.v1.TeamScheduleR\bschedule\"J\n" +
+	"\x16SetTeamScheduleRequest\x120\n" +
+	"\bschedule\x18\x01 \x01(\v2\x14.prs.v1.TeamScheduleR\bschedule\"K\n" +
+	"\x17SetTeamScheduleResponse\x120\n" +
+	"\bschedule\x18\x01 \x01(\v2\x14.prs.v1.TeamScheduleR\bschedule\"g\n" +
+	"\x12SetIsActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\"7\n" +
+	"\x13SetIsActiveResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.prs.v1.UserR\x04user\"L\n" +
+	"\x13SetSeniorityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tseniority\x18\x02 \x01(\tR\tseniority\"8\n" +
+	"\x14SetSeniorityResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.prs.v1.UserR\x04user\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"3\n" +
+	"\x0fGetUserResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.prs.v1.UserR\x04user\"+\n" +
+	"\x10GetReviewRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"k\n" +
+	"\x11GetReviewResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12=\n" +
+	"\rpull_requests\x18\x02 \x03(\v2\x18.prs.v1.PullRequestShortR\fpullRequests\"+\n" +
+	"\x10GetSkillsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"?\n" +
+	"\x11GetSkillsResponse\x12*\n" +
+	"\x06skills\x18\x01 \x01(\v2\x12.prs.v1.UserSkillsR\x06skills\">\n" +
+	"\x10SetSkillsRequest\x12*\n" +
+	"\x06skills\x18\x01 \x01(\v2\x12.prs.v1.UserSkillsR\x06skills\"?\n" +
+	"\x11SetSkillsResponse\x12*\n" +
+	"\x06skills\x18\x01 \x01(\v2\x12.prs.v1.UserSkillsR\x06skills\"1\n" +
+	"\x16GetUserScheduleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"K\n" +
+	"\x17GetUserScheduleResponse\x120\n" +
+	"\bschedule\x18\x01 \x01(\v2\x14.prs.v1.UserScheduleR\bschedule\"J\n" +
+	"\x16SetUserScheduleRequest\x120\n" +
+	"\bschedule\x18\x01 \x01(\v2\x14.prs.v1.UserScheduleR\bschedule\"K\n" +
+	"\x17SetUserScheduleResponse\x120\n" +
+	"\bschedule\x18\x01 \x01(\v2\x14.prs.v1.UserScheduleR\bschedule\"]\n" +
+	"\x16GetAvailabilityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"S\n" +
+	"\x17GetAvailabilityResponse\x128\n" +
+	"\favailability\x18\x01 \x01(\v2\x14.prs.v1.AvailabilityR\favailability\".\n" +
+	"\x13GetSlackUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"?\n" +
+	"\x14GetSlackUserResponse\x12'\n" +
+	"\x05slack\x18\x01 \x01(\v2\x11.prs.v1.SlackUserR\x05slack\">\n" +
+	"\x13SetSlackUserRequest\x12'\n" +
+	"\x05slack\x18\x01 \x01(\v2\x11.prs.v1.SlackUserR\x05slack\"?\n" +
+	"\x14SetSlackUserResponse\x12'\n" +
+	"\x05slack\x18\x01 \x01(\v2\x11.prs.v1.SlackUserR\x05slack\"+\n" +
+	"\x10GetDigestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"G\n" +
+	"\x11GetDigestResponse\x122\n" +
+	"\x06digest\x18\x01 \x01(\v2\x1a.prs.v1.DigestSubscriptionR\x06digest\"F\n" +
+	"\x10SetDigestRequest\x122\n" +
+	"\x06digest\x18\x01 \x01(\v2\x1a.prs.v1.DigestSubscriptionR\x06digest\"G\n" +
+	"\x11SetDigestResponse\x122\n" +
+	"\x06digest\x18\x01 \x01(\v2\x1a.prs.v1.DigestSubscriptionR\x06digest\"\xe5\x01\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x1b\n" +
+	"\tteam_name\x18\x04 \x01(\tR\bteamName\x12#\n" +
+	"\rchanged_files\x18\x05 \x03(\tR\fchangedFiles\x12\x16\n" +
+	"\x06labels\x18\x06 \x03(\tR\x06labels\"@\n" +
+	"\x19CreatePullRequestResponse\x12#\n" +
+	"\x02pr\x18\x01 \x01(\v2\x13.prs.v1.PullRequestR\x02pr\"A\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"?\n" +
+	"\x18MergePullRequestResponse\x12#\n" +
+	"\x02pr\x18\x01 \x01(\v2\x13.prs.v1.PullRequestR\x02pr\"a\n" +
+	"\x17ReassignReviewerRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\"`\n" +
+	"\x18ReassignReviewerResponse\x12#\n" +
+	"\x02pr\x18\x01 \x01(\v2\x13.prs.v1.PullRequestR\x02pr\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\"3\n" +
+	"\x14FillReviewersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"Q\n" +
+	"\x15FillReviewersResponse\x128\n" +
+	"\rpull_requests\x18\x01 \x03(\v2\x13.prs.v1.PullRequestR\fpullRequests\"x\n" +
+	"\x18ExplainAssignmentRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12$\n" +
+	"\vdecision_id\x18\x02 \x01(\x03H\x00R\n" +
+	"decisionId\x88\x01\x01B\x0e\n" +
+	"\f_decision_id\"}\n" +
+	"\x19ExplainAssignmentResponse\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x128\n" +
+	"\tdecisions\x18\x02 \x03(\v2\x1a.prs.v1.AssignmentDecisionR\tdecisions\"a\n" +
+	"\x11GetOverdueRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12/\n" +
+	"\x13include_descendants\x18\x02 \x01(\bR\x12includeDescendants\"E\n" +
+	"\x12GetOverdueResponse\x12/\n" +
+	"\aoverdue\x18\x01 \x03(\v2\x15.prs.v1.OverdueReviewR\aoverdue\"?\n" +
+	"\x15GetPullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"=\n" +
+	"\x16GetPullRequestResponse\x12#\n" +
+	"\x02pr\x18\x01 \x01(\v2\x13.prs.v1.PullRequestR\x02pr\"\xc1\x03\n" +
+	"\x17ListPullRequestsRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12/\n" +
+	"\x13include_descendants\x18\x02 \x01(\bR\x12includeDescendants\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vreviewer_id\x18\x05 \x01(\tR\n" +
+	"reviewerId\x12=\n" +
+	"\fcreated_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x123\n" +
+	"\x13need_more_reviewers\x18\b \x01(\bH\x00R\x11needMoreReviewers\x88\x01\x01\x12\x16\n" +
+	"\x06cursor\x18\t \x01(\tR\x06cursor\x12\x19\n" +
+	"\x05limit\x18\n" +
+	" \x01(\x05H\x01R\x05limit\x88\x01\x01B\x16\n" +
+	"\x14_need_more_reviewersB\b\n" +
+	"\x06_limit\"u\n" +
+	"\x18ListPullRequestsResponse\x128\n" +
+	"\rpull_requests\x18\x01 \x03(\v2\x13.prs.v1.PullRequestR\fpullRequests\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"_\n" +
+	"\x0fGetStatsRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12/\n" +
+	"\x13include_descendants\x18\x02 \x01(\bR\x12includeDescendants\"\x89\x02\n" +
+	"\x10GetStatsResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x19\n" +
+	"\btotal_pr\x18\x02 \x01(\x05R\atotalPr\x12\x17\n" +
+	"\aopen_pr\x18\x03 \x01(\x05R\x06openPr\x12^\n" +
+	"\x12review_assignments\x18\x04 \x03(\v2/.prs.v1.GetStatsResponse.ReviewAssignmentsEntryR\x11reviewAssignments\x1aD\n" +
+	"\x16ReviewAssignmentsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"c\n" +
+	"\x13GetPairStatsRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12/\n" +
+	"\x13include_descendants\x18\x02 \x01(\bR\x12includeDescendants\"\x94\x01\n" +
+	"\x14GetPairStatsResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x18\n" +
+	"\aauthors\x18\x02 \x03(\tR\aauthors\x12\x1c\n" +
+	"\treviewers\x18\x03 \x03(\tR\treviewers\x12'\n" +
+	"\x06matrix\x18\x04 \x03(\v2\x0f.prs.v1.PairRowR\x06matrix\"f\n" +
+	"\x16GetLatencyStatsRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12/\n" +
+	"\x13include_descendants\x18\x02 \x01(\bR\x12includeDescendants\"\x9b\x01\n" +
+	"\x17GetLatencyStatsResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12,\n" +
+	"\x05merge\x18\x02 \x01(\v2\x16.prs.v1.LatencySummaryR\x05merge\x125\n" +
+	"\treviewers\x18\x03 \x03(\v2\x17.prs.v1.ReviewerLatencyR\treviewers\"\xcd\x01\n" +
+	"\x13StreamEventsRequest\x12'\n" +
+	"\rlast_event_id\x18\x01 \x01(\x03H\x00R\vlastEventId\x88\x01\x01\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\x12/\n" +
+	"\x13include_descendants\x18\x03 \x01(\bR\x12includeDescendants\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05types\x18\x05 \x03(\tR\x05typesB\x10\n" +
+	"\x0e_last_event_id\";\n" +
+	"\x14StreamEventsResponse\x12#\n" +
+	"\x05event\x18\x01 \x01(\v2\r.prs.v1.EventR\x05event\"1\n" +
+	"\x12GetHolidaysRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"B\n" +
+	"\x13GetHolidaysResponse\x12+\n" +
+	"\bholidays\x18\x01 \x03(\v2\x0f.prs.v1.HolidayR\bholidays\"`\n" +
+	"\x15ImportHolidaysRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x18\n" +
+	"\areplace\x18\x02 \x01(\bR\areplace\x12\x10\n" +
+	"\x03ics\x18\x03 \x01(\fR\x03ics\"4\n" +
+	"\x16ImportHolidaysResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported2\xb6\x19\n" +
+	"\n" +
+	"PRSService\x12:\n" +
+	"\aAddTeam\x12\x16.prs.v1.AddTeamRequest\x1a\x17.prs.v1.AddTeamResponse\x12:\n" +
+	"\aGetTeam\x12\x16.prs.v1.GetTeamRequest\x1a\x17.prs.v1.GetTeamResponse\x12R\n" +
+	"\x0fGetTeamFallback\x12\x1e.prs.v1.GetTeamFallbackRequest\x1a\x1f.prs.v1.GetTeamFallbackResponse\x12R\n" +
+	"\x0fSetTeamFallback\x12\x1e.prs.v1.SetTeamFallbackRequest\x1a\x1f.prs.v1.SetTeamFallbackResponse\x12O\n" +
+	"\x0eGetTeamSubtree\x12\x1d.prs.v1.GetTeamSubtreeRequest\x1a\x1e.prs.v1.GetTeamSubtreeResponse\x12L\n" +
+	"\rSetTeamParent\x12\x1c.prs.v1.SetTeamParentRequest\x1a\x1d.prs.v1.SetTeamParentResponse\x12L\n" +
+	"\rGetTeamOwners\x12\x1c.prs.v1.GetTeamOwnersRequest\x1a\x1d.prs.v1.GetTeamOwnersResponse\x12L\n" +
+	"\rSetTeamOwners\x12\x1c.prs.v1.SetTeamOwnersRequest\x1a\x1d.prs.v1.SetTeamOwnersResponse\x12U\n" +
+	"\x10DeleteTeamOwners\x12\x1f.prs.v1.DeleteTeamOwnersRequest\x1a .prs.v1.DeleteTeamOwnersResponse\x12[\n" +
+	"\x12GetTeamReviewRules\x12!.prs.v1.GetTeamReviewRulesRequest\x1a\".prs.v1.GetTeamReviewRulesResponse\x12[\n" +
+	"\x12SetTeamReviewRules\x12!.prs.v1.SetTeamReviewRulesRequest\x1a\".prs.v1.SetTeamReviewRulesResponse\x12C\n" +
+	"\n" +
+	"GetTeamSLA\x12\x19.prs.v1.GetTeamSLARequest\x1a\x1a.prs.v1.GetTeamSLAResponse\x12C\n" +
+	"\n" +
+	"SetTeamSLA\x12\x19.prs.v1.SetTeamSLARequest\x1a\x1a.prs.v1.SetTeamSLAResponse\x12R\n" +
+	"\x0fGetTeamSchedule\x12\x1e.prs.v1.GetTeamScheduleRequest\x1a\x1f.prs.v1.GetTeamScheduleResponse\x12R\n" +
+	"\x0fSetTeamSchedule\x12\x1e.prs.v1.SetTeamScheduleRequest\x1a\x1f.prs.v1.SetTeamScheduleResponse\x12F\n" +
+	"\vSetIsActive\x12\x1a.prs.v1.SetIsActiveRequest\x1a\x1b.prs.v1.SetIsActiveResponse\x12I\n" +
+	"\fSetSeniority\x12\x1b.prs.v1.SetSeniorityRequest\x1a\x1c.prs.v1.SetSeniorityResponse\x12:\n" +
+	"\aGetUser\x12\x16.prs.v1.GetUserRequest\x1a\x17.prs.v1.GetUserResponse\x12@\n" +
+	"\tGetReview\x12\x18.prs.v1.GetReviewRequest\x1a\x19.prs.v1.GetReviewResponse\x12@\n" +
+	"\tGetSkills\x12\x18.prs.v1.GetSkillsRequest\x1a\x19.prs.v1.GetSkillsResponse\x12@\n" +
+	"\tSetSkills\x12\x18.prs.v1.SetSkillsRequest\x1a\x19.prs.v1.SetSkillsResponse\x12R\n" +
+	"\x0fGetUserSchedule\x12\x1e.prs.v1.GetUserScheduleRequest\x1a\x1f.prs.v1.GetUserScheduleResponse\x12R\n" +
+	"\x0fSetUserSchedule\x12\x1e.prs.v1.SetUserScheduleRequest\x1a\x1f.prs.v1.SetUserScheduleResponse\x12R\n" +
+	"\x0fGetAvailability\x12\x1e.prs.v1.GetAvailabilityRequest\x1a\x1f.prs.v1.GetAvailabilityResponse\x12I\n" +
+	"\fGetSlackUser\x12\x1b.prs.v1.GetSlackUserRequest\x1a\x1c.prs.v1.GetSlackUserResponse\x12I\n" +
+	"\fSetSlackUser\x12\x1b.prs.v1.SetSlackUserRequest\x1a\x1c.prs.v1.SetSlackUserResponse\x12@\n" +
+	"\tGetDigest\x12\x18.prs.v1.GetDigestRequest\x1a\x19.prs.v1.GetDigestResponse\x12@\n" +
+	"\tSetDigest\x12\x18.prs.v1.SetDigestRequest\x1a\x19.prs.v1.SetDigestResponse\x12X\n" +
+	"\x11CreatePullRequest\x12 .prs.v1.CreatePullRequestRequest\x1a!.prs.v1.CreatePullRequestResponse\x12U\n" +
+	"\x10MergePullRequest\x12\x1f.prs.v1.MergePullRequestRequest\x1a .prs.v1.MergePullRequestResponse\x12U\n" +
+	"\x10ReassignReviewer\x12\x1f.prs.v1.ReassignReviewerRequest\x1a .prs.v1.ReassignReviewerResponse\x12L\n" +
+	"\rFillReviewers\x12\x1c.prs.v1.FillReviewersRequest\x1a\x1d.prs.v1.FillReviewersResponse\x12X\n" +
+	"\x11ExplainAssignment\x12 .prs.v1.ExplainAssignmentRequest\x1a!.prs.v1.ExplainAssignmentResponse\x12C\n" +
+	"\n" +
+	"GetOverdue\x12\x19.prs.v1.GetOverdueRequest\x1a\x1a.prs.v1.GetOverdueResponse\x12O\n" +
+	"\x0eGetPullRequest\x12\x1d.prs.v1.GetPullRequestRequest\x1a\x1e.prs.v1.GetPullRequestResponse\x12U\n" +
+	"\x10ListPullRequests\x12\x1f.prs.v1.ListPullRequestsRequest\x1a .prs.v1.ListPullRequestsResponse\x12=\n" +
+	"\bGetStats\x12\x17.prs.v1.GetStatsRequest\x1a\x18.prs.v1.GetStatsResponse\x12I\n" +
+	"\fGetPairStats\x12\x1b.prs.v1.GetPairStatsRequest\x1a\x1c.prs.v1.GetPairStatsResponse\x12R\n" +
+	"\x0fGetLatencyStats\x12\x1e.prs.v1.GetLatencyStatsRequest\x1a\x1f.prs.v1.GetLatencyStatsResponse\x12K\n" +
+	"\fStreamEvents\x12\x1b.prs.v1.StreamEventsRequest\x1a\x1c.prs.v1.StreamEventsResponse0\x01\x12F\n" +
+	"\vGetHolidays\x12\x1a.prs.v1.GetHolidaysRequest\x1a\x1b.prs.v1.GetHolidaysResponse\x12O\n" +
+	"\x0eImportHolidays\x12\x1d.prs.v1.ImportHolidaysRequest\x1a\x1e.prs.v1.ImportHolidaysResponseB0Z.github.com/F3dosik/PRS.git/pkg/pb/prs/v1;prsv1b\x06proto3"
+
+var (
+	file_prs_v1_prs_proto_rawDescOnce sync.Once
+	file_prs_v1_prs_proto_rawDescData []byte
+)
+
+func file_prs_v1_prs_proto_rawDescGZIP() []byte {
+	file_prs_v1_prs_proto_rawDescOnce.Do(func() {
+		file_prs_v1_prs_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_prs_v1_prs_proto_rawDesc), len(file_prs_v1_prs_proto_rawDesc)))
+	})
+	return file_prs_v1_prs_proto_rawDescData
+}
+
+var file_prs_v1_prs_proto_msgTypes = make([]protoimpl.MessageInfo, 114)
+var file_prs_v1_prs_proto_goTypes = []any{
+	(*TeamMember)(nil),                 // 0: prs.v1.TeamMember
+	(*Team)(nil),                       // 1: prs.v1.Team
+	(*FallbackEntry)(nil),              // 2: prs.v1.FallbackEntry
+	(*TeamFallback)(nil),               // 3: prs.v1.TeamFallback
+	(*TeamNode)(nil),                   // 4: prs.v1.TeamNode
+	(*CodeOwnerRule)(nil),              // 5: prs.v1.CodeOwnerRule
+	(*TeamOwners)(nil),                 // 6: prs.v1.TeamOwners
+	(*TeamReviewRules)(nil),            // 7: prs.v1.TeamReviewRules
+	(*TeamSLA)(nil),                    // 8: prs.v1.TeamSLA
+	(*WorkSchedule)(nil),               // 9: prs.v1.WorkSchedule
+	(*TeamSchedule)(nil),               // 10: prs.v1.TeamSchedule
+	(*UserSchedule)(nil),               // 11: prs.v1.UserSchedule
+	(*Holiday)(nil),                    // 12: prs.v1.Holiday
+	(*Availability)(nil),               // 13: prs.v1.Availability
+	(*UserTeam)(nil),                   // 14: prs.v1.UserTeam
+	(*User)(nil),                       // 15: prs.v1.User
+	(*UserSkills)(nil),                 // 16: prs.v1.UserSkills
+	(*SlackUser)(nil),                  // 17: prs.v1.SlackUser
+	(*DigestSubscription)(nil),         // 18: prs.v1.DigestSubscription
+	(*PullRequestShort)(nil),           // 19: prs.v1.PullRequestShort
+	(*ReviewAssignment)(nil),           // 20: prs.v1.ReviewAssignment
+	(*PullRequest)(nil),                // 21: prs.v1.PullRequest
+	(*CandidateExplanation)(nil),       // 22: prs.v1.CandidateExplanation
+	(*AssignmentDecision)(nil),         // 23: prs.v1.AssignmentDecision
+	(*OverdueReview)(nil),              // 24: prs.v1.OverdueReview
+	(*LatencySummary)(nil),             // 25: prs.v1.LatencySummary
+	(*ReviewerLatency)(nil),            // 26: prs.v1.ReviewerLatency
+	(*PairRow)(nil),                    // 27: prs.v1.PairRow
+	(*Event)(nil),                      // 28: prs.v1.Event
+	(*AddTeamRequest)(nil),             // 29: prs.v1.AddTeamRequest
+	(*AddTeamResponse)(nil),            // 30: prs.v1.AddTeamResponse
+	(*GetTeamRequest)(nil),             // 31: prs.v1.GetTeamRequest
+	(*GetTeamResponse)(nil),            // 32: prs.v1.GetTeamResponse
+	(*GetTeamFallbackRequest)(nil),     // 33: prs.v1.GetTeamFallbackRequest
+	(*GetTeamFallbackResponse)(nil),    // 34: prs.v1.GetTeamFallbackResponse
+	(*SetTeamFallbackRequest)(nil),     // 35: prs.v1.SetTeamFallbackRequest
+	(*SetTeamFallbackResponse)(nil),    // 36: prs.v1.SetTeamFallbackResponse
+	(*GetTeamSubtreeRequest)(nil),      // 37: prs.v1.GetTeamSubtreeRequest
+	(*GetTeamSubtreeResponse)(nil),     // 38: prs.v1.GetTeamSubtreeResponse
+	(*SetTeamParentRequest)(nil),       // 39: prs.v1.SetTeamParentRequest
+	(*SetTeamParentResponse)(nil),      // 40: prs.v1.SetTeamParentResponse
+	(*GetTeamOwnersRequest)(nil),       // 41: prs.v1.GetTeamOwnersRequest
+	(*GetTeamOwnersResponse)(nil),      // 42: prs.v1.GetTeamOwnersResponse
+	(*SetTeamOwnersRequest)(nil),       // 43: prs.v1.SetTeamOwnersRequest
+	(*SetTeamOwnersResponse)(nil),      // 44: prs.v1.SetTeamOwnersResponse
+	(*DeleteTeamOwnersRequest)(nil),    // 45: prs.v1.DeleteTeamOwnersRequest
+	(*DeleteTeamOwnersResponse)(nil),   // 46: prs.v1.DeleteTeamOwnersResponse
+	(*GetTeamReviewRulesRequest)(nil),  // 47: prs.v1.GetTeamReviewRulesRequest
+	(*GetTeamReviewRulesResponse)(nil), // 48: prs.v1.GetTeamReviewRulesResponse
+	(*SetTeamReviewRulesRequest)(nil),  // 49: prs.v1.SetTeamReviewRulesRequest
+	(*SetTeamReviewRulesResponse)(nil), // 50: prs.v1.SetTeamReviewRulesResponse
+	(*GetTeamSLARequest)(nil),          // 51: prs.v1.GetTeamSLARequest
+	(*GetTeamSLAResponse)(nil),         // 52: prs.v1.GetTeamSLAResponse
+	(*SetTeamSLARequest)(nil),          // 53: prs.v1.SetTeamSLARequest
+	(*SetTeamSLAResponse)(nil),         // 54: prs.v1.SetTeamSLAResponse
+	(*GetTeamScheduleRequest)(nil),     // 55: prs.v1.GetTeamScheduleRequest
+	(*GetTeamScheduleResponse)(nil),    // 56: prs.v1.GetTeamScheduleResponse
+	(*SetTeamScheduleRequest)(nil),     // 57: prs.v1.SetTeamScheduleRequest
+	(*SetTeamScheduleResponse)(nil),    // 58: prs.v1.SetTeamScheduleResponse
+	(*SetIsActiveRequest)(nil),         // 59: prs.v1.SetIsActiveRequest
+	(*SetIsActiveResponse)(nil),        // 60: prs.v1.SetIsActiveResponse
+	(*SetSeniorityRequest)(nil),        // 61: prs.v1.SetSeniorityRequest
+	(*SetSeniorityResponse)(nil),       // 62: prs.v1.SetSeniorityResponse
+	(*GetUserRequest)(nil),             // 63: prs.v1.GetUserRequest
+	(*GetUserResponse)(nil),            // 64: prs.v1.GetUserResponse
+	(*GetReviewRequest)(nil),           // 65: prs.v1.GetReviewRequest
+	(*GetReviewResponse)(nil),          // 66: prs.v1.GetReviewResponse
+	(*GetSkillsRequest)(nil),           // 67: prs.v1.GetSkillsRequest
+	(*GetSkillsResponse)(nil),          // 68: prs.v1.GetSkillsResponse
+	(*SetSkillsRequest)(nil),           // 69: prs.v1.SetSkillsRequest
+	(*SetSkillsResponse)(nil),          // 70: prs.v1.SetSkillsResponse
+	(*GetUserScheduleRequest)(nil),     // 71: prs.v1.GetUserScheduleRequest
+	(*GetUserScheduleResponse)(nil),    // 72: prs.v1.GetUserScheduleResponse
+	(*SetUserScheduleRequest)(nil),     // 73: prs.v1.SetUserScheduleRequest
+	(*SetUserScheduleResponse)(nil),    // 74: prs.v1.SetUserScheduleResponse
+	(*GetAvailabilityRequest)(nil),     // 75: prs.v1.GetAvailabilityRequest
+	(*GetAvailabilityResponse)(nil),    // 76: prs.v1.GetAvailabilityResponse
+	(*GetSlackUserRequest)(nil),        // 77: prs.v1.GetSlackUserRequest
+	(*GetSlackUserResponse)(nil),       // 78: prs.v1.GetSlackUserResponse
+	(*SetSlackUserRequest)(nil),        // 79: prs.v1.SetSlackUserRequest
+	(*SetSlackUserResponse)(nil),       // 80: prs.v1.SetSlackUserResponse
+	(*GetDigestRequest)(nil),           // 81: prs.v1.GetDigestRequest
+	(*GetDigestResponse)(nil),          // 82: prs.v1.GetDigestResponse
+	(*SetDigestRequest)(nil),           // 83: prs.v1.SetDigestRequest
+	(*SetDigestResponse)(nil),          // 84: prs.v1.SetDigestResponse
+	(*CreatePullRequestRequest)(nil),   // 85: prs.v1.CreatePullRequestRequest
+	(*CreatePullRequestResponse)(nil),  // 86: prs.v1.CreatePullRequestResponse
+	(*MergePullRequestRequest)(nil),    // 87: prs.v1.MergePullRequestRequest
+	(*MergePullRequestResponse)(nil),   // 88: prs.v1.MergePullRequestResponse
+	(*ReassignReviewerRequest)(nil),    // 89: prs.v1.ReassignReviewerRequest
+	(*ReassignReviewerResponse)(nil),   // 90: prs.v1.ReassignReviewerResponse
+	(*FillReviewersRequest)(nil),       // 91: prs.v1.FillReviewersRequest
+	(*FillReviewersResponse)(nil),      // 92: prs.v1.FillReviewersResponse
+	(*ExplainAssignmentRequest)(nil),   // 93: prs.v1.ExplainAssignmentRequest
+	(*ExplainAssignmentResponse)(nil),  // 94: prs.v1.ExplainAssignmentResponse
+	(*GetOverdueRequest)(nil),          // 95: prs.v1.GetOverdueRequest
+	(*GetOverdueResponse)(nil),         // 96: prs.v1.GetOverdueResponse
+	(*GetPullRequestRequest)(nil),      // 97: prs.v1.GetPullRequestRequest
+	(*GetPullRequestResponse)(nil),     // 98: prs.v1.GetPullRequestResponse
+	(*ListPullRequestsRequest)(nil),    // 99: prs.v1.ListPullRequestsRequest
+	(*ListPullRequestsResponse)(nil),   // 100: prs.v1.ListPullRequestsResponse
+	(*GetStatsRequest)(nil),            // 101: prs.v1.GetStatsRequest
+	(*GetStatsResponse)(nil),           // 102: prs.v1.GetStatsResponse
+	(*GetPairStatsRequest)(nil),        // 103: prs.v1.GetPairStatsRequest
+	(*GetPairStatsResponse)(nil),       // 104: prs.v1.GetPairStatsResponse
+	(*GetLatencyStatsRequest)(nil),     // 105: prs.v1.GetLatencyStatsRequest
+	(*GetLatencyStatsResponse)(nil),    // 106: prs.v1.GetLatencyStatsResponse
+	(*StreamEventsRequest)(nil),        // 107: prs.v1.StreamEventsRequest
+	(*StreamEventsResponse)(nil),       // 108: prs.v1.StreamEventsResponse
+	(*GetHolidaysRequest)(nil),         // 109: prs.v1.GetHolidaysRequest
+	(*GetHolidaysResponse)(nil),        // 110: prs.v1.GetHolidaysResponse
+	(*ImportHolidaysRequest)(nil),      // 111: prs.v1.ImportHolidaysRequest
+	(*ImportHolidaysResponse)(nil),     // 112: prs.v1.ImportHolidaysResponse
+	nil,                                // 113: prs.v1.GetStatsResponse.ReviewAssignmentsEntry
+	(*timestamppb.Timestamp)(nil),      // 114: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),            // 115: google.protobuf.Struct
+}
+var file_prs_v1_prs_proto_depIdxs = []int32{
+	0,   // 0: prs.v1.Team.members:type_name -> prs.v1.TeamMember
+	2,   // 1: prs.v1.TeamFallback.chain:type_name -> prs.v1.FallbackEntry
+	4,   // 2: prs.v1.TeamNode.children:type_name -> prs.v1.TeamNode
+	5,   // 3: prs.v1.TeamOwners.rules:type_name -> prs.v1.CodeOwnerRule
+	9,   // 4: prs.v1.TeamSchedule.schedule:type_name -> prs.v1.WorkSchedule
+	9,   // 5: prs.v1.UserSchedule.schedule:type_name -> prs.v1.WorkSchedule
+	114, // 6: prs.v1.Availability.at:type_name -> google.protobuf.Timestamp
+	114, // 7: prs.v1.Availability.next_available_at:type_name -> google.protobuf.Timestamp
+	14,  // 8: prs.v1.User.teams:type_name -> prs.v1.UserTeam
+	114, // 9: prs.v1.DigestSubscription.last_sent_at:type_name -> google.protobuf.Timestamp
+	114, // 10: prs.v1.PullRequest.created_at:type_name -> google.protobuf.Timestamp
+	114, // 11: prs.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	20,  // 12: prs.v1.PullRequest.assignments:type_name -> prs.v1.ReviewAssignment
+	114, // 13: prs.v1.AssignmentDecision.created_at:type_name -> google.protobuf.Timestamp
+	22,  // 14: prs.v1.AssignmentDecision.candidates:type_name -> prs.v1.CandidateExplanation
+	114, // 15: prs.v1.OverdueReview.assigned_at:type_name -> google.protobuf.Timestamp
+	114, // 16: prs.v1.OverdueReview.due_at:type_name -> google.protobuf.Timestamp
+	114, // 17: prs.v1.OverdueReview.reassign_at:type_name -> google.protobuf.Timestamp
+	114, // 18: prs.v1.OverdueReview.breached_at:type_name -> google.protobuf.Timestamp
+	25,  // 19: prs.v1.ReviewerLatency.latency:type_name -> prs.v1.LatencySummary
+	115, // 20: prs.v1.Event.payload:type_name -> google.protobuf.Struct
+	114, // 21: prs.v1.Event.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 22: prs.v1.AddTeamRequest.team:type_name -> prs.v1.Team
+	1,   // 23: prs.v1.AddTeamResponse.team:type_name -> prs.v1.Team
+	1,   // 24: prs.v1.GetTeamResponse.team:type_name -> prs.v1.Team
+	3,   // 25: prs.v1.GetTeamFallbackResponse.fallback:type_name -> prs.v1.TeamFallback
+	3,   // 26: prs.v1.SetTeamFallbackRequest.fallback:type_name -> prs.v1.TeamFallback
+	3,   // 27: prs.v1.SetTeamFallbackResponse.fallback:type_name -> prs.v1.TeamFallback
+	4,   // 28: prs.v1.GetTeamSubtreeResponse.subtree:type_name -> prs.v1.TeamNode
+	1,   // 29: prs.v1.SetTeamParentResponse.team:type_name -> prs.v1.Team
+	6,   // 30: prs.v1.GetTeamOwnersResponse.owners:type_name -> prs.v1.TeamOwners
+	6,   // 31: prs.v1.SetTeamOwnersRequest.owners:type_name -> prs.v1.TeamOwners
+	6,   // 32: prs.v1.SetTeamOwnersResponse.owners:type_name -> prs.v1.TeamOwners
+	7,   // 33: prs.v1.GetTeamReviewRulesResponse.rules:type_name -> prs.v1.TeamReviewRules
+	7,   // 34: prs.v1.SetTeamReviewRulesRequest.rules:type_name -> prs.v1.TeamReviewRules
+	7,   // 35: prs.v1.SetTeamReviewRulesResponse.rules:type_name -> prs.v1.TeamReviewRules
+	8,   // 36: prs.v1.GetTeamSLAResponse.sla:type_name -> prs.v1.TeamSLA
+	8,   // 37: prs.v1.SetTeamSLARequest.sla:type_name -> prs.v1.TeamSLA
+	8,   // 38: prs.v1.SetTeamSLAResponse.sla:type_name -> prs.v1.TeamSLA
+	10,  // 39: prs.v1.GetTeamScheduleResponse.schedule:type_name -> prs.v1.TeamSchedule
+	10,  // 40: prs.v1.SetTeamScheduleRequest.schedule:type_name -> prs.v1.TeamSchedule
+	10,  // 41: prs.v1.SetTeamScheduleResponse.schedule:type_name -> prs.v1.TeamSchedule
+	15,  // 42: prs.v1.SetIsActiveResponse.user:type_name -> prs.v1.User
+	15,  // 43: prs.v1.SetSeniorityResponse.user:type_name -> prs.v1.User
+	15,  // 44: prs.v1.GetUserResponse.user:type_name -> prs.v1.User
+	19,  // 45: prs.v1.GetReviewResponse.pull_requests:type_name -> prs.v1.PullRequestShort
+	16,  // 46: prs.v1.GetSkillsResponse.skills:type_name -> prs.v1.UserSkills
+	16,  // 47: prs.v1.SetSkillsRequest.skills:type_name -> prs.v1.UserSkills
+	16,  // 48: prs.v1.SetSkillsResponse.skills:type_name -> prs.v1.UserSkills
+	11,  // 49: prs.v1.GetUserScheduleResponse.schedule:type_name -> prs.v1.UserSchedule
+	11,  // 50: prs.v1.SetUserScheduleRequest.schedule:type_name -> prs.v1.UserSchedule
+	11,  // 51: prs.v1.SetUserScheduleResponse.schedule:type_name -> prs.v1.UserSchedule
+	114, // 52: prs.v1.GetAvailabilityRequest.at:type_name -> google.protobuf.Timestamp
+	13,  // 53: prs.v1.GetAvailabilityResponse.availability:type_name -> prs.v1.Availability
+	17,  // 54: prs.v1.GetSlackUserResponse.slack:type_name -> prs.v1.SlackUser
+	17,  // 55: prs.v1.SetSlackUserRequest.slack:type_name -> prs.v1.SlackUser
+	17,  // 56: prs.v1.SetSlackUserResponse.slack:type_name -> prs.v1.SlackUser
+	18,  // 57: prs.v1.GetDigestResponse.digest:type_name -> prs.v1.DigestSubscription
+	18,  // 58: prs.v1.SetDigestRequest.digest:type_name -> prs.v1.DigestSubscription
+	18,  // 59: prs.v1.SetDigestResponse.digest:type_name -> prs.v1.DigestSubscription
+	21,  // 60: prs.v1.CreatePullRequestResponse.pr:type_name -> prs.v1.PullRequest
+	21,  // 61: prs.v1.MergePullRequestResponse.pr:type_name -> prs.v1.PullRequest
+	21,  // 62: prs.v1.ReassignReviewerResponse.pr:type_name -> prs.v1.PullRequest
+	21,  // 63: prs.v1.FillReviewersResponse.pull_requests:type_name -> prs.v1.PullRequest
+	23,  // 64: prs.v1.ExplainAssignmentResponse.decisions:type_name -> prs.v1.AssignmentDecision
+	24,  // 65: prs.v1.GetOverdueResponse.overdue:type_name -> prs.v1.OverdueReview
+	21,  // 66: prs.v1.GetPullRequestResponse.pr:type_name -> prs.v1.PullRequest
+	114, // 67: prs.v1.ListPullRequestsRequest.created_from:type_name -> google.protobuf.Timestamp
+	114, // 68: prs.v1.ListPullRequestsRequest.created_to:type_name -> google.protobuf.Timestamp
+	21,  // 69: prs.v1.ListPullRequestsResponse.pull_requests:type_name -> prs.v1.PullRequest
+	113, // 70: prs.v1.GetStatsResponse.review_assignments:type_name -> prs.v1.GetStatsResponse.ReviewAssignmentsEntry
+	27,  // 71: prs.v1.GetPairStatsResponse.matrix:type_name -> prs.v1.PairRow
+	25,  // 72: prs.v1.GetLatencyStatsResponse.merge:type_name -> prs.v1.LatencySummary
+	26,  // 73: prs.v1.GetLatencyStatsResponse.reviewers:type_name -> prs.v1.ReviewerLatency
+	28,  // 74: prs.v1.StreamEventsResponse.event:type_name -> prs.v1.Event
+	12,  // 75: prs.v1.GetHolidaysResponse.holidays:type_name -> prs.v1.Holiday
+	29,  // 76: prs.v1.PRSService.AddTeam:input_type -> prs.v1.AddTeamRequest
+	31,  // 77: prs.v1.PRSService.GetTeam:input_type -> prs.v1.GetTeamRequest
+	33,  // 78: prs.v1.PRSService.GetTeamFallback:input_type -> prs.v1.GetTeamFallbackRequest
+	35,  // 79: prs.v1.PRSService.SetTeamFallback:input_type -> prs.v1.SetTeamFallbackRequest
+	37,  // 80: prs.v1.PRSService.GetTeamSubtree:input_type -> prs.v1.GetTeamSubtreeRequest
+	39,  // 81: prs.v1.PRSService.SetTeamParent:input_type -> prs.v1.SetTeamParentRequest
+	41,  // 82: prs.v1.PRSService.GetTeamOwners:input_type -> prs.v1.GetTeamOwnersRequest
+	43,  // 83: prs.v1.PRSService.SetTeamOwners:input_type -> prs.v1.SetTeamOwnersRequest
+	45,  // 84: prs.v1.PRSService.DeleteTeamOwners:input_type -> prs.v1.DeleteTeamOwnersRequest
+	47,  // 85: prs.v1.PRSService.GetTeamReviewRules:input_type -> prs.v1.GetTeamReviewRulesRequest
+	49,  // 86: prs.v1.PRSService.SetTeamReviewRules:input_type -> prs.v1.SetTeamReviewRulesRequest
+	51,  // 87: prs.v1.PRSService.GetTeamSLA:input_type -> prs.v1.GetTeamSLARequest
+	53,  // 88: prs.v1.PRSService.SetTeamSLA:input_type -> prs.v1.SetTeamSLARequest
+	55,  // 89: prs.v1.PRSService.GetTeamSchedule:input_type -> prs.v1.GetTeamScheduleRequest
+	57,  // 90: prs.v1.PRSService.SetTeamSchedule:input_type -> prs.v1.SetTeamScheduleRequest
+	59,  // 91: prs.v1.PRSService.SetIsActive:input_type -> prs.v1.SetIsActiveRequest
+	61,  // 92: prs.v1.PRSService.SetSeniority:input_type -> prs.v1.SetSeniorityRequest
+	63,  // 93: prs.v1.PRSService.GetUser:input_type -> prs.v1.GetUserRequest
+	65,  // 94: prs.v1.PRSService.GetReview:input_type -> prs.v1.GetReviewRequest
+	67,  // 95: prs.v1.PRSService.GetSkills:input_type -> prs.v1.GetSkillsRequest
+	69,  // 96: prs.v1.PRSService.SetSkills:input_type -> prs.v1.SetSkillsRequest
+	71,  // 97: prs.v1.PRSService.GetUserSchedule:input_type -> prs.v1.GetUserScheduleRequest
+	73,  // 98: prs.v1.PRSService.SetUserSchedule:input_type -> prs.v1.SetUserScheduleRequest
+	75,  // 99: prs.v1.PRSService.GetAvailability:input_type -> prs.v1.GetAvailabilityRequest
+	77,  // 100: prs.v1.PRSService.GetSlackUser:input_type -> prs.v1.GetSlackUserRequest
+	79,  // 101: prs.v1.PRSService.SetSlackUser:input_type -> prs.v1.SetSlackUserRequest
+	81,  // 102: prs.v1.PRSService.GetDigest:input_type -> prs.v1.GetDigestRequest
+	83,  // 103: prs.v1.PRSService.SetDigest:input_type -> prs.v1.SetDigestRequest
+	85,  // 104: prs.v1.PRSService.CreatePullRequest:input_type -> prs.v1.CreatePullRequestRequest
+	87,  // 105: prs.v1.PRSService.MergePullRequest:input_type -> prs.v1.MergePullRequestRequest
+	89,  // 106: prs.v1.PRSService.ReassignReviewer:input_type -> prs.v1.ReassignReviewerRequest
+	91,  // 107: prs.v1.PRSService.FillReviewers:input_type -> prs.v1.FillReviewersRequest
+	93,  // 108: prs.v1.PRSService.ExplainAssignment:input_type -> prs.v1.ExplainAssignmentRequest
+	95,  // 109: prs.v1.PRSService.GetOverdue:input_type -> prs.v1.GetOverdueRequest
+	97,  // 110: prs.v1.PRSService.GetPullRequest:input_type -> prs.v1.GetPullRequestRequest
+	99,  // 111: prs.v1.PRSService.ListPullRequests:input_type -> prs.v1.ListPullRequestsRequest
+	101, // 112: prs.v1.PRSService.GetStats:input_type -> prs.v1.GetStatsRequest
+	103, // 113: prs.v1.PRSService.GetPairStats:input_type -> prs.v1.GetPairStatsRequest
+	105, // 114: prs.v1.PRSService.GetLatencyStats:input_type -> prs.v1.GetLatencyStatsRequest
+	107, // 115: prs.v1.PRSService.StreamEvents:input_type -> prs.v1.StreamEventsRequest
+	109, // 116: prs.v1.PRSService.GetHolidays:input_type -> prs.v1.GetHolidaysRequest
+	111, // 117: prs.v1.PRSService.ImportHolidays:input_type -> prs.v1.ImportHolidaysRequest
+	30,  // 118: prs.v1.PRSService.AddTeam:output_type -> prs.v1.AddTeamResponse
+	32,  // 119: prs.v1.PRSService.GetTeam:output_type -> prs.v1.GetTeamResponse
+	34,  // 120: prs.v1.PRSService.GetTeamFallback:output_type -> prs.v1.GetTeamFallbackResponse
+	36,  // 121: prs.v1.PRSService.SetTeamFallback:output_type -> prs.v1.SetTeamFallbackResponse
+	38,  // 122: prs.v1.PRSService.GetTeamSubtree:output_type -> prs.v1.GetTeamSubtreeResponse
+	40,  // 123: prs.v1.PRSService.SetTeamParent:output_type -> prs.v1.SetTeamParentResponse
+	42,  // 124: prs.v1.PRSService.GetTeamOwners:output_type -> prs.v1.GetTeamOwnersResponse
+	44,  // 125: prs.v1.PRSService.SetTeamOwners:output_type -> prs.v1.SetTeamOwnersResponse
+	46,  // 126: prs.v1.PRSService.DeleteTeamOwners:output_type -> prs.v1.DeleteTeamOwnersResponse
+	48,  // 127: prs.v1.PRSService.GetTeamReviewRules:output_type -> prs.v1.GetTeamReviewRulesResponse
+	50,  // 128: prs.v1.PRSService.SetTeamReviewRules:output_type -> prs.v1.SetTeamReviewRulesResponse
+	52,  // 129: prs.v1.PRSService.GetTeamSLA:output_type -> prs.v1.GetTeamSLAResponse
+	54,  // 130: prs.v1.PRSService.SetTeamSLA:output_type -> prs.v1.SetTeamSLAResponse
+	56,  // 131: prs.v1.PRSService.GetTeamSchedule:output_type -> prs.v1.GetTeamScheduleResponse
+	58,  // 132: prs.v1.PRSService.SetTeamSchedule:output_type -> prs.v1.SetTeamScheduleResponse
+	60,  // 133: prs.v1.PRSService.SetIsActive:output_type -> prs.v1.SetIsActiveResponse
+	62,  // 134: prs.v1.PRSService.SetSeniority:output_type -> prs.v1.SetSeniorityResponse
+	64,  // 135: prs.v1.PRSService.GetUser:output_type -> prs.v1.GetUserResponse
+	66,  // 136: prs.v1.PRSService.GetReview:output_type -> prs.v1.GetReviewResponse
+	68,  // 137: prs.v1.PRSService.GetSkills:output_type -> prs.v1.GetSkillsResponse
+	70,  // 138: prs.v1.PRSService.SetSkills:output_type -> prs.v1.SetSkillsResponse
+	72,  // 139: prs.v1.PRSService.GetUserSchedule:output_type -> prs.v1.GetUserScheduleResponse
+	74,  // 140: prs.v1.PRSService.SetUserSchedule:output_type -> prs.v1.SetUserScheduleResponse
+	76,  // 141: prs.v1.PRSService.GetAvailability:output_type -> prs.v1.GetAvailabilityResponse
+	78,  // 142: prs.v1.PRSService.GetSlackUser:output_type -> prs.v1.GetSlackUserResponse
+	80,  // 143: prs.v1.PRSService.SetSlackUser:output_type -> prs.v1.SetSlackUserResponse
+	82,  // 144: prs.v1.PRSService.GetDigest:output_type -> prs.v1.GetDigestResponse
+	84,  // 145: prs.v1.PRSService.SetDigest:output_type -> prs.v1.SetDigestResponse
+	86,  // 146: prs.v1.PRSService.CreatePullRequest:output_type -> prs.v1.CreatePullRequestResponse
+	88,  // 147: prs.v1.PRSService.MergePullRequest:output_type -> prs.v1.MergePullRequestResponse
+	90,  // 148: prs.v1.PRSService.ReassignReviewer:output_type -> prs.v1.ReassignReviewerResponse
+	92,  // 149: prs.v1.PRSService.FillReviewers:output_type -> prs.v1.FillReviewersResponse
+	94,  // 150: prs.v1.PRSService.ExplainAssignment:output_type -> prs.v1.ExplainAssignmentResponse
+	96,  // 151: prs.v1.PRSService.GetOverdue:output_type -> prs.v1.GetOverdueResponse
+	98,  // 152: prs.v1.PRSService.GetPullRequest:output_type -> prs.v1.GetPullRequestResponse
+	100, // 153: prs.v1.PRSService.ListPullRequests:output_type -> prs.v1.ListPullRequestsResponse
+	102, // 154: prs.v1.PRSService.GetStats:output_type -> prs.v1.GetStatsResponse
+	104, // 155: prs.v1.PRSService.GetPairStats:output_type -> prs.v1.GetPairStatsResponse
+	106, // 156: prs.v1.PRSService.GetLatencyStats:output_type -> prs.v1.GetLatencyStatsResponse
+	108, // 157: prs.v1.PRSService.StreamEvents:output_type -> prs.v1.StreamEventsResponse
+	110, // 158: prs.v1.PRSService.GetHolidays:output_type -> prs.v1.GetHolidaysResponse
+	112, // 159: prs.v1.PRSService.ImportHolidays:output_type -> prs.v1.ImportHolidaysResponse
+	118, // [118:160] is the sub-list for method output_type
+	76,  // [76:118] is the sub-list for method input_type
+	76,  // [76:76] is the sub-list for extension type_name
+	76,  // [76:76] is the sub-list for extension extendee
+	0,   // [0:76] is the sub-list for field type_name
+}
+
+func init() { file_prs_v1_prs_proto_init() }
+func file_prs_v1_prs_proto_init() {
+	if File_prs_v1_prs_proto != nil {
+		return
+	}
+	file_prs_v1_prs_proto_msgTypes[1].OneofWrappers = []any{}
+	file_prs_v1_prs_proto_msgTypes[4].OneofWrappers = []any{}
+	file_prs_v1_prs_proto_msgTypes[8].OneofWrappers = []any{}
+	file_prs_v1_prs_proto_msgTypes[15].OneofWrappers = []any{}
+	file_prs_v1_prs_proto_msgTypes[20].OneofWrappers = []any{}
+	file_prs_v1_prs_proto_msgTypes[21].OneofWrappers = []any{}
+	file_prs_v1_prs_proto_msgTypes[22].OneofWrappers = []any{}
+	file_prs_v1_prs_proto_msgTypes[23].OneofWrappers = []any{}
+	file_prs_v1_prs_proto_msgTypes[28].OneofWrappers = []any{}
+	file_prs_v1_prs_proto_msgTypes[39].OneofWrappers = []any{}
+	file_prs_v1_prs_proto_msgTypes[93].OneofWrappers = []any{}
+	file_prs_v1_prs_proto_msgTypes[99].OneofWrappers = []any{}
+	file_prs_v1_prs_proto_msgTypes[107].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_prs_v1_prs_proto_rawDesc), len(file_prs_v1_prs_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   114,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_prs_v1_prs_proto_goTypes,
+		DependencyIndexes: file_prs_v1_prs_proto_depIdxs,
+		MessageInfos:      file_prs_v1_prs_proto_msgTypes,
+	}.Build()
+	File_prs_v1_prs_proto = out.File
+	file_prs_v1_prs_proto_goTypes = nil
+	file_prs_v1_prs_proto_depIdxs = nil
+}
@@ -0,0 +1,1781 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: prs/v1/prs.proto
+
+package prsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PRSService_AddTeam_FullMethodName            = "/prs.v1.PRSService/AddTeam"
+	PRSService_GetTeam_FullMethodName            = "/prs.v1.PRSService/GetTeam"
+	PRSService_GetTeamFallback_FullMethodName    = "/prs.v1.PRSService/GetTeamFallback"
+	PRSService_SetTeamFallback_FullMethodName    = "/prs.v1.PRSService/SetTeamFallback"
+	PRSService_GetTeamSubtree_FullMethodName     = "/prs.v1.PRSService/GetTeamSubtree"
+	PRSService_SetTeamParent_FullMethodName      = "/prs.v1.PRSService/SetTeamParent"
+	PRSService_GetTeamOwners_FullMethodName      = "/prs.v1.PRSService/GetTeamOwners"
+	PRSService_SetTeamOwners_FullMethodName      = "/prs.v1.PRSService/SetTeamOwners"
+	PRSService_DeleteTeamOwners_FullMethodName   = "/prs.v1.PRSService/DeleteTeamOwners"
+	PRSService_GetTeamReviewRules_FullMethodName = "/prs.v1.PRSService/GetTeamReviewRules"
+	PRSService_SetTeamReviewRules_FullMethodName = "/prs.v1.PRSService/SetTeamReviewRules"
+	PRSService_GetTeamSLA_FullMethodName         = "/prs.v1.PRSService/GetTeamSLA"
+	PRSService_SetTeamSLA_FullMethodName         = "/prs.v1.PRSService/SetTeamSLA"
+	PRSService_GetTeamSchedule_FullMethodName    = "/prs.v1.PRSService/GetTeamSchedule"
+	PRSService_SetTeamSchedule_FullMethodName    = "/prs.v1.PRSService/SetTeamSchedule"
+	PRSService_SetIsActive_FullMethodName        = "/prs.v1.PRSService/SetIsActive"
+	PRSService_SetSeniority_FullMethodName       = "/prs.v1.PRSService/SetSeniority"
+	PRSService_GetUser_FullMethodName            = "/prs.v1.PRSService/GetUser"
+	PRSService_GetReview_FullMethodName          = "/prs.v1.PRSService/GetReview"
+	PRSService_GetSkills_FullMethodName          = "/prs.v1.PRSService/GetSkills"
+	PRSService_SetSkills_FullMethodName          = "/prs.v1.PRSService/SetSkills"
+	PRSService_GetUserSchedule_FullMethodName    = "/prs.v1.PRSService/GetUserSchedule"
+	PRSService_SetUserSchedule_FullMethodName    = "/prs.v1.PRSService/SetUserSchedule"
+	PRSService_GetAvailability_FullMethodName    = "/prs.v1.PRSService/GetAvailability"
+	PRSService_GetSlackUser_FullMethodName       = "/prs.v1.PRSService/GetSlackUser"
+	PRSService_SetSlackUser_FullMethodName       = "/prs.v1.PRSService/SetSlackUser"
+	PRSService_GetDigest_FullMethodName          = "/prs.v1.PRSService/GetDigest"
+	PRSService_SetDigest_FullMethodName          = "/prs.v1.PRSService/SetDigest"
+	PRSService_CreatePullRequest_FullMethodName  = "/prs.v1.PRSService/CreatePullRequest"
+	PRSService_MergePullRequest_FullMethodName   = "/prs.v1.PRSService/MergePullRequest"
+	PRSService_ReassignReviewer_FullMethodName   = "/prs.v1.PRSService/ReassignReviewer"
+	PRSService_FillReviewers_FullMethodName      = "/prs.v1.PRSService/FillReviewers"
+	PRSService_ExplainAssignment_FullMethodName  = "/prs.v1.PRSService/ExplainAssignment"
+	PRSService_GetOverdue_FullMethodName         = "/prs.v1.PRSService/GetOverdue"
+	PRSService_GetPullRequest_FullMethodName     = "/prs.v1.PRSService/GetPullRequest"
+	PRSService_ListPullRequests_FullMethodName   = "/prs.v1.PRSService/ListPullRequests"
+	PRSService_GetStats_FullMethodName           = "/prs.v1.PRSService/GetStats"
+	PRSService_GetPairStats_FullMethodName       = "/prs.v1.PRSService/GetPairStats"
+	PRSService_GetLatencyStats_FullMethodName    = "/prs.v1.PRSService/GetLatencyStats"
+	PRSService_StreamEvents_FullMethodName       = "/prs.v1.PRSService/StreamEvents"
+	PRSService_GetHolidays_FullMethodName        = "/prs.v1.PRSService/GetHolidays"
+	PRSService_ImportHolidays_FullMethodName     = "/prs.v1.PRSService/ImportHolidays"
+)
+
+// PRSServiceClient is the client API for PRSService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PRSService повторяет REST API сервиса: каждый метод соответствует маршруту
+// из internal/server (в комментарии указан маршрут). Идентификаторы — строки UUID,
+// перечисления передаются теми же строками, что и в JSON.
+type PRSServiceClient interface {
+	// POST /team/add
+	AddTeam(ctx context.Context, in *AddTeamRequest, opts ...grpc.CallOption) (*AddTeamResponse, error)
+	// GET /team/get
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error)
+	// GET /team/fallback
+	GetTeamFallback(ctx context.Context, in *GetTeamFallbackRequest, opts ...grpc.CallOption) (*GetTeamFallbackResponse, error)
+	// POST /team/fallback
+	SetTeamFallback(ctx context.Context, in *SetTeamFallbackRequest, opts ...grpc.CallOption) (*SetTeamFallbackResponse, error)
+	// GET /team/subtree
+	GetTeamSubtree(ctx context.Context, in *GetTeamSubtreeRequest, opts ...grpc.CallOption) (*GetTeamSubtreeResponse, error)
+	// POST /team/setParent
+	SetTeamParent(ctx context.Context, in *SetTeamParentRequest, opts ...grpc.CallOption) (*SetTeamParentResponse, error)
+	// GET /team/owners
+	GetTeamOwners(ctx context.Context, in *GetTeamOwnersRequest, opts ...grpc.CallOption) (*GetTeamOwnersResponse, error)
+	// POST /team/owners
+	SetTeamOwners(ctx context.Context, in *SetTeamOwnersRequest, opts ...grpc.CallOption) (*SetTeamOwnersResponse, error)
+	// DELETE /team/owners
+	DeleteTeamOwners(ctx context.Context, in *DeleteTeamOwnersRequest, opts ...grpc.CallOption) (*DeleteTeamOwnersResponse, error)
+	// GET /team/reviewRules
+	GetTeamReviewRules(ctx context.Context, in *GetTeamReviewRulesRequest, opts ...grpc.CallOption) (*GetTeamReviewRulesResponse, error)
+	// POST /team/reviewRules
+	SetTeamReviewRules(ctx context.Context, in *SetTeamReviewRulesRequest, opts ...grpc.CallOption) (*SetTeamReviewRulesResponse, error)
+	// GET /team/sla
+	GetTeamSLA(ctx context.Context, in *GetTeamSLARequest, opts ...grpc.CallOption) (*GetTeamSLAResponse, error)
+	// POST /team/sla
+	SetTeamSLA(ctx context.Context, in *SetTeamSLARequest, opts ...grpc.CallOption) (*SetTeamSLAResponse, error)
+	// GET /team/schedule
+	GetTeamSchedule(ctx context.Context, in *GetTeamScheduleRequest, opts ...grpc.CallOption) (*GetTeamScheduleResponse, error)
+	// POST /team/schedule
+	SetTeamSchedule(ctx context.Context, in *SetTeamScheduleRequest, opts ...grpc.CallOption) (*SetTeamScheduleResponse, error)
+	// POST /users/setIsActive
+	SetIsActive(ctx context.Context, in *SetIsActiveRequest, opts ...grpc.CallOption) (*SetIsActiveResponse, error)
+	// POST /users/setSeniority
+	SetSeniority(ctx context.Context, in *SetSeniorityRequest, opts ...grpc.CallOption) (*SetSeniorityResponse, error)
+	// GET /users/get
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// GET /users/getReview
+	GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewResponse, error)
+	// GET /users/skills
+	GetSkills(ctx context.Context, in *GetSkillsRequest, opts ...grpc.CallOption) (*GetSkillsResponse, error)
+	// POST /users/skills
+	SetSkills(ctx context.Context, in *SetSkillsRequest, opts ...grpc.CallOption) (*SetSkillsResponse, error)
+	// GET /users/schedule
+	GetUserSchedule(ctx context.Context, in *GetUserScheduleRequest, opts ...grpc.CallOption) (*GetUserScheduleResponse, error)
+	// POST /users/schedule
+	SetUserSchedule(ctx context.Context, in *SetUserScheduleRequest, opts ...grpc.CallOption) (*SetUserScheduleResponse, error)
+	// GET /users/availability
+	GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpc.CallOption) (*GetAvailabilityResponse, error)
+	// GET /users/slack
+	GetSlackUser(ctx context.Context, in *GetSlackUserRequest, opts ...grpc.CallOption) (*GetSlackUserResponse, error)
+	// POST /users/slack
+	SetSlackUser(ctx context.Context, in *SetSlackUserRequest, opts ...grpc.CallOption) (*SetSlackUserResponse, error)
+	// GET /users/digest
+	GetDigest(ctx context.Context, in *GetDigestRequest, opts ...grpc.CallOption) (*GetDigestResponse, error)
+	// POST /users/digest
+	SetDigest(ctx context.Context, in *SetDigestRequest, opts ...grpc.CallOption) (*SetDigestResponse, error)
+	// POST /pullRequest/create
+	CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*CreatePullRequestResponse, error)
+	// POST /pullRequest/merge
+	MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*MergePullRequestResponse, error)
+	// POST /pullRequest/reassign
+	ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error)
+	// POST /pullRequest/fillReviewers
+	FillReviewers(ctx context.Context, in *FillReviewersRequest, opts ...grpc.CallOption) (*FillReviewersResponse, error)
+	// GET /pullRequest/explainAssignment
+	ExplainAssignment(ctx context.Context, in *ExplainAssignmentRequest, opts ...grpc.CallOption) (*ExplainAssignmentResponse, error)
+	// GET /pullRequest/overdue
+	GetOverdue(ctx context.Context, in *GetOverdueRequest, opts ...grpc.CallOption) (*GetOverdueResponse, error)
+	// GET /pullRequest/get
+	GetPullRequest(ctx context.Context, in *GetPullRequestRequest, opts ...grpc.CallOption) (*GetPullRequestResponse, error)
+	// GET /pullRequest/list: страницы от новых PR к старым, следующая запрашивается
+	// с next_cursor предыдущей; пустой next_cursor означает последнюю страницу.
+	ListPullRequests(ctx context.Context, in *ListPullRequestsRequest, opts ...grpc.CallOption) (*ListPullRequestsResponse, error)
+	// GET /stats
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
+	// GET /stats/pairs
+	GetPairStats(ctx context.Context, in *GetPairStatsRequest, opts ...grpc.CallOption) (*GetPairStatsResponse, error)
+	// GET /stats/latency
+	GetLatencyStats(ctx context.Context, in *GetLatencyStatsRequest, opts ...grpc.CallOption) (*GetLatencyStatsResponse, error)
+	// GET /events/stream: поток продолжается с события после last_event_id,
+	// а без него — с новых событий. События идут в порядке фиксации транзакций,
+	// id не обязательно возрастают: передаётся id последнего полученного события.
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamEventsResponse], error)
+	// GET /holidays
+	GetHolidays(ctx context.Context, in *GetHolidaysRequest, opts ...grpc.CallOption) (*GetHolidaysResponse, error)
+	// POST /holidays/import
+	ImportHolidays(ctx context.Context, in *ImportHolidaysRequest, opts ...grpc.CallOption) (*ImportHolidaysResponse, error)
+}
+
+type pRSServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPRSServiceClient(cc grpc.ClientConnInterface) PRSServiceClient {
+	return &pRSServiceClient{cc}
+}
+
+func (c *pRSServiceClient) AddTeam(ctx context.Context, in *AddTeamRequest, opts ...grpc.CallOption) (*AddTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTeamResponse)
+	err := c.cc.Invoke(ctx, PRSService_AddTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetTeamFallback(ctx context.Context, in *GetTeamFallbackRequest, opts ...grpc.CallOption) (*GetTeamFallbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamFallbackResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetTeamFallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) SetTeamFallback(ctx context.Context, in *SetTeamFallbackRequest, opts ...grpc.CallOption) (*SetTeamFallbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTeamFallbackResponse)
+	err := c.cc.Invoke(ctx, PRSService_SetTeamFallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetTeamSubtree(ctx context.Context, in *GetTeamSubtreeRequest, opts ...grpc.CallOption) (*GetTeamSubtreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamSubtreeResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetTeamSubtree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) SetTeamParent(ctx context.Context, in *SetTeamParentRequest, opts ...grpc.CallOption) (*SetTeamParentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTeamParentResponse)
+	err := c.cc.Invoke(ctx, PRSService_SetTeamParent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetTeamOwners(ctx context.Context, in *GetTeamOwnersRequest, opts ...grpc.CallOption) (*GetTeamOwnersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamOwnersResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetTeamOwners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) SetTeamOwners(ctx context.Context, in *SetTeamOwnersRequest, opts ...grpc.CallOption) (*SetTeamOwnersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTeamOwnersResponse)
+	err := c.cc.Invoke(ctx, PRSService_SetTeamOwners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) DeleteTeamOwners(ctx context.Context, in *DeleteTeamOwnersRequest, opts ...grpc.CallOption) (*DeleteTeamOwnersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTeamOwnersResponse)
+	err := c.cc.Invoke(ctx, PRSService_DeleteTeamOwners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetTeamReviewRules(ctx context.Context, in *GetTeamReviewRulesRequest, opts ...grpc.CallOption) (*GetTeamReviewRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamReviewRulesResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetTeamReviewRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) SetTeamReviewRules(ctx context.Context, in *SetTeamReviewRulesRequest, opts ...grpc.CallOption) (*SetTeamReviewRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTeamReviewRulesResponse)
+	err := c.cc.Invoke(ctx, PRSService_SetTeamReviewRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetTeamSLA(ctx context.Context, in *GetTeamSLARequest, opts ...grpc.CallOption) (*GetTeamSLAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamSLAResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetTeamSLA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) SetTeamSLA(ctx context.Context, in *SetTeamSLARequest, opts ...grpc.CallOption) (*SetTeamSLAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTeamSLAResponse)
+	err := c.cc.Invoke(ctx, PRSService_SetTeamSLA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetTeamSchedule(ctx context.Context, in *GetTeamScheduleRequest, opts ...grpc.CallOption) (*GetTeamScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamScheduleResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetTeamSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) SetTeamSchedule(ctx context.Context, in *SetTeamScheduleRequest, opts ...grpc.CallOption) (*SetTeamScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTeamScheduleResponse)
+	err := c.cc.Invoke(ctx, PRSService_SetTeamSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) SetIsActive(ctx context.Context, in *SetIsActiveRequest, opts ...grpc.CallOption) (*SetIsActiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetIsActiveResponse)
+	err := c.cc.Invoke(ctx, PRSService_SetIsActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) SetSeniority(ctx context.Context, in *SetSeniorityRequest, opts ...grpc.CallOption) (*SetSeniorityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSeniorityResponse)
+	err := c.cc.Invoke(ctx, PRSService_SetSeniority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetSkills(ctx context.Context, in *GetSkillsRequest, opts ...grpc.CallOption) (*GetSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSkillsResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) SetSkills(ctx context.Context, in *SetSkillsRequest, opts ...grpc.CallOption) (*SetSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSkillsResponse)
+	err := c.cc.Invoke(ctx, PRSService_SetSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetUserSchedule(ctx context.Context, in *GetUserScheduleRequest, opts ...grpc.CallOption) (*GetUserScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserScheduleResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetUserSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) SetUserSchedule(ctx context.Context, in *SetUserScheduleRequest, opts ...grpc.CallOption) (*SetUserScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserScheduleResponse)
+	err := c.cc.Invoke(ctx, PRSService_SetUserSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpc.CallOption) (*GetAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAvailabilityResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetSlackUser(ctx context.Context, in *GetSlackUserRequest, opts ...grpc.CallOption) (*GetSlackUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSlackUserResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetSlackUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) SetSlackUser(ctx context.Context, in *SetSlackUserRequest, opts ...grpc.CallOption) (*SetSlackUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSlackUserResponse)
+	err := c.cc.Invoke(ctx, PRSService_SetSlackUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetDigest(ctx context.Context, in *GetDigestRequest, opts ...grpc.CallOption) (*GetDigestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDigestResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetDigest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) SetDigest(ctx context.Context, in *SetDigestRequest, opts ...grpc.CallOption) (*SetDigestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDigestResponse)
+	err := c.cc.Invoke(ctx, PRSService_SetDigest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*CreatePullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePullRequestResponse)
+	err := c.cc.Invoke(ctx, PRSService_CreatePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*MergePullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergePullRequestResponse)
+	err := c.cc.Invoke(ctx, PRSService_MergePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignReviewerResponse)
+	err := c.cc.Invoke(ctx, PRSService_ReassignReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) FillReviewers(ctx context.Context, in *FillReviewersRequest, opts ...grpc.CallOption) (*FillReviewersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FillReviewersResponse)
+	err := c.cc.Invoke(ctx, PRSService_FillReviewers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) ExplainAssignment(ctx context.Context, in *ExplainAssignmentRequest, opts ...grpc.CallOption) (*ExplainAssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainAssignmentResponse)
+	err := c.cc.Invoke(ctx, PRSService_ExplainAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetOverdue(ctx context.Context, in *GetOverdueRequest, opts ...grpc.CallOption) (*GetOverdueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOverdueResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetOverdue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetPullRequest(ctx context.Context, in *GetPullRequestRequest, opts ...grpc.CallOption) (*GetPullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPullRequestResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetPullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) ListPullRequests(ctx context.Context, in *ListPullRequestsRequest, opts ...grpc.CallOption) (*ListPullRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPullRequestsResponse)
+	err := c.cc.Invoke(ctx, PRSService_ListPullRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatsResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetPairStats(ctx context.Context, in *GetPairStatsRequest, opts ...grpc.CallOption) (*GetPairStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPairStatsResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetPairStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) GetLatencyStats(ctx context.Context, in *GetLatencyStatsRequest, opts ...grpc.CallOption) (*GetLatencyStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLatencyStatsResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetLatencyStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PRSService_ServiceDesc.Streams[0], PRSService_StreamEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamEventsRequest, StreamEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PRSService_StreamEventsClient = grpc.ServerStreamingClient[StreamEventsResponse]
+
+func (c *pRSServiceClient) GetHolidays(ctx context.Context, in *GetHolidaysRequest, opts ...grpc.CallOption) (*GetHolidaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHolidaysResponse)
+	err := c.cc.Invoke(ctx, PRSService_GetHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRSServiceClient) ImportHolidays(ctx context.Context, in *ImportHolidaysRequest, opts ...grpc.CallOption) (*ImportHolidaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportHolidaysResponse)
+	err := c.cc.Invoke(ctx, PRSService_ImportHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PRSServiceServer is the server API for PRSService service.
+// All implementations must embed UnimplementedPRSServiceServer
+// for forward compatibility.
+//
+// PRSService повторяет REST API сервиса: каждый метод соответствует маршруту
+// из internal/server (в комментарии указан маршрут). Идентификаторы — строки UUID,
+// перечисления передаются теми же строками, что и в JSON.
+type PRSServiceServer interface {
+	// POST /team/add
+	AddTeam(context.Context, *AddTeamRequest) (*AddTeamResponse, error)
+	// GET /team/get
+	GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error)
+	// GET /team/fallback
+	GetTeamFallback(context.Context, *GetTeamFallbackRequest) (*GetTeamFallbackResponse, error)
+	// POST /team/fallback
+	SetTeamFallback(context.Context, *SetTeamFallbackRequest) (*SetTeamFallbackResponse, error)
+	// GET /team/subtree
+	GetTeamSubtree(context.Context, *GetTeamSubtreeRequest) (*GetTeamSubtreeResponse, error)
+	// POST /team/setParent
+	SetTeamParent(context.Context, *SetTeamParentRequest) (*SetTeamParentResponse, error)
+	// GET /team/owners
+	GetTeamOwners(context.Context, *GetTeamOwnersRequest) (*GetTeamOwnersResponse, error)
+	// POST /team/owners
+	SetTeamOwners(context.Context, *SetTeamOwnersRequest) (*SetTeamOwnersResponse, error)
+	// DELETE /team/owners
+	DeleteTeamOwners(context.Context, *DeleteTeamOwnersRequest) (*DeleteTeamOwnersResponse, error)
+	// GET /team/reviewRules
+	GetTeamReviewRules(context.Context, *GetTeamReviewRulesRequest) (*GetTeamReviewRulesResponse, error)
+	// POST /team/reviewRules
+	SetTeamReviewRules(context.Context, *SetTeamReviewRulesRequest) (*SetTeamReviewRulesResponse, error)
+	// GET /team/sla
+	GetTeamSLA(context.Context, *GetTeamSLARequest) (*GetTeamSLAResponse, error)
+	// POST /team/sla
+	SetTeamSLA(context.Context, *SetTeamSLARequest) (*SetTeamSLAResponse, error)
+	// GET /team/schedule
+	GetTeamSchedule(context.Context, *GetTeamScheduleRequest) (*GetTeamScheduleResponse, error)
+	// POST /team/schedule
+	SetTeamSchedule(context.Context, *SetTeamScheduleRequest) (*SetTeamScheduleResponse, error)
+	// POST /users/setIsActive
+	SetIsActive(context.Context, *SetIsActiveRequest) (*SetIsActiveResponse, error)
+	// POST /users/setSeniority
+	SetSeniority(context.Context, *SetSeniorityRequest) (*SetSeniorityResponse, error)
+	// GET /users/get
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// GET /users/getReview
+	GetReview(context.Context, *GetReviewRequest) (*GetReviewResponse, error)
+	// GET /users/skills
+	GetSkills(context.Context, *GetSkillsRequest) (*GetSkillsResponse, error)
+	// POST /users/skills
+	SetSkills(context.Context, *SetSkillsRequest) (*SetSkillsResponse, error)
+	// GET /users/schedule
+	GetUserSchedule(context.Context, *GetUserScheduleRequest) (*GetUserScheduleResponse, error)
+	// POST /users/schedule
+	SetUserSchedule(context.Context, *SetUserScheduleRequest) (*SetUserScheduleResponse, error)
+	// GET /users/availability
+	GetAvailability(context.Context, *GetAvailabilityRequest) (*GetAvailabilityResponse, error)
+	// GET /users/slack
+	GetSlackUser(context.Context, *GetSlackUserRequest) (*GetSlackUserResponse, error)
+	// POST /users/slack
+	SetSlackUser(context.Context, *SetSlackUserRequest) (*SetSlackUserResponse, error)
+	// GET /users/digest
+	GetDigest(context.Context, *GetDigestRequest) (*GetDigestResponse, error)
+	// POST /users/digest
+	SetDigest(context.Context, *SetDigestRequest) (*SetDigestResponse, error)
+	// POST /pullRequest/create
+	CreatePullRequest(context.Context, *CreatePullRequestRequest) (*CreatePullRequestResponse, error)
+	// POST /pullRequest/merge
+	MergePullRequest(context.Context, *MergePullRequestRequest) (*MergePullRequestResponse, error)
+	// POST /pullRequest/reassign
+	ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error)
+	// POST /pullRequest/fillReviewers
+	FillReviewers(context.Context, *FillReviewersRequest) (*FillReviewersResponse, error)
+	// GET /pullRequest/explainAssignment
+	ExplainAssignment(context.Context, *ExplainAssignmentRequest) (*ExplainAssignmentResponse, error)
+	// GET /pullRequest/overdue
+	GetOverdue(context.Context, *GetOverdueRequest) (*GetOverdueResponse, error)
+	// GET /pullRequest/get
+	GetPullRequest(context.Context, *GetPullRequestRequest) (*GetPullRequestResponse, error)
+	// GET /pullRequest/list: страницы от новых PR к старым, следующая запрашивается
+	// с next_cursor предыдущей; пустой next_cursor означает последнюю страницу.
+	ListPullRequests(context.Context, *ListPullRequestsRequest) (*ListPullRequestsResponse, error)
+	// GET /stats
+	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	// GET /stats/pairs
+	GetPairStats(context.Context, *GetPairStatsRequest) (*GetPairStatsResponse, error)
+	// GET /stats/latency
+	GetLatencyStats(context.Context, *GetLatencyStatsRequest) (*GetLatencyStatsResponse, error)
+	// GET /events/stream: поток продолжается с события после last_event_id,
+	// а без него — с новых событий. События идут в порядке фиксации транзакций,
+	// id не обязательно возрастают: передаётся id последнего полученного события.
+	StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[StreamEventsResponse]) error
+	// GET /holidays
+	GetHolidays(context.Context, *GetHolidaysRequest) (*GetHolidaysResponse, error)
+	// POST /holidays/import
+	ImportHolidays(context.Context, *ImportHolidaysRequest) (*ImportHolidaysResponse, error)
+	mustEmbedUnimplementedPRSServiceServer()
+}
+
+// UnimplementedPRSServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPRSServiceServer struct{}
+
+func (UnimplementedPRSServiceServer) AddTeam(context.Context, *AddTeamRequest) (*AddTeamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTeam not implemented")
+}
+func (UnimplementedPRSServiceServer) GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedPRSServiceServer) GetTeamFallback(context.Context, *GetTeamFallbackRequest) (*GetTeamFallbackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeamFallback not implemented")
+}
+func (UnimplementedPRSServiceServer) SetTeamFallback(context.Context, *SetTeamFallbackRequest) (*SetTeamFallbackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTeamFallback not implemented")
+}
+func (UnimplementedPRSServiceServer) GetTeamSubtree(context.Context, *GetTeamSubtreeRequest) (*GetTeamSubtreeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeamSubtree not implemented")
+}
+func (UnimplementedPRSServiceServer) SetTeamParent(context.Context, *SetTeamParentRequest) (*SetTeamParentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTeamParent not implemented")
+}
+func (UnimplementedPRSServiceServer) GetTeamOwners(context.Context, *GetTeamOwnersRequest) (*GetTeamOwnersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeamOwners not implemented")
+}
+func (UnimplementedPRSServiceServer) SetTeamOwners(context.Context, *SetTeamOwnersRequest) (*SetTeamOwnersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTeamOwners not implemented")
+}
+func (UnimplementedPRSServiceServer) DeleteTeamOwners(context.Context, *DeleteTeamOwnersRequest) (*DeleteTeamOwnersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTeamOwners not implemented")
+}
+func (UnimplementedPRSServiceServer) GetTeamReviewRules(context.Context, *GetTeamReviewRulesRequest) (*GetTeamReviewRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeamReviewRules not implemented")
+}
+func (UnimplementedPRSServiceServer) SetTeamReviewRules(context.Context, *SetTeamReviewRulesRequest) (*SetTeamReviewRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTeamReviewRules not implemented")
+}
+func (UnimplementedPRSServiceServer) GetTeamSLA(context.Context, *GetTeamSLARequest) (*GetTeamSLAResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeamSLA not implemented")
+}
+func (UnimplementedPRSServiceServer) SetTeamSLA(context.Context, *SetTeamSLARequest) (*SetTeamSLAResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTeamSLA not implemented")
+}
+func (UnimplementedPRSServiceServer) GetTeamSchedule(context.Context, *GetTeamScheduleRequest) (*GetTeamScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeamSchedule not implemented")
+}
+func (UnimplementedPRSServiceServer) SetTeamSchedule(context.Context, *SetTeamScheduleRequest) (*SetTeamScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTeamSchedule not implemented")
+}
+func (UnimplementedPRSServiceServer) SetIsActive(context.Context, *SetIsActiveRequest) (*SetIsActiveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetIsActive not implemented")
+}
+func (UnimplementedPRSServiceServer) SetSeniority(context.Context, *SetSeniorityRequest) (*SetSeniorityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSeniority not implemented")
+}
+func (UnimplementedPRSServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedPRSServiceServer) GetReview(context.Context, *GetReviewRequest) (*GetReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReview not implemented")
+}
+func (UnimplementedPRSServiceServer) GetSkills(context.Context, *GetSkillsRequest) (*GetSkillsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSkills not implemented")
+}
+func (UnimplementedPRSServiceServer) SetSkills(context.Context, *SetSkillsRequest) (*SetSkillsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSkills not implemented")
+}
+func (UnimplementedPRSServiceServer) GetUserSchedule(context.Context, *GetUserScheduleRequest) (*GetUserScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserSchedule not implemented")
+}
+func (UnimplementedPRSServiceServer) SetUserSchedule(context.Context, *SetUserScheduleRequest) (*SetUserScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetUserSchedule not implemented")
+}
+func (UnimplementedPRSServiceServer) GetAvailability(context.Context, *GetAvailabilityRequest) (*GetAvailabilityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAvailability not implemented")
+}
+func (UnimplementedPRSServiceServer) GetSlackUser(context.Context, *GetSlackUserRequest) (*GetSlackUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSlackUser not implemented")
+}
+func (UnimplementedPRSServiceServer) SetSlackUser(context.Context, *SetSlackUserRequest) (*SetSlackUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSlackUser not implemented")
+}
+func (UnimplementedPRSServiceServer) GetDigest(context.Context, *GetDigestRequest) (*GetDigestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDigest not implemented")
+}
+func (UnimplementedPRSServiceServer) SetDigest(context.Context, *SetDigestRequest) (*SetDigestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDigest not implemented")
+}
+func (UnimplementedPRSServiceServer) CreatePullRequest(context.Context, *CreatePullRequestRequest) (*CreatePullRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePullRequest not implemented")
+}
+func (UnimplementedPRSServiceServer) MergePullRequest(context.Context, *MergePullRequestRequest) (*MergePullRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergePullRequest not implemented")
+}
+func (UnimplementedPRSServiceServer) ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReassignReviewer not implemented")
+}
+func (UnimplementedPRSServiceServer) FillReviewers(context.Context, *FillReviewersRequest) (*FillReviewersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FillReviewers not implemented")
+}
+func (UnimplementedPRSServiceServer) ExplainAssignment(context.Context, *ExplainAssignmentRequest) (*ExplainAssignmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExplainAssignment not implemented")
+}
+func (UnimplementedPRSServiceServer) GetOverdue(context.Context, *GetOverdueRequest) (*GetOverdueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOverdue not implemented")
+}
+func (UnimplementedPRSServiceServer) GetPullRequest(context.Context, *GetPullRequestRequest) (*GetPullRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPullRequest not implemented")
+}
+func (UnimplementedPRSServiceServer) ListPullRequests(context.Context, *ListPullRequestsRequest) (*ListPullRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPullRequests not implemented")
+}
+func (UnimplementedPRSServiceServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedPRSServiceServer) GetPairStats(context.Context, *GetPairStatsRequest) (*GetPairStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPairStats not implemented")
+}
+func (UnimplementedPRSServiceServer) GetLatencyStats(context.Context, *GetLatencyStatsRequest) (*GetLatencyStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLatencyStats not implemented")
+}
+func (UnimplementedPRSServiceServer) StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[StreamEventsResponse]) error {
+	return status.Error(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedPRSServiceServer) GetHolidays(context.Context, *GetHolidaysRequest) (*GetHolidaysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHolidays not implemented")
+}
+func (UnimplementedPRSServiceServer) ImportHolidays(context.Context, *ImportHolidaysRequest) (*ImportHolidaysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportHolidays not implemented")
+}
+func (UnimplementedPRSServiceServer) mustEmbedUnimplementedPRSServiceServer() {}
+func (UnimplementedPRSServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafePRSServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PRSServiceServer will
+// result in compilation errors.
+type UnsafePRSServiceServer interface {
+	mustEmbedUnimplementedPRSServiceServer()
+}
+
+func RegisterPRSServiceServer(s grpc.ServiceRegistrar, srv PRSServiceServer) {
+	// If the following call panics, it indicates UnimplementedPRSServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PRSService_ServiceDesc, srv)
+}
+
+func _PRSService_AddTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).AddTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_AddTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).AddTeam(ctx, req.(*AddTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetTeamFallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamFallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetTeamFallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetTeamFallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetTeamFallback(ctx, req.(*GetTeamFallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_SetTeamFallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamFallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).SetTeamFallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_SetTeamFallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).SetTeamFallback(ctx, req.(*SetTeamFallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetTeamSubtree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamSubtreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetTeamSubtree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetTeamSubtree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetTeamSubtree(ctx, req.(*GetTeamSubtreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_SetTeamParent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamParentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).SetTeamParent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_SetTeamParent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).SetTeamParent(ctx, req.(*SetTeamParentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetTeamOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamOwnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetTeamOwners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetTeamOwners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetTeamOwners(ctx, req.(*GetTeamOwnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_SetTeamOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamOwnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).SetTeamOwners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_SetTeamOwners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).SetTeamOwners(ctx, req.(*SetTeamOwnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_DeleteTeamOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamOwnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).DeleteTeamOwners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_DeleteTeamOwners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).DeleteTeamOwners(ctx, req.(*DeleteTeamOwnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetTeamReviewRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamReviewRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetTeamReviewRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetTeamReviewRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetTeamReviewRules(ctx, req.(*GetTeamReviewRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_SetTeamReviewRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamReviewRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).SetTeamReviewRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_SetTeamReviewRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).SetTeamReviewRules(ctx, req.(*SetTeamReviewRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetTeamSLA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamSLARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetTeamSLA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetTeamSLA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetTeamSLA(ctx, req.(*GetTeamSLARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_SetTeamSLA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamSLARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).SetTeamSLA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_SetTeamSLA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).SetTeamSLA(ctx, req.(*SetTeamSLARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetTeamSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetTeamSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetTeamSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetTeamSchedule(ctx, req.(*GetTeamScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_SetTeamSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).SetTeamSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_SetTeamSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).SetTeamSchedule(ctx, req.(*SetTeamScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_SetIsActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIsActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).SetIsActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_SetIsActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).SetIsActive(ctx, req.(*SetIsActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_SetSeniority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSeniorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).SetSeniority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_SetSeniority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).SetSeniority(ctx, req.(*SetSeniorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetReview(ctx, req.(*GetReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetSkills(ctx, req.(*GetSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_SetSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).SetSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_SetSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).SetSkills(ctx, req.(*SetSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetUserSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetUserSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetUserSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetUserSchedule(ctx, req.(*GetUserScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_SetUserSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).SetUserSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_SetUserSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).SetUserSchedule(ctx, req.(*SetUserScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetAvailability(ctx, req.(*GetAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetSlackUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSlackUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetSlackUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetSlackUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetSlackUser(ctx, req.(*GetSlackUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_SetSlackUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSlackUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).SetSlackUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_SetSlackUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).SetSlackUser(ctx, req.(*SetSlackUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetDigest(ctx, req.(*GetDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_SetDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).SetDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_SetDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).SetDigest(ctx, req.(*SetDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_CreatePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).CreatePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_CreatePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).CreatePullRequest(ctx, req.(*CreatePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_MergePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).MergePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_MergePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).MergePullRequest(ctx, req.(*MergePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_ReassignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).ReassignReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_ReassignReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).ReassignReviewer(ctx, req.(*ReassignReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_FillReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FillReviewersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).FillReviewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_FillReviewers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).FillReviewers(ctx, req.(*FillReviewersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_ExplainAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).ExplainAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_ExplainAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).ExplainAssignment(ctx, req.(*ExplainAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetOverdue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOverdueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetOverdue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetOverdue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetOverdue(ctx, req.(*GetOverdueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetPullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetPullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetPullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetPullRequest(ctx, req.(*GetPullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_ListPullRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPullRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).ListPullRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_ListPullRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).ListPullRequests(ctx, req.(*ListPullRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetPairStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPairStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetPairStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetPairStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetPairStats(ctx, req.(*GetPairStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_GetLatencyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatencyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetLatencyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetLatencyStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetLatencyStats(ctx, req.(*GetLatencyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PRSServiceServer).StreamEvents(m, &grpc.GenericServerStream[StreamEventsRequest, StreamEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PRSService_StreamEventsServer = grpc.ServerStreamingServer[StreamEventsResponse]
+
+func _PRSService_GetHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).GetHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_GetHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).GetHolidays(ctx, req.(*GetHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRSService_ImportHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRSServiceServer).ImportHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRSService_ImportHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRSServiceServer).ImportHolidays(ctx, req.(*ImportHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PRSService_ServiceDesc is the grpc.ServiceDesc for PRSService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PRSService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prs.v1.PRSService",
+	HandlerType: (*PRSServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddTeam",
+			Handler:    _PRSService_AddTeam_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _PRSService_GetTeam_Handler,
+		},
+		{
+			MethodName: "GetTeamFallback",
+			Handler:    _PRSService_GetTeamFallback_Handler,
+		},
+		{
+			MethodName: "SetTeamFallback",
+			Handler:    _PRSService_SetTeamFallback_Handler,
+		},
+		{
+			MethodName: "GetTeamSubtree",
+			Handler:    _PRSService_GetTeamSubtree_Handler,
+		},
+		{
+			MethodName: "SetTeamParent",
+			Handler:    _PRSService_SetTeamParent_Handler,
+		},
+		{
+			MethodName: "GetTeamOwners",
+			Handler:    _PRSService_GetTeamOwners_Handler,
+		},
+		{
+			MethodName: "SetTeamOwners",
+			Handler:    _PRSService_SetTeamOwners_Handler,
+		},
+		{
+			MethodName: "DeleteTeamOwners",
+			Handler:    _PRSService_DeleteTeamOwners_Handler,
+		},
+		{
+			MethodName: "GetTeamReviewRules",
+			Handler:    _PRSService_GetTeamReviewRules_Handler,
+		},
+		{
+			MethodName: "SetTeamReviewRules",
+			Handler:    _PRSService_SetTeamReviewRules_Handler,
+		},
+		{
+			MethodName: "GetTeamSLA",
+			Handler:    _PRSService_GetTeamSLA_Handler,
+		},
+		{
+			MethodName: "SetTeamSLA",
+			Handler:    _PRSService_SetTeamSLA_Handler,
+		},
+		{
+			MethodName: "GetTeamSchedule",
+			Handler:    _PRSService_GetTeamSchedule_Handler,
+		},
+		{
+			MethodName: "SetTeamSchedule",
+			Handler:    _PRSService_SetTeamSchedule_Handler,
+		},
+		{
+			MethodName: "SetIsActive",
+			Handler:    _PRSService_SetIsActive_Handler,
+		},
+		{
+			MethodName: "SetSeniority",
+			Handler:    _PRSService_SetSeniority_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _PRSService_GetUser_Handler,
+		},
+		{
+			MethodName: "GetReview",
+			Handler:    _PRSService_GetReview_Handler,
+		},
+		{
+			MethodName: "GetSkills",
+			Handler:    _PRSService_GetSkills_Handler,
+		},
+		{
+			MethodName: "SetSkills",
+			Handler:    _PRSService_SetSkills_Handler,
+		},
+		{
+			MethodName: "GetUserSchedule",
+			Handler:    _PRSService_GetUserSchedule_Handler,
+		},
+		{
+			MethodName: "SetUserSchedule",
+			Handler:    _PRSService_SetUserSchedule_Handler,
+		},
+		{
+			MethodName: "GetAvailability",
+			Handler:    _PRSService_GetAvailability_Handler,
+		},
+		{
+			MethodName: "GetSlackUser",
+			Handler:    _PRSService_GetSlackUser_Handler,
+		},
+		{
+			MethodName: "SetSlackUser",
+			Handler:    _PRSService_SetSlackUser_Handler,
+		},
+		{
+			MethodName: "GetDigest",
+			Handler:    _PRSService_GetDigest_Handler,
+		},
+		{
+			MethodName: "SetDigest",
+			Handler:    _PRSService_SetDigest_Handler,
+		},
+		{
+			MethodName: "CreatePullRequest",
+			Handler:    _PRSService_CreatePullRequest_Handler,
+		},
+		{
+			MethodName: "MergePullRequest",
+			Handler:    _PRSService_MergePullRequest_Handler,
+		},
+		{
+			MethodName: "ReassignReviewer",
+			Handler:    _PRSService_ReassignReviewer_Handler,
+		},
+		{
+			MethodName: "FillReviewers",
+			Handler:    _PRSService_FillReviewers_Handler,
+		},
+		{
+			MethodName: "ExplainAssignment",
+			Handler:    _PRSService_ExplainAssignment_Handler,
+		},
+		{
+			MethodName: "GetOverdue",
+			Handler:    _PRSService_GetOverdue_Handler,
+		},
+		{
+			MethodName: "GetPullRequest",
+			Handler:    _PRSService_GetPullRequest_Handler,
+		},
+		{
+			MethodName: "ListPullRequests",
+			Handler:    _PRSService_ListPullRequests_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _PRSService_GetStats_Handler,
+		},
+		{
+			MethodName: "GetPairStats",
+			Handler:    _PRSService_GetPairStats_Handler,
+		},
+		{
+			MethodName: "GetLatencyStats",
+			Handler:    _PRSService_GetLatencyStats_Handler,
+		},
+		{
+			MethodName: "GetHolidays",
+			Handler:    _PRSService_GetHolidays_Handler,
+		},
+		{
+			MethodName: "ImportHolidays",
+			Handler:    _PRSService_ImportHolidays_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _PRSService_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "prs/v1/prs.proto",
+}